@@ -0,0 +1,229 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+)
+
+// AddOnSpec defines the desired state of AddOn.
+type AddOnSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:message="clusterName is immutable",rule=(self == oldSelf)
+	// Cluster name in OpenShift Cluster Manager by which this should be managed for.  A cluster with this
+	// name should exist in the organization by which the operator is associated.  If the cluster does
+	// not exist, the reconciliation process will continue until one does.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:message="addOnID is immutable",rule=(self == oldSelf)
+	// ID of the add-on in OpenShift Cluster Manager to install (e.g. cluster-logging-operator).
+	AddOnID string `json:"addOnID,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Version of the add-on to install.  If this is empty, the version determined by OpenShift
+	// Cluster Manager is installed and the add-on is not upgraded by the operator.
+	Version string `json:"version,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// Parameters which are passed to the add-on at installation.  The parameters which are
+	// accepted vary by add-on.
+	Parameters []AddOnParameter `json:"parameters,omitempty"`
+}
+
+// AddOnParameter defines a single parameter which is passed to an add-on.
+//
+// +kubebuilder:validation:XValidation:message="only one of value or secret may be set",rule=!(has(self.value) && has(self.secret))
+type AddOnParameter struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Name (ID) of the parameter as defined by the add-on.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// Value of the parameter.
+	Value string `json:"value,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Reference to a secret by name containing the value of the parameter.  The key matching
+	// the name of the parameter is used to locate the data.  This should exist in the same
+	// namespace as the add-on and is useful for sensitive values.
+	Secret *configv1.SecretNameReference `json:"secret,omitempty"`
+}
+
+// AddOnStatus defines the observed state of AddOn.
+type AddOnStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.clusterID is immutable",rule=(self == oldSelf)
+	// Represents the programmatic cluster ID of the cluster, as
+	// determined during reconciliation.  This is used to reduce
+	// the number of API calls to look up a cluster ID based on
+	// the cluster name.
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// Installation state of the add-on, as reported by OpenShift Cluster Manager.
+	State string `json:"state,omitempty"`
+
+	// Installed version of the add-on, as reported by OpenShift Cluster Manager.
+	Version string `json:"version,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// AddOn is the Schema for the addons API.
+type AddOn struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AddOnSpec   `json:"spec,omitempty"`
+	Status AddOnStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// AddOnList contains a list of AddOn.
+type AddOnList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AddOn `json:"items"`
+}
+
+// FindAll gets a complete list of resources in the cluster for this type.
+func (addOn *AddOn) FindAll(
+	ctx context.Context,
+	c kubernetes.Client,
+) ([]AddOn, error) {
+	objects := &AddOnList{}
+
+	if err := c.List(ctx, objects); err != nil {
+		return []AddOn{}, fmt.Errorf("unable to retrieve add-ons - %w", err)
+	}
+
+	return objects.Items, nil
+}
+
+// FindAllByClusterID gets a list of resources which have a particular cluster ID in the status field.
+func (addOn *AddOn) FindAllByClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) ([]*AddOn, error) {
+	objects, err := addOn.FindAll(ctx, c)
+	if err != nil {
+		return []*AddOn{}, err
+	}
+
+	matches := []*AddOn{}
+
+	for i := range objects {
+		if objects[i].Status.ClusterID == clusterID {
+			matches = append(matches, &objects[i])
+		}
+	}
+
+	return matches, nil
+}
+
+// ExistsForClusterID returns if a particular object is associated with a cluster ID.
+func (addOn *AddOn) ExistsForClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) (bool, error) {
+	objects, err := addOn.FindAllByClusterID(ctx, c, clusterID)
+
+	return (len(objects) > 0), err
+}
+
+// GetClusterID gets the status.clusterID field from the object.  It is used to
+// satisfy the Workload interface.
+func (addOn *AddOn) GetClusterID() string {
+	return addOn.Status.ClusterID
+}
+
+// GetConditions returns the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (addOn *AddOn) GetConditions() []metav1.Condition {
+	return addOn.Status.Conditions
+}
+
+// SetConditions sets the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (addOn *AddOn) SetConditions(conditions []metav1.Condition) {
+	addOn.Status.Conditions = conditions
+}
+
+// CopyFrom copies an OCM AddOnInstallation object into an AddOn object that is recognizable by this
+// controller.  Parameters are always copied as values as OCM has no knowledge of where the value
+// originated.
+func (addOn *AddOn) CopyFrom(source *clustersmgmtv1.AddOnInstallation) {
+	addOn.Spec.AddOnID = source.Addon().ID()
+	addOn.Spec.Version = source.AddonVersion().ID()
+	addOn.Spec.Parameters = []AddOnParameter{}
+
+	for _, parameter := range source.Parameters().Slice() {
+		addOn.Spec.Parameters = append(addOn.Spec.Parameters, AddOnParameter{
+			Name:  parameter.ID(),
+			Value: parameter.Value(),
+		})
+	}
+
+	addOn.Status.State = string(source.State())
+	addOn.Status.Version = source.AddonVersion().ID()
+}
+
+// Builder returns the builder object from a reconciler object.  This object is used to
+// pass into the OCM API for creating the object.  The values of the parameters are passed in
+// as they may be stored in secrets rather than directly on the object.
+func (addOn *AddOn) Builder(parameters map[string]string) *clustersmgmtv1.AddOnInstallationBuilder {
+	builder := clustersmgmtv1.NewAddOnInstallation().
+		ID(addOn.Spec.AddOnID).
+		Addon(clustersmgmtv1.NewAddOn().ID(addOn.Spec.AddOnID))
+
+	if addOn.Spec.Version != "" {
+		builder = builder.AddonVersion(clustersmgmtv1.NewAddOnVersion().ID(addOn.Spec.Version))
+	}
+
+	if len(addOn.Spec.Parameters) > 0 {
+		items := []*clustersmgmtv1.AddOnInstallationParameterBuilder{}
+
+		for i := range addOn.Spec.Parameters {
+			name := addOn.Spec.Parameters[i].Name
+			items = append(items, clustersmgmtv1.NewAddOnInstallationParameter().ID(name).Value(parameters[name]))
+		}
+
+		builder = builder.Parameters(clustersmgmtv1.NewAddOnInstallationParameterList().Items(items...))
+	}
+
+	return builder
+}
+
+func init() {
+	SchemeBuilder.Register(&AddOn{}, &AddOnList{})
+}
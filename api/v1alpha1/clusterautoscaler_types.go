@@ -0,0 +1,294 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+)
+
+// ClusterAutoscalerSpec defines the desired state of ClusterAutoscaler.
+type ClusterAutoscalerSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:message="clusterName is immutable",rule=(self == oldSelf)
+	// Cluster name in OpenShift Cluster Manager by which this should be managed for.  A cluster with this
+	// name should exist in the organization by which the operator is associated.  If the cluster does
+	// not exist, the reconciliation process will continue until one does.  Only clusters which are not
+	// using a hosted control plane support a cluster autoscaler.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	// Identify node groups with the same instance type and label set and balance the number of nodes
+	// between them.
+	BalanceSimilarNodeGroups bool `json:"balanceSimilarNodeGroups,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	// Prevent the autoscaler from removing nodes which have pods with local storage.
+	SkipNodesWithLocalStorage bool `json:"skipNodesWithLocalStorage,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	// Ignore daemonset pods when calculating the resource utilization for scaling down nodes.
+	IgnoreDaemonsetsUtilization bool `json:"ignoreDaemonsetsUtilization,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=6
+	// Log verbosity of the autoscaler.
+	LogVerbosity int `json:"logVerbosity,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=600
+	// +kubebuilder:validation:Minimum=1
+	// Maximum amount of time, in seconds, that the autoscaler waits for pods to gracefully terminate
+	// before scaling down a node.
+	MaxPodGracePeriodSeconds int `json:"maxPodGracePeriodSeconds,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=-10
+	// Pods with a priority below this threshold do not cause the autoscaler to scale up and do not
+	// prevent the autoscaler from scaling down.
+	PodPriorityThreshold int `json:"podPriorityThreshold,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="15m"
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// Maximum amount of time that the autoscaler waits for a node to be provisioned.
+	MaxNodeProvisionTime string `json:"maxNodeProvisionTime,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={}
+	// Limits on the resources of the cluster which are enforced by the autoscaler.
+	ResourceLimits ClusterAutoscalerResourceLimits `json:"resourceLimits,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={}
+	// Configuration of how the autoscaler scales down nodes.
+	ScaleDown ClusterAutoscalerScaleDown `json:"scaleDown,omitempty"`
+}
+
+// ClusterAutoscalerResourceLimits defines the limits on the resources of the cluster which are enforced
+// by the autoscaler.
+type ClusterAutoscalerResourceLimits struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=180
+	// +kubebuilder:validation:Minimum=1
+	// Maximum number of nodes in all node groups.  This includes nodes which are not managed by
+	// the autoscaler.
+	MaxNodesTotal int `json:"maxNodesTotal,omitempty"`
+}
+
+// ClusterAutoscalerScaleDown defines how the autoscaler scales down nodes.
+type ClusterAutoscalerScaleDown struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	// Whether the autoscaler should scale down nodes.
+	Enabled bool `json:"enabled"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0.5"
+	// +kubebuilder:validation:Pattern=`^(0(\.[0-9]+)?|1(\.0+)?)$`
+	// Node utilization level, as a value between 0 and 1, below which a node is considered for
+	// scale down.
+	UtilizationThreshold string `json:"utilizationThreshold,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="10m"
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// Amount of time a node should be unneeded before it is eligible for scale down.
+	UnneededTime string `json:"unneededTime,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="10m"
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// Amount of time after a scale up before scale down evaluation resumes.
+	DelayAfterAdd string `json:"delayAfterAdd,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="0s"
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// Amount of time after a node deletion before scale down evaluation resumes.
+	DelayAfterDelete string `json:"delayAfterDelete,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="3m"
+	// +kubebuilder:validation:Pattern=`^([0-9]+(\.[0-9]+)?(ns|us|µs|ms|s|m|h))+$`
+	// Amount of time after a scale down failure before scale down evaluation resumes.
+	DelayAfterFailure string `json:"delayAfterFailure,omitempty"`
+}
+
+// ClusterAutoscalerStatus defines the observed state of ClusterAutoscaler.
+type ClusterAutoscalerStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.clusterID is immutable",rule=(self == oldSelf)
+	// Represents the programmatic cluster ID of the cluster, as
+	// determined during reconciliation.  This is used to reduce
+	// the number of API calls to look up a cluster ID based on
+	// the cluster name.
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.Hosted is immutable",rule=(self == oldSelf)
+	// Whether this cluster is using a hosted control plane.  Clusters which are using a hosted
+	// control plane do not support a cluster autoscaler.
+	Hosted bool `json:"hosted,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// ClusterAutoscaler is the Schema for the clusterautoscalers API.
+type ClusterAutoscaler struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterAutoscalerSpec   `json:"spec,omitempty"`
+	Status ClusterAutoscalerStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterAutoscalerList contains a list of ClusterAutoscaler.
+type ClusterAutoscalerList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterAutoscaler `json:"items"`
+}
+
+// FindAll gets a complete list of resources in the cluster for this type.
+func (autoscaler *ClusterAutoscaler) FindAll(
+	ctx context.Context,
+	c kubernetes.Client,
+) ([]ClusterAutoscaler, error) {
+	objects := &ClusterAutoscalerList{}
+
+	if err := c.List(ctx, objects); err != nil {
+		return []ClusterAutoscaler{}, fmt.Errorf("unable to retrieve cluster autoscalers - %w", err)
+	}
+
+	return objects.Items, nil
+}
+
+// FindAllByClusterID gets a list of resources which have a particular cluster ID in the status field.
+func (autoscaler *ClusterAutoscaler) FindAllByClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) ([]*ClusterAutoscaler, error) {
+	objects, err := autoscaler.FindAll(ctx, c)
+	if err != nil {
+		return []*ClusterAutoscaler{}, err
+	}
+
+	matches := []*ClusterAutoscaler{}
+
+	for i := range objects {
+		if objects[i].Status.ClusterID == clusterID {
+			matches = append(matches, &objects[i])
+		}
+	}
+
+	return matches, nil
+}
+
+// ExistsForClusterID returns if a particular object is associated with a cluster ID.
+func (autoscaler *ClusterAutoscaler) ExistsForClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) (bool, error) {
+	objects, err := autoscaler.FindAllByClusterID(ctx, c, clusterID)
+
+	return (len(objects) > 0), err
+}
+
+// GetClusterID gets the status.clusterID field from the object.  It is used to
+// satisfy the Workload interface.
+func (autoscaler *ClusterAutoscaler) GetClusterID() string {
+	return autoscaler.Status.ClusterID
+}
+
+// GetConditions returns the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (autoscaler *ClusterAutoscaler) GetConditions() []metav1.Condition {
+	return autoscaler.Status.Conditions
+}
+
+// SetConditions sets the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (autoscaler *ClusterAutoscaler) SetConditions(conditions []metav1.Condition) {
+	autoscaler.Status.Conditions = conditions
+}
+
+// CopyFrom copies an OCM ClusterAutoscaler object into a ClusterAutoscaler object that is recognizable by
+// this controller.
+func (autoscaler *ClusterAutoscaler) CopyFrom(source *clustersmgmtv1.ClusterAutoscaler) {
+	autoscaler.Spec.BalanceSimilarNodeGroups = source.BalanceSimilarNodeGroups()
+	autoscaler.Spec.SkipNodesWithLocalStorage = source.SkipNodesWithLocalStorage()
+	autoscaler.Spec.IgnoreDaemonsetsUtilization = source.IgnoreDaemonsetsUtilization()
+	autoscaler.Spec.LogVerbosity = source.LogVerbosity()
+	autoscaler.Spec.MaxPodGracePeriodSeconds = source.MaxPodGracePeriod()
+	autoscaler.Spec.PodPriorityThreshold = source.PodPriorityThreshold()
+	autoscaler.Spec.MaxNodeProvisionTime = source.MaxNodeProvisionTime()
+	autoscaler.Spec.ResourceLimits.MaxNodesTotal = source.ResourceLimits().MaxNodesTotal()
+	autoscaler.Spec.ScaleDown = ClusterAutoscalerScaleDown{
+		Enabled:              source.ScaleDown().Enabled(),
+		UtilizationThreshold: source.ScaleDown().UtilizationThreshold(),
+		UnneededTime:         source.ScaleDown().UnneededTime(),
+		DelayAfterAdd:        source.ScaleDown().DelayAfterAdd(),
+		DelayAfterDelete:     source.ScaleDown().DelayAfterDelete(),
+		DelayAfterFailure:    source.ScaleDown().DelayAfterFailure(),
+	}
+}
+
+// Builder returns the builder object from a reconciler object.  This object is used to
+// pass into the OCM API for creating the object.
+func (autoscaler *ClusterAutoscaler) Builder() *clustersmgmtv1.ClusterAutoscalerBuilder {
+	scaleDown := autoscaler.Spec.ScaleDown
+
+	return clustersmgmtv1.NewClusterAutoscaler().
+		BalanceSimilarNodeGroups(autoscaler.Spec.BalanceSimilarNodeGroups).
+		SkipNodesWithLocalStorage(autoscaler.Spec.SkipNodesWithLocalStorage).
+		IgnoreDaemonsetsUtilization(autoscaler.Spec.IgnoreDaemonsetsUtilization).
+		LogVerbosity(autoscaler.Spec.LogVerbosity).
+		MaxPodGracePeriod(autoscaler.Spec.MaxPodGracePeriodSeconds).
+		PodPriorityThreshold(autoscaler.Spec.PodPriorityThreshold).
+		MaxNodeProvisionTime(autoscaler.Spec.MaxNodeProvisionTime).
+		ResourceLimits(clustersmgmtv1.NewAutoscalerResourceLimits().
+			MaxNodesTotal(autoscaler.Spec.ResourceLimits.MaxNodesTotal),
+		).
+		ScaleDown(clustersmgmtv1.NewAutoscalerScaleDownConfig().
+			Enabled(scaleDown.Enabled).
+			UtilizationThreshold(scaleDown.UtilizationThreshold).
+			UnneededTime(scaleDown.UnneededTime).
+			DelayAfterAdd(scaleDown.DelayAfterAdd).
+			DelayAfterDelete(scaleDown.DelayAfterDelete).
+			DelayAfterFailure(scaleDown.DelayAfterFailure),
+		)
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterAutoscaler{}, &ClusterAutoscalerList{})
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+)
+
+// ClusterGroupMembershipSpec defines the desired state of ClusterGroupMembership.
+type ClusterGroupMembershipSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:message="clusterName is immutable",rule=(self == oldSelf)
+	// Cluster name in OpenShift Cluster Manager by which this should be managed for.  A cluster with this
+	// name should exist in the organization by which the operator is associated.  If the cluster does
+	// not exist, the reconciliation process will continue until one does.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=dedicated-admins
+	// +kubebuilder:validation:Enum=dedicated-admins;cluster-admins
+	// +kubebuilder:validation:XValidation:message="group is immutable",rule=(self == oldSelf)
+	// Cluster group in OpenShift Cluster Manager to manage the membership of.  Must be one of
+	// dedicated-admins (default) or cluster-admins.  The cluster-admins group is only available
+	// on clusters which have cluster admin access enabled.
+	Group string `json:"group,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	// List of users which should be members of the group.  Users which are members of the group
+	// in OpenShift Cluster Manager, but are not listed here, are removed from the group.  The
+	// username must match the username of the user from the identity provider.
+	Users []string `json:"users,omitempty"`
+}
+
+// ClusterGroupMembershipStatus defines the observed state of ClusterGroupMembership.
+type ClusterGroupMembershipStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.clusterID is immutable",rule=(self == oldSelf)
+	// Represents the programmatic cluster ID of the cluster, as
+	// determined during reconciliation.  This is used to reduce
+	// the number of API calls to look up a cluster ID based on
+	// the cluster name.
+	ClusterID string `json:"clusterID,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// ClusterGroupMembership is the Schema for the clustergroupmemberships API.
+type ClusterGroupMembership struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterGroupMembershipSpec   `json:"spec,omitempty"`
+	Status ClusterGroupMembershipStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterGroupMembershipList contains a list of ClusterGroupMembership.
+type ClusterGroupMembershipList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterGroupMembership `json:"items"`
+}
+
+// FindAll gets a complete list of resources in the cluster for this type.
+func (membership *ClusterGroupMembership) FindAll(
+	ctx context.Context,
+	c kubernetes.Client,
+) ([]ClusterGroupMembership, error) {
+	objects := &ClusterGroupMembershipList{}
+
+	if err := c.List(ctx, objects); err != nil {
+		return []ClusterGroupMembership{}, fmt.Errorf("unable to retrieve cluster group memberships - %w", err)
+	}
+
+	return objects.Items, nil
+}
+
+// FindAllByClusterID gets a list of resources which have a particular cluster ID in the status field.
+func (membership *ClusterGroupMembership) FindAllByClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) ([]*ClusterGroupMembership, error) {
+	objects, err := membership.FindAll(ctx, c)
+	if err != nil {
+		return []*ClusterGroupMembership{}, err
+	}
+
+	matches := []*ClusterGroupMembership{}
+
+	for i := range objects {
+		if objects[i].Status.ClusterID == clusterID {
+			matches = append(matches, &objects[i])
+		}
+	}
+
+	return matches, nil
+}
+
+// ExistsForClusterID returns if a particular object is associated with a cluster ID.
+func (membership *ClusterGroupMembership) ExistsForClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) (bool, error) {
+	objects, err := membership.FindAllByClusterID(ctx, c, clusterID)
+
+	return (len(objects) > 0), err
+}
+
+// GetClusterID gets the status.clusterID field from the object.  It is used to
+// satisfy the Workload interface.
+func (membership *ClusterGroupMembership) GetClusterID() string {
+	return membership.Status.ClusterID
+}
+
+// GetConditions returns the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (membership *ClusterGroupMembership) GetConditions() []metav1.Condition {
+	return membership.Status.Conditions
+}
+
+// SetConditions sets the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (membership *ClusterGroupMembership) SetConditions(conditions []metav1.Condition) {
+	membership.Status.Conditions = conditions
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterGroupMembership{}, &ClusterGroupMembershipList{})
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	ClusterRolloutStateProgressing = "Progressing"
+	ClusterRolloutStateCompleted   = "Completed"
+	ClusterRolloutStateFailed      = "Failed"
+
+	ClusterRolloutClusterStatePending   = "Pending"
+	ClusterRolloutClusterStateUpgrading = "Upgrading"
+	ClusterRolloutClusterStateSucceeded = "Succeeded"
+	ClusterRolloutClusterStateFailed    = "Failed"
+
+	defaultClusterRolloutUpgradeTimeoutMinutes = 360
+)
+
+// ClusterRolloutSpec defines the desired state of ClusterRollout.
+//
+//nolint:lll
+type ClusterRolloutSpec struct {
+	// +kubebuilder:validation:Required
+	// Label selector used to select the ROSACluster objects, in the same namespace as this
+	// resource, which are upgraded as part of this rollout.
+	Selector metav1.LabelSelector `json:"selector"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:message="version must be valid x.y.z format",rule=(self.split(".").size() == 3)
+	// +kubebuilder:validation:XValidation:message="version cannot start with a 'v'",rule=(!self.startsWith('v'))
+	// OpenShift version, in format of x.y.z, which the selected clusters are upgraded to.  Clusters
+	// which are already running this version, or a newer version, are not upgraded.  Changing this
+	// field starts a new rollout from the first wave.
+	Version string `json:"version"`
+
+	// +kubebuilder:validation:Optional
+	// Strategy by which the selected clusters are upgraded.
+	Strategy ClusterRolloutStrategy `json:"strategy,omitempty"`
+}
+
+// ClusterRolloutStrategy represents the strategy by which the clusters of a rollout are upgraded
+// in waves.
+type ClusterRolloutStrategy struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// Number of clusters which are upgraded in each wave (default: 1).  Clusters are assigned to
+	// waves in order of their name.
+	BatchSize int `json:"batchSize,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	// Time to wait after a wave has completed before starting the next wave, in minutes (default: 0).
+	PauseMinutes int `json:"pauseMinutes,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=0
+	// +kubebuilder:validation:Minimum=0
+	// Number of clusters which may fail to upgrade before the rollout is stopped (default: 0).  Once
+	// this is exceeded, no further clusters are upgraded.
+	FailureThreshold int `json:"failureThreshold,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=360
+	// +kubebuilder:validation:Minimum=1
+	// Time to wait for the upgrade of a cluster to complete, and for the cluster to be ready, after
+	// its upgrade was requested, in minutes (default: 360).  A cluster which has not completed its
+	// upgrade in this time has failed, for example if it is hibernating or has no upgrade path to
+	// the version.
+	UpgradeTimeoutMinutes int `json:"upgradeTimeoutMinutes,omitempty"`
+}
+
+// ClusterRolloutStatus defines the observed state of ClusterRollout.
+type ClusterRolloutStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Represents the version which the progress in this status refers to.  This differs
+	// from 'spec.version' until a new rollout has been started.
+	Version string `json:"version,omitempty"`
+
+	// Represents the state of the rollout (Progressing, Completed or Failed).
+	State string `json:"state,omitempty"`
+
+	// Represents the wave, starting from 0, which is currently being upgraded.
+	CurrentWave int `json:"currentWave,omitempty"`
+
+	// Represents the time at which the most recent wave completed.  The next wave
+	// starts once 'spec.strategy.pauseMinutes' has elapsed after this time.
+	WaveCompletionTime *metav1.Time `json:"waveCompletionTime,omitempty"`
+
+	// Represents the progress of each cluster which is a part of the rollout.
+	Clusters []ClusterRolloutClusterStatus `json:"clusters,omitempty"`
+}
+
+// ClusterRolloutClusterStatus represents the progress of a single cluster which is a part of a rollout.
+type ClusterRolloutClusterStatus struct {
+	// Name of the ROSACluster object.
+	Name string `json:"name"`
+
+	// Represents the wave, starting from 0, in which the cluster is upgraded.
+	Wave int `json:"wave"`
+
+	// Represents the state of the upgrade of the cluster (Pending, Upgrading, Succeeded or Failed).
+	State string `json:"state"`
+
+	// Represents the OpenShift version which the cluster was most recently observed running.
+	Version string `json:"version,omitempty"`
+
+	// Represents additional information about the state of the upgrade of the cluster.
+	Message string `json:"message,omitempty"`
+
+	// Represents the time at which the upgrade of the cluster was requested.  The upgrade
+	// has failed once 'spec.strategy.upgradeTimeoutMinutes' has elapsed after this time.
+	UpgradeStartTime *metav1.Time `json:"upgradeStartTime,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// ClusterRollout is the Schema for the clusterrollouts API.
+type ClusterRollout struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterRolloutSpec   `json:"spec,omitempty"`
+	Status ClusterRolloutStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ClusterRolloutList contains a list of ClusterRollout.
+type ClusterRolloutList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterRollout `json:"items"`
+}
+
+// GetClusterID returns an empty cluster ID as a rollout spans many clusters.  It is used to
+// satisfy the Workload interface.
+func (rollout *ClusterRollout) GetClusterID() string {
+	return ""
+}
+
+// GetConditions returns the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (rollout *ClusterRollout) GetConditions() []metav1.Condition {
+	return rollout.Status.Conditions
+}
+
+// SetConditions sets the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (rollout *ClusterRollout) SetConditions(conditions []metav1.Condition) {
+	rollout.Status.Conditions = conditions
+}
+
+// GetBatchSize returns the number of clusters which are upgraded in each wave.
+func (rollout *ClusterRollout) GetBatchSize() int {
+	if rollout.Spec.Strategy.BatchSize < 1 {
+		return 1
+	}
+
+	return rollout.Spec.Strategy.BatchSize
+}
+
+// GetUpgradeTimeout returns the time to wait for the upgrade of a cluster to complete.
+func (rollout *ClusterRollout) GetUpgradeTimeout() time.Duration {
+	if rollout.Spec.Strategy.UpgradeTimeoutMinutes < 1 {
+		return defaultClusterRolloutUpgradeTimeoutMinutes * time.Minute
+	}
+
+	return time.Duration(rollout.Spec.Strategy.UpgradeTimeoutMinutes) * time.Minute
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterRollout{}, &ClusterRolloutList{})
+}
@@ -28,6 +28,7 @@ import (
 )
 
 const (
+	GitLabClientIDKey     = "clientID"
 	GitLabClientSecretKey = "clientSecret"
 	GitLabAccessTokenKey  = "accessToken"
 	GitLabCAKey           = "ca.crt"
@@ -36,18 +37,26 @@ const (
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
+// +kubebuilder:validation:XValidation:message="clientID and clientSecret are required unless accessTokenSecret is specified",rule=(has(self.accessTokenSecret) || (has(self.clientID) && has(self.clientSecret)))
 // GitLabIdentityProviderSpec defines the desired state of GitLabIdentityProvider.
 //
 //nolint:lll
 type GitLabIdentityProviderSpec struct {
-	// clientID is the oauth client ID
-	ClientID string `json:"clientID"`
+	// +kubebuilder:validation:Optional
+	// clientID is the oauth client ID.  This is required unless accessTokenSecret is specified, in
+	// which case the client ID of the application created by the operator is used.
+	ClientID string `json:"clientID,omitempty"`
 
-	// clientSecret is a required reference to the secret by name containing the oauth client secret.
+	// +kubebuilder:validation:Optional
+	// clientSecret is a reference to the secret by name containing the oauth client secret.
 	// The key "clientSecret" is used to locate the data.
 	// If the secret or expected key is not found, the identity provider is not honored.
-	// This should exist in the same namespace as the operator.
-	ClientSecret configv1.SecretNameReference `json:"clientSecret"`
+	// This should exist in the same namespace as the operator.  This is required unless
+	// accessTokenSecret is specified, in which case this is the name of the secret that the
+	// operator manages to store the client ID and client secret of the application it created.  If
+	// accessTokenSecret is specified and this is empty, the name of the managed secret defaults
+	// to the metadata.name field of the parent resource with a "-gitlab-oauth" suffix.
+	ClientSecret configv1.SecretNameReference `json:"clientSecret,omitempty"`
 
 	// url is the oauth server base URL
 	URL string `json:"url"`
@@ -87,19 +96,19 @@ type GitLabIdentityProviderSpec struct {
 	// API limitation.
 	DisplayName string `json:"displayName,omitempty"`
 
-	// TODO: eventually we want to be able to have the operator create the application.  currently there is a limitation
-	//       in gitlab which restricts application creation for a particular group to the server admins.  once this
-	//       api limitation is removed (if ever) we can implement the following and be able to reconcile accordingly.
-
-	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:XValidation:message="accessTokenSecret is immutable",rule=(self == oldSelf)
-	// accessTokenSecret is a required reference to the secret by
+	// accessTokenSecret is an optional reference to the secret by
 	// name containing the GitLab access token required to interact with
-	// the GitLab API.  This access token must have read/write API access.  The
-	// secret must contain the key 'accessToken' to locate the data. If the secret or
+	// the GitLab API.  When specified, the operator creates and manages the OAuth
+	// application in GitLab on behalf of the user, including keeping its redirect URI
+	// in sync with the callback URL of the cluster and deleting it when this resource
+	// is deleted.  This access token must have read/write API access and, as the GitLab
+	// applications API is restricted to administrators, must belong to an administrator.
+	// The secret must contain the key 'accessToken' to locate the data. If the secret or
 	// expected key is not found, the identity provider is not honored. The namespace
 	// for this secret must exist in the same namespace as the resource.
-	// AccessTokenSecret string `json:"accessTokenSecret,omitempty"`
+	AccessTokenSecret configv1.SecretNameReference `json:"accessTokenSecret,omitempty"`
 }
 
 // GitLabIdentityProviderStatus defines the observed state of GitLabIdentityProvider.
@@ -124,6 +133,16 @@ type GitLabIdentityProviderStatus struct {
 	// Represents the OAuth endpoint used for the OAuth provider to call back
 	// to.  This is necessary for proper configuration of any external identity provider.
 	CallbackURL string `json:"callbackURL,omitempty"`
+
+	// Represents the programmatic ID of the OAuth application in GitLab which
+	// is managed by the operator.  This is only set when spec.accessTokenSecret
+	// is specified.
+	ApplicationID int `json:"applicationID,omitempty"`
+
+	// Represents a checksum of the data from the secrets and config maps referenced by
+	// this object, as last applied to OpenShift Cluster Manager.  OpenShift Cluster Manager
+	// does not return this data, so this is used to determine when it has changed.
+	ReferenceHash string `json:"referenceHash,omitempty"`
 }
 
 // +kubebuilder:resource:categories=idps;identityproviders
@@ -214,6 +233,12 @@ func (gitlab *GitLabIdentityProvider) SetConditions(conditions []metav1.Conditio
 	gitlab.Status.Conditions = conditions
 }
 
+// ManagesApplication returns if the operator manages the OAuth application in GitLab for
+// this object.
+func (gitlab *GitLabIdentityProvider) ManagesApplication() bool {
+	return gitlab.Spec.AccessTokenSecret.Name != ""
+}
+
 // CopyFrom copies a GitLab Identity provider into an object that is able to be reconciled.
 func (gitlab *GitLabIdentityProvider) CopyFrom(source *clustersmgmtv1.IdentityProvider) {
 	gitlab.Spec.CA = configv1.ConfigMapNameReference{Name: source.Gitlab().CA()}
@@ -229,6 +254,10 @@ func (gitlab *GitLabIdentityProvider) Builder(ca, clientSecret string) *clusters
 		Name(gitlab.Spec.DisplayName).
 		Type(clustersmgmtv1.IdentityProviderTypeGitlab)
 
+	if gitlab.Status.ProviderID != "" {
+		builder.ID(gitlab.Status.ProviderID)
+	}
+
 	gitlabIDP := clustersmgmtv1.NewGitlabIdentityProvider().
 		URL(gitlab.Spec.URL).
 		ClientSecret(clientSecret).
@@ -0,0 +1,228 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+)
+
+const (
+	GoogleClientSecretKey = "clientSecret"
+)
+
+// +kubebuilder:validation:XValidation:message="hostedDomain is required unless mappingMethod is lookup",rule=(self.mappingMethod == 'lookup' || has(self.hostedDomain))
+// GoogleIdentityProviderSpec defines the desired state of GoogleIdentityProvider.
+//
+//nolint:lll
+type GoogleIdentityProviderSpec struct {
+	// clientID is the oauth client ID
+	ClientID string `json:"clientID"`
+
+	// clientSecret is a required reference to the secret by name containing the oauth client secret.
+	// The key "clientSecret" is used to locate the data.
+	// If the secret or expected key is not found, the identity provider is not honored.
+	// This should exist in the same namespace as the operator.
+	ClientSecret configv1.SecretNameReference `json:"clientSecret"`
+
+	// +kubebuilder:validation:Optional
+	// hostedDomain is the optional Google App domain (e.g. "mycompany.com") to restrict logins to.
+	// This is required unless the mapping method is lookup.
+	HostedDomain string `json:"hostedDomain,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=claim
+	// +kubebuilder:validation:Enum=claim;lookup;generate;add
+	// Mapping method to use for the identity provider.
+	// See https://docs.openshift.com/container-platform/latest/authentication/understanding-identity-provider.html#identity-provider-parameters_understanding-identity-provider
+	// for a detailed description of what these mean.  Must be one of claim (default), lookup, generate, or add.
+	MappingMethod string `json:"mappingMethod,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:message="clusterName is immutable",rule=(self == oldSelf)
+	// Cluster name in OpenShift Cluster Manager by which this should be managed for.  A cluster with this
+	// name should exist in the organization by which the operator is associated.  If the cluster does
+	// not exist, the reconciliation process will continue until one does.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=4
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:XValidation:message="displayName is immutable",rule=(self == oldSelf)
+	// Friendly display name as displayed in the OpenShift Cluster Manager
+	// console.  If this is empty, the metadata.name field of the parent resource is used
+	// to construct the display name.  This is limited to 15 characters as per the backend
+	// API limitation.
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// GoogleIdentityProviderStatus defines the observed state of GoogleIdentityProvider.
+type GoogleIdentityProviderStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.clusterID is immutable",rule=(self == oldSelf)
+	// Represents the programmatic cluster ID of the cluster, as
+	// determined during reconciliation.  This is used to reduce
+	// the number of API calls to look up a cluster ID based on
+	// the cluster name.
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.providerID is immutable",rule=(self == oldSelf)
+	// Represents the programmatic identity provider ID of the IDP, as
+	// determined during reconciliation.  This is used to reduce
+	// the number of API calls to look up a cluster ID based on
+	// the identity provider name.
+	ProviderID string `json:"providerID,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.callbackURL is immutable",rule=(self == oldSelf)
+	// Represents the OAuth endpoint used for the OAuth provider to call back
+	// to.  This is necessary for proper configuration of the OAuth client in Google.
+	CallbackURL string `json:"callbackURL,omitempty"`
+
+	// Represents a checksum of the data from the secrets and config maps referenced by
+	// this object, as last applied to OpenShift Cluster Manager.  OpenShift Cluster Manager
+	// does not return this data, so this is used to determine when it has changed.
+	ReferenceHash string `json:"referenceHash,omitempty"`
+}
+
+// +kubebuilder:resource:categories=idps;identityproviders
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+//+kubebuilder:validation:XValidation:message="metadata.name limited to 15 characters",rule=(self.metadata.name.size() <= 15)
+
+// GoogleIdentityProvider is the Schema for the googleidentityproviders API.
+type GoogleIdentityProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   GoogleIdentityProviderSpec   `json:"spec,omitempty"`
+	Status GoogleIdentityProviderStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// GoogleIdentityProviderList contains a list of GoogleIdentityProvider.
+type GoogleIdentityProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []GoogleIdentityProvider `json:"items"`
+}
+
+// FindAll gets a complete list of resources in the cluster for this type.
+func (google *GoogleIdentityProvider) FindAll(
+	ctx context.Context,
+	c kubernetes.Client,
+) ([]GoogleIdentityProvider, error) {
+	objects := &GoogleIdentityProviderList{}
+
+	if err := c.List(ctx, objects); err != nil {
+		return []GoogleIdentityProvider{}, fmt.Errorf("unable to retrieve google identity providers - %w", err)
+	}
+
+	return objects.Items, nil
+}
+
+// FindAllByClusterID gets a list of resources which have a particular cluster ID in the status field.
+func (google *GoogleIdentityProvider) FindAllByClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) ([]*GoogleIdentityProvider, error) {
+	objects, err := google.FindAll(ctx, c)
+	if err != nil {
+		return []*GoogleIdentityProvider{}, err
+	}
+
+	matches := []*GoogleIdentityProvider{}
+
+	for i := range objects {
+		if objects[i].Status.ClusterID == clusterID {
+			matches = append(matches, &objects[i])
+		}
+	}
+
+	return matches, nil
+}
+
+// ExistsForClusterID returns if a particular object is associated with a cluster ID.
+func (google *GoogleIdentityProvider) ExistsForClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) (bool, error) {
+	objects, err := google.FindAllByClusterID(ctx, c, clusterID)
+
+	return (len(objects) > 0), err
+}
+
+// GetClusterID gets the status.clusterID field from the object.  It is used to
+// satisfy the Workload interface.
+func (google *GoogleIdentityProvider) GetClusterID() string {
+	return google.Status.ClusterID
+}
+
+// GetConditions returns the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (google *GoogleIdentityProvider) GetConditions() []metav1.Condition {
+	return google.Status.Conditions
+}
+
+// SetConditions sets the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (google *GoogleIdentityProvider) SetConditions(conditions []metav1.Condition) {
+	google.Status.Conditions = conditions
+}
+
+// CopyFrom copies a Google Identity provider into an object that is able to be reconciled.
+func (google *GoogleIdentityProvider) CopyFrom(source *clustersmgmtv1.IdentityProvider) {
+	google.Spec.MappingMethod = string(source.MappingMethod())
+	google.Spec.ClientID = source.Google().ClientID()
+	google.Spec.HostedDomain = source.Google().HostedDomain()
+}
+
+// Builder returns the builder object from a reconciler object.  This object is used to
+// pass into the OCM API for creating the object.
+func (google *GoogleIdentityProvider) Builder(clientSecret string) *clustersmgmtv1.IdentityProviderBuilder {
+	builder := clustersmgmtv1.NewIdentityProvider().
+		MappingMethod(clustersmgmtv1.IdentityProviderMappingMethod(google.Spec.MappingMethod)).
+		Name(google.Spec.DisplayName).
+		Type(clustersmgmtv1.IdentityProviderTypeGoogle)
+
+	if google.Status.ProviderID != "" {
+		builder.ID(google.Status.ProviderID)
+	}
+
+	googleIDP := clustersmgmtv1.NewGoogleIdentityProvider().
+		ClientSecret(clientSecret).
+		ClientID(google.Spec.ClientID)
+
+	if google.Spec.HostedDomain != "" {
+		googleIDP.HostedDomain(google.Spec.HostedDomain)
+	}
+
+	return builder.Google(googleIDP)
+}
+
+func init() {
+	SchemeBuilder.Register(&GoogleIdentityProvider{}, &GoogleIdentityProviderList{})
+}
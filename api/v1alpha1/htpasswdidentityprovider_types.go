@@ -0,0 +1,226 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// HTPasswdIdentityProviderSpec defines the desired state of HTPasswdIdentityProvider.
+//
+//nolint:lll
+type HTPasswdIdentityProviderSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// userSecrets is a required list of references to secrets by name which contain the users
+	// for this identity provider.  Each key in the secret data is a username and the value is
+	// either a plain text password or a bcrypt hash of the password.  A username may only be
+	// defined in a single secret.  These should exist in the same namespace as the resource.
+	UserSecrets []configv1.SecretNameReference `json:"userSecrets"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=claim
+	// +kubebuilder:validation:Enum=claim;lookup;generate;add
+	// Mapping method to use for the identity provider.
+	// See https://docs.openshift.com/container-platform/latest/authentication/understanding-identity-provider.html#identity-provider-parameters_understanding-identity-provider
+	// for a detailed description of what these mean.  Must be one of claim (default), lookup, generate, or add.
+	MappingMethod string `json:"mappingMethod,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:message="clusterName is immutable",rule=(self == oldSelf)
+	// Cluster name in OpenShift Cluster Manager by which this should be managed for.  A cluster with this
+	// name should exist in the organization by which the operator is associated.  If the cluster does
+	// not exist, the reconciliation process will continue until one does.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=4
+	// +kubebuilder:validation:MaxLength=15
+	// +kubebuilder:validation:XValidation:message="displayName is immutable",rule=(self == oldSelf)
+	// Friendly display name as displayed in the OpenShift Cluster Manager
+	// console.  If this is empty, the metadata.name field of the parent resource is used
+	// to construct the display name.  This is limited to 15 characters as per the backend
+	// API limitation.
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// HTPasswdIdentityProviderStatus defines the observed state of HTPasswdIdentityProvider.
+type HTPasswdIdentityProviderStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.clusterID is immutable",rule=(self == oldSelf)
+	// Represents the programmatic cluster ID of the cluster, as
+	// determined during reconciliation.  This is used to reduce
+	// the number of API calls to look up a cluster ID based on
+	// the cluster name.
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// Represents the programmatic identity provider ID of the IDP, as
+	// observed in OpenShift Cluster Manager during reconciliation.  This is
+	// used to manage the users of the identity provider, so it follows the
+	// identity provider if it is recreated outside of the operator.
+	ProviderID string `json:"providerID,omitempty"`
+
+	// Represents a checksum of the credentials for each user, keyed by username, which
+	// was last synchronized to OpenShift Cluster Manager.  OpenShift Cluster Manager does not
+	// return passwords, so this is used to determine when a password has changed.  The
+	// checksum is an HMAC keyed by the OpenShift Cluster Manager token of the operator, so
+	// that it may not be used to guess passwords.
+	UserChecksums map[string]string `json:"userChecksums,omitempty"`
+}
+
+// +kubebuilder:resource:categories=idps;identityproviders
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// HTPasswdIdentityProvider is the Schema for the htpasswdidentityproviders API.
+type HTPasswdIdentityProvider struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   HTPasswdIdentityProviderSpec   `json:"spec,omitempty"`
+	Status HTPasswdIdentityProviderStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// HTPasswdIdentityProviderList contains a list of HTPasswdIdentityProvider.
+type HTPasswdIdentityProviderList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []HTPasswdIdentityProvider `json:"items"`
+}
+
+// FindAll gets a complete list of resources in the cluster for this type.
+func (htpasswd *HTPasswdIdentityProvider) FindAll(
+	ctx context.Context,
+	c kubernetes.Client,
+) ([]HTPasswdIdentityProvider, error) {
+	objects := &HTPasswdIdentityProviderList{}
+
+	if err := c.List(ctx, objects); err != nil {
+		return []HTPasswdIdentityProvider{}, fmt.Errorf("unable to retrieve htpasswd identity providers - %w", err)
+	}
+
+	return objects.Items, nil
+}
+
+// FindAllByClusterID gets a list of resources which have a particular cluster ID in the status field.
+func (htpasswd *HTPasswdIdentityProvider) FindAllByClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) ([]*HTPasswdIdentityProvider, error) {
+	objects, err := htpasswd.FindAll(ctx, c)
+	if err != nil {
+		return []*HTPasswdIdentityProvider{}, err
+	}
+
+	matches := []*HTPasswdIdentityProvider{}
+
+	for i := range objects {
+		if objects[i].Status.ClusterID == clusterID {
+			matches = append(matches, &objects[i])
+		}
+	}
+
+	return matches, nil
+}
+
+// ExistsForClusterID returns if a particular object is associated with a cluster ID.
+func (htpasswd *HTPasswdIdentityProvider) ExistsForClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) (bool, error) {
+	objects, err := htpasswd.FindAllByClusterID(ctx, c, clusterID)
+
+	return (len(objects) > 0), err
+}
+
+// GetClusterID gets the status.clusterID field from the object.  It is used to
+// satisfy the Workload interface.
+func (htpasswd *HTPasswdIdentityProvider) GetClusterID() string {
+	return htpasswd.Status.ClusterID
+}
+
+// GetConditions returns the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (htpasswd *HTPasswdIdentityProvider) GetConditions() []metav1.Condition {
+	return htpasswd.Status.Conditions
+}
+
+// SetConditions sets the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (htpasswd *HTPasswdIdentityProvider) SetConditions(conditions []metav1.Condition) {
+	htpasswd.Status.Conditions = conditions
+}
+
+// CopyFrom copies relevant fields from an HTPasswd Identity provider into an object that is able to be reconciled.
+func (htpasswd *HTPasswdIdentityProvider) CopyFrom(source *clustersmgmtv1.IdentityProvider) {
+	htpasswd.Spec.MappingMethod = string(source.MappingMethod())
+}
+
+// Builder returns the builder object from a reconciler object.  This object is used to
+// pass into the OCM API for creating the object.  The users are only used upon creation
+// as OCM requires at least one user to exist when creating an htpasswd identity provider.
+// Subsequent user changes are managed via the htpasswd users API.
+func (htpasswd *HTPasswdIdentityProvider) Builder(users map[string]string) *clustersmgmtv1.IdentityProviderBuilder {
+	builder := clustersmgmtv1.NewIdentityProvider().
+		MappingMethod(clustersmgmtv1.IdentityProviderMappingMethod(htpasswd.Spec.MappingMethod)).
+		Name(htpasswd.Spec.DisplayName).
+		Type(clustersmgmtv1.IdentityProviderTypeHtpasswd)
+
+	if htpasswd.Status.ProviderID != "" {
+		builder.ID(htpasswd.Status.ProviderID)
+	}
+
+	if len(users) == 0 {
+		return builder
+	}
+
+	// sort the usernames so that the request is deterministic
+	usernames := make([]string, 0, len(users))
+	for username := range users {
+		usernames = append(usernames, username)
+	}
+
+	sort.Strings(usernames)
+
+	userBuilders := make([]*clustersmgmtv1.HTPasswdUserBuilder, len(usernames))
+	for i, username := range usernames {
+		userBuilders[i] = ocm.NewHTPasswdUserBuilder(username, users[username])
+	}
+
+	return builder.Htpasswd(
+		clustersmgmtv1.NewHTPasswdIdentityProvider().
+			Users(clustersmgmtv1.NewHTPasswdUserList().Items(userBuilders...)),
+	)
+}
+
+func init() {
+	SchemeBuilder.Register(&HTPasswdIdentityProvider{}, &HTPasswdIdentityProviderList{})
+}
@@ -0,0 +1,241 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+)
+
+// IngressSpec defines the desired state of Ingress.
+type IngressSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:message="clusterName is immutable",rule=(self == oldSelf)
+	// Cluster name in OpenShift Cluster Manager by which this should be managed for.  A cluster with this
+	// name should exist in the organization by which the operator is associated.  If the cluster does
+	// not exist, the reconciliation process will continue until one does.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	// +kubebuilder:validation:XValidation:message="default is immutable",rule=(self == oldSelf)
+	// Manage the default ingress of the cluster.  The default ingress is created along with the
+	// cluster and is never created or deleted by the operator.  If this is false, an additional
+	// ingress is created, which is only valid for clusters which are not using a hosted control plane.
+	Default bool `json:"default,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=external;internal
+	// Listening method of the ingress.  An external ingress is accessible from the internet while
+	// an internal ingress is only accessible from within the VPC.  If this is empty, the value
+	// determined by OpenShift Cluster Manager is used.
+	Listening string `json:"listening,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=classic;nlb
+	// Type of AWS load balancer used by the ingress.  If this is empty, the value determined by
+	// OpenShift Cluster Manager is used.
+	LoadBalancerType string `json:"loadBalancerType,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Labels which select the routes that are served by the ingress.  If this is empty, all routes
+	// are served by the ingress.
+	RouteSelectors map[string]string `json:"routeSelectors,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	// Namespaces whose routes are not served by the ingress.
+	ExcludedNamespaces []string `json:"excludedNamespaces,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=WildcardsAllowed;WildcardsDisallowed
+	// Whether routes with a wildcard host are admitted by the ingress.  If this is empty, the value
+	// determined by OpenShift Cluster Manager is used.
+	RouteWildcardPolicy string `json:"routeWildcardPolicy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=Strict;InterNamespaceAllowed
+	// Whether routes may claim the same host name across namespaces.  If this is empty, the value
+	// determined by OpenShift Cluster Manager is used.
+	RouteNamespaceOwnershipPolicy string `json:"routeNamespaceOwnershipPolicy,omitempty"`
+}
+
+// IngressStatus defines the observed state of Ingress.
+type IngressStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.clusterID is immutable",rule=(self == oldSelf)
+	// Represents the programmatic cluster ID of the cluster, as
+	// determined during reconciliation.  This is used to reduce
+	// the number of API calls to look up a cluster ID based on
+	// the cluster name.
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.Hosted is immutable",rule=(self == oldSelf)
+	// Whether this cluster is using a hosted control plane.  Clusters which are using a hosted
+	// control plane only support the default ingress.
+	Hosted bool `json:"hosted,omitempty"`
+
+	// Represents the programmatic ingress ID, as determined during reconciliation.
+	IngressID string `json:"ingressID,omitempty"`
+
+	// DNS name of the ingress, as reported by OpenShift Cluster Manager.
+	DNSName string `json:"dnsName,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// Ingress is the Schema for the ingresses API.
+type Ingress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   IngressSpec   `json:"spec,omitempty"`
+	Status IngressStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// IngressList contains a list of Ingress.
+type IngressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Ingress `json:"items"`
+}
+
+// FindAll gets a complete list of resources in the cluster for this type.
+func (ingress *Ingress) FindAll(
+	ctx context.Context,
+	c kubernetes.Client,
+) ([]Ingress, error) {
+	objects := &IngressList{}
+
+	if err := c.List(ctx, objects); err != nil {
+		return []Ingress{}, fmt.Errorf("unable to retrieve ingresses - %w", err)
+	}
+
+	return objects.Items, nil
+}
+
+// FindAllByClusterID gets a list of resources which have a particular cluster ID in the status field.
+func (ingress *Ingress) FindAllByClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) ([]*Ingress, error) {
+	objects, err := ingress.FindAll(ctx, c)
+	if err != nil {
+		return []*Ingress{}, err
+	}
+
+	matches := []*Ingress{}
+
+	for i := range objects {
+		if objects[i].Status.ClusterID == clusterID {
+			matches = append(matches, &objects[i])
+		}
+	}
+
+	return matches, nil
+}
+
+// ExistsForClusterID returns if a particular object is associated with a cluster ID.
+func (ingress *Ingress) ExistsForClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) (bool, error) {
+	objects, err := ingress.FindAllByClusterID(ctx, c, clusterID)
+
+	return (len(objects) > 0), err
+}
+
+// GetClusterID gets the status.clusterID field from the object.  It is used to
+// satisfy the Workload interface.
+func (ingress *Ingress) GetClusterID() string {
+	return ingress.Status.ClusterID
+}
+
+// GetConditions returns the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (ingress *Ingress) GetConditions() []metav1.Condition {
+	return ingress.Status.Conditions
+}
+
+// SetConditions sets the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (ingress *Ingress) SetConditions(conditions []metav1.Condition) {
+	ingress.Status.Conditions = conditions
+}
+
+// CopyFrom copies an OCM Ingress object into an Ingress object that is recognizable by this
+// controller.
+func (ingress *Ingress) CopyFrom(source *clustersmgmtv1.Ingress) {
+	ingress.Spec.Default = source.Default()
+	ingress.Spec.Listening = string(source.Listening())
+	ingress.Spec.LoadBalancerType = string(source.LoadBalancerType())
+	ingress.Spec.RouteSelectors = source.RouteSelectors()
+	ingress.Spec.ExcludedNamespaces = source.ExcludedNamespaces()
+	ingress.Spec.RouteWildcardPolicy = string(source.RouteWildcardPolicy())
+	ingress.Spec.RouteNamespaceOwnershipPolicy = string(source.RouteNamespaceOwnershipPolicy())
+	ingress.Status.IngressID = source.ID()
+	ingress.Status.DNSName = source.DNSName()
+}
+
+// Builder returns the builder object from a reconciler object.  This object is used to
+// pass into the OCM API for creating the object.
+func (ingress *Ingress) Builder() *clustersmgmtv1.IngressBuilder {
+	builder := clustersmgmtv1.NewIngress().
+		Default(ingress.Spec.Default).
+		RouteSelectors(ingress.Spec.RouteSelectors).
+		ExcludedNamespaces(ingress.Spec.ExcludedNamespaces...)
+
+	if ingress.Status.IngressID != "" {
+		builder = builder.ID(ingress.Status.IngressID)
+	}
+
+	// only set the values which have been requested and let ocm determine the remaining values
+	if ingress.Spec.Listening != "" {
+		builder = builder.Listening(clustersmgmtv1.ListeningMethod(ingress.Spec.Listening))
+	}
+
+	if ingress.Spec.LoadBalancerType != "" {
+		builder = builder.LoadBalancerType(clustersmgmtv1.LoadBalancerFlavor(ingress.Spec.LoadBalancerType))
+	}
+
+	if ingress.Spec.RouteWildcardPolicy != "" {
+		builder = builder.RouteWildcardPolicy(clustersmgmtv1.WildcardPolicy(ingress.Spec.RouteWildcardPolicy))
+	}
+
+	if ingress.Spec.RouteNamespaceOwnershipPolicy != "" {
+		builder = builder.RouteNamespaceOwnershipPolicy(
+			clustersmgmtv1.NamespaceOwnershipPolicy(ingress.Spec.RouteNamespaceOwnershipPolicy),
+		)
+	}
+
+	return builder
+}
+
+func init() {
+	SchemeBuilder.Register(&Ingress{}, &IngressList{})
+}
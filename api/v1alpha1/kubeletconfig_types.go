@@ -0,0 +1,183 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"fmt"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+)
+
+// KubeletConfigSpec defines the desired state of KubeletConfig.
+type KubeletConfigSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:message="clusterName is immutable",rule=(self == oldSelf)
+	// Cluster name in OpenShift Cluster Manager by which this should be managed for.  A cluster with this
+	// name should exist in the organization by which the operator is associated.  If the cluster does
+	// not exist, the reconciliation process will continue until one does.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:message="displayName is immutable",rule=(self == oldSelf)
+	// Name of the kubelet config as it appears in OpenShift Cluster Manager.  If this is empty, the
+	// metadata.name field of the parent resource is used.  For clusters using a hosted control
+	// plane, machine pools reference the kubelet config by this name.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=4096
+	// +kubebuilder:validation:Maximum=16384
+	// Maximum number of processes (PIDs) allowed per pod.
+	PodPidsLimit int `json:"podPidsLimit,omitempty"`
+}
+
+// KubeletConfigStatus defines the observed state of KubeletConfig.
+type KubeletConfigStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.clusterID is immutable",rule=(self == oldSelf)
+	// Represents the programmatic cluster ID of the cluster, as
+	// determined during reconciliation.  This is used to reduce
+	// the number of API calls to look up a cluster ID based on
+	// the cluster name.
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.Hosted is immutable",rule=(self == oldSelf)
+	// Whether this cluster is using a hosted control plane.  Clusters which are not using a hosted
+	// control plane have a single, cluster-wide kubelet config which applies to all nodes.
+	Hosted bool `json:"hosted,omitempty"`
+
+	// Represents the programmatic kubelet config ID, as determined during reconciliation.
+	// This is only set for clusters using a hosted control plane.
+	ConfigID string `json:"configID,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// KubeletConfig is the Schema for the kubeletconfigs API.
+type KubeletConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   KubeletConfigSpec   `json:"spec,omitempty"`
+	Status KubeletConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// KubeletConfigList contains a list of KubeletConfig.
+type KubeletConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []KubeletConfig `json:"items"`
+}
+
+// FindAll gets a complete list of resources in the cluster for this type.
+func (kubeletConfig *KubeletConfig) FindAll(
+	ctx context.Context,
+	c kubernetes.Client,
+) ([]KubeletConfig, error) {
+	objects := &KubeletConfigList{}
+
+	if err := c.List(ctx, objects); err != nil {
+		return []KubeletConfig{}, fmt.Errorf("unable to retrieve kubelet configs - %w", err)
+	}
+
+	return objects.Items, nil
+}
+
+// FindAllByClusterID gets a list of resources which have a particular cluster ID in the status field.
+func (kubeletConfig *KubeletConfig) FindAllByClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) ([]*KubeletConfig, error) {
+	objects, err := kubeletConfig.FindAll(ctx, c)
+	if err != nil {
+		return []*KubeletConfig{}, err
+	}
+
+	matches := []*KubeletConfig{}
+
+	for i := range objects {
+		if objects[i].Status.ClusterID == clusterID {
+			matches = append(matches, &objects[i])
+		}
+	}
+
+	return matches, nil
+}
+
+// ExistsForClusterID returns if a particular object is associated with a cluster ID.
+func (kubeletConfig *KubeletConfig) ExistsForClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) (bool, error) {
+	objects, err := kubeletConfig.FindAllByClusterID(ctx, c, clusterID)
+
+	return (len(objects) > 0), err
+}
+
+// GetClusterID gets the status.clusterID field from the object.  It is used to
+// satisfy the Workload interface.
+func (kubeletConfig *KubeletConfig) GetClusterID() string {
+	return kubeletConfig.Status.ClusterID
+}
+
+// GetConditions returns the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (kubeletConfig *KubeletConfig) GetConditions() []metav1.Condition {
+	return kubeletConfig.Status.Conditions
+}
+
+// SetConditions sets the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (kubeletConfig *KubeletConfig) SetConditions(conditions []metav1.Condition) {
+	kubeletConfig.Status.Conditions = conditions
+}
+
+// CopyFrom copies an OCM KubeletConfig object into a KubeletConfig object that is recognizable by this
+// controller.
+func (kubeletConfig *KubeletConfig) CopyFrom(source *clustersmgmtv1.KubeletConfig) {
+	kubeletConfig.Spec.PodPidsLimit = source.PodPidsLimit()
+}
+
+// Builder returns the builder object from a reconciler object.  This object is used to
+// pass into the OCM API for creating the object.
+func (kubeletConfig *KubeletConfig) Builder() *clustersmgmtv1.KubeletConfigBuilder {
+	builder := clustersmgmtv1.NewKubeletConfig().
+		Name(kubeletConfig.Spec.DisplayName).
+		PodPidsLimit(kubeletConfig.Spec.PodPidsLimit)
+
+	if kubeletConfig.Status.ConfigID != "" {
+		builder = builder.ID(kubeletConfig.Status.ConfigID)
+	}
+
+	return builder
+}
+
+func init() {
+	SchemeBuilder.Register(&KubeletConfig{}, &KubeletConfigList{})
+}
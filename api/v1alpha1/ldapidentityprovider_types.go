@@ -123,6 +123,11 @@ type LDAPIdentityProviderStatus struct {
 	// the number of API calls to look up a cluster ID based on
 	// the identity provider name.
 	ProviderID string `json:"providerID,omitempty"`
+
+	// Represents a checksum of the data from the secrets and config maps referenced by
+	// this object, as last applied to OpenShift Cluster Manager.  OpenShift Cluster Manager
+	// does not return this data, so this is used to determine when it has changed.
+	ReferenceHash string `json:"referenceHash,omitempty"`
 }
 
 // +kubebuilder:resource:categories=idps;identityproviders
@@ -233,6 +238,10 @@ func (ldap *LDAPIdentityProvider) Builder(ca, bindPassword string) *clustersmgmt
 		Name(ldap.Spec.DisplayName).
 		Type(clustersmgmtv1.IdentityProviderTypeLDAP)
 
+	if ldap.Status.ProviderID != "" {
+		builder.ID(ldap.Status.ProviderID)
+	}
+
 	return builder.LDAP(
 		clustersmgmtv1.NewLDAPIdentityProvider().
 			URL(ldap.Spec.URL).
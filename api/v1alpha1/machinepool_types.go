@@ -28,10 +28,20 @@ import (
 	"github.com/rh-mobb/ocm-operator/pkg/ocm"
 )
 
+const (
+	defaultMachinePoolInstanceType = "m5.xlarge"
+
+	nodeDrainGracePeriodUnitMinutes = "minutes"
+	nodeDrainGracePeriodUnitHours   = "hours"
+
+	minutesPerHour = 60
+)
+
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // +kubebuilder:validation:XValidation:message="maximumNodesPerZone must be greater than or equal to minimumNodesPerZone",rule=(self.maximumNodesPerZone == 0 || self.minimumNodesPerZone <= self.maximumNodesPerZone)
+// +kubebuilder:validation:XValidation:message="subnet and availabilityZones are mutually exclusive",rule=!(has(self.subnet) && has(self.availabilityZones))
 // MachinePoolSpec defines the desired state of MachinePool.
 //
 //nolint:lll
@@ -62,17 +72,155 @@ type MachinePoolSpec struct {
 
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=true
-	// Wait for the machine pool to enter a ready state.  If this is set to true, it is
-	// assumed that the operator is running in the cluster that machine pools are
-	// being controlled for.  This is due to a limitation in the OCM API which does
-	// not expose the ready state of a machine pool.  If this is set to false, the
-	// reconciler will perform a "fire and forget" approach and assume if the object
-	// is created, it will eventually be correctly reconciled.
+	// Wait for the machine pool to enter a ready state.  The machine pool is ready when at least
+	// the minimum number of nodes are ready.  If the operator is running in the cluster that machine
+	// pools are being controlled for, the nodes of the machine pool are checked directly.  Otherwise,
+	// the current number of nodes is retrieved from OCM, which is only possible for clusters using
+	// a hosted control plane.  If this is set to false, the reconciler will perform a "fire and
+	// forget" approach and assume if the object is created, it will eventually be correctly reconciled.
 	Wait bool `json:"wait,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=30
+	// +kubebuilder:validation:Minimum=1
+	// Amount of time, in minutes, to wait for the machine pool to enter a ready state before
+	// giving up and setting a failure condition.  This is only relevant if spec.wait is true.
+	WaitTimeoutMinutes int `json:"waitTimeoutMinutes,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="subnet is immutable",rule=(self == oldSelf)
+	// Subnet ID to place the nodes of this machine pool in.  This must be one of the subnets
+	// of the parent cluster and is only valid for clusters which were provisioned into an
+	// existing VPC.  Because a subnet exists in a single availability zone, the nodes are placed
+	// in a single availability zone.  Mutually exclusive with spec.availabilityZones.
+	Subnet string `json:"subnet,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="availabilityZones is immutable",rule=(self == oldSelf)
+	// +listType=set
+	// Availability zones to place the nodes of this machine pool in.  These must be a subset of
+	// the availability zones of the parent cluster.  If unset, the nodes are spread across
+	// all availability zones of the parent cluster.  Only a single availability zone may be
+	// set for clusters using a hosted control plane.  Mutually exclusive with spec.subnet.
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="openshiftVersion must either be blank or valid x.y.z format",rule=(self == "" || self.split(".").size() == 3)
+	// +kubebuilder:validation:XValidation:message="openshiftVersion cannot start with a 'v'",rule=(!self.startsWith('v'))
+	// OpenShift version of the nodes in this MachinePool.  Version must be in format of x.y.z.  This
+	// is only valid for clusters using a hosted control plane and is ignored otherwise, as the nodes
+	// of other clusters always follow the version of the cluster.  If this is empty, the nodes
+	// follow the version of the cluster and are upgraded when the cluster is upgraded.  The version
+	// may not exceed the version of the cluster and may only be increased, which upgrades the
+	// nodes in this MachinePool.
+	OpenShiftVersion string `json:"openshiftVersion,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Configuration of how the nodes in this MachinePool are replaced during an upgrade.  This
+	// is only valid for clusters using a hosted control plane and is ignored otherwise.
+	Upgrade MachinePoolUpgrade `json:"upgrade,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=10080
+	// Amount of time, in minutes, that nodes in this MachinePool are allowed to drain their
+	// workloads before being forcefully removed during a scale down or an upgrade.  Pod disruption
+	// budgets are respected until this time has passed.  If this is 0, pod disruption budgets are
+	// respected indefinitely.  The maximum is 10080 (1 week).  This is only valid for clusters using
+	// a hosted control plane and is ignored otherwise, as other clusters configure the node drain
+	// grace period on the cluster.
+	NodeDrainGracePeriodMinutes int `json:"nodeDrainGracePeriodMinutes,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	// Automatically repair nodes in this MachinePool which are determined to be unhealthy.
+	// This is only valid for clusters using a hosted control plane and is ignored otherwise.
+	AutoRepair *bool `json:"autoRepair,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=1
+	// +listType=set
+	// Names of the kubelet configs, as they appear in OpenShift Cluster Manager, to apply to the
+	// nodes in this MachinePool.  These are typically managed with the KubeletConfig resource and
+	// must exist for the same cluster.  This is only valid for clusters using a hosted control plane
+	// and is ignored otherwise, as other clusters use a single, cluster-wide kubelet config.
+	KubeletConfigs []string `json:"kubeletConfigs,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=set
+	// Names of the tuning configs, as they appear in OpenShift Cluster Manager, to apply to the
+	// nodes in this MachinePool.  These are typically managed with the TuningConfig resource and
+	// must exist for the same cluster.  This is only valid for clusters using a hosted control plane
+	// and is ignored otherwise.
+	TuningConfigs []string `json:"tuningConfigs,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// Represents the AWS provider specific configuration options.
 	AWS MachinePoolProviderAWS `json:"aws,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// Schedules which override the number of nodes of this MachinePool during recurring windows
+	// of time (e.g. to scale down overnight).  If multiple schedules are active at the same time,
+	// the first active schedule in the list is used.  When no schedule is active, the
+	// spec.minimumNodesPerZone and spec.maximumNodesPerZone fields are used.
+	Schedules []MachinePoolSchedule `json:"schedules,omitempty"`
+}
+
+// MachinePoolSchedule represents a recurring window of time during which the number of nodes
+// of a MachinePool is overridden.
+type MachinePoolSchedule struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Unique name of the schedule.  This is reported in status.activeSchedule while
+	// the schedule is active.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Cron expression, in standard five field format (e.g. '0 19 * * 1-5'), representing the
+	// start of each window in which this schedule is active.
+	Schedule string `json:"schedule"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="UTC"
+	// IANA time zone (e.g. 'America/New_York') in which the cron expression is evaluated.
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10080
+	// Length of each window in which this schedule is active, in minutes.
+	DurationMinutes int `json:"durationMinutes"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	// Minimum amount of nodes allowed per availability zone while this schedule is active.
+	// Only machine pools other than the default machine pool of a cluster may be scaled to 0.
+	MinimumNodesPerZone int `json:"minimumNodesPerZone"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	// Maximum amount of nodes allowed per availability zone while this schedule is active.  If
+	// this field is set, autoscaling will be enabled while this schedule is active.
+	MaximumNodesPerZone int `json:"maximumNodesPerZone,omitempty"`
+}
+
+// MachinePoolUpgrade represents the configuration of how nodes are replaced during an upgrade.
+type MachinePoolUpgrade struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+%?$`
+	// Maximum number of nodes, or percentage of nodes (e.g. 10%), that may be created above
+	// the desired number of nodes during an upgrade.  If unset, the default of the provider
+	// is used.
+	MaxSurge string `json:"maxSurge,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Pattern=`^[0-9]+%?$`
+	// Maximum number of nodes, or percentage of nodes (e.g. 10%), that may be unavailable
+	// during an upgrade.  If unset, the default of the provider is used.
+	MaxUnavailable string `json:"maxUnavailable,omitempty"`
 }
 
 // DefaultMachinePoolFields represents the fields relevant to the default machine pool.  It
@@ -94,11 +242,12 @@ type DefaultMachinePoolFields struct {
 	// for this machine pool.
 	MaximumNodesPerZone int `json:"maximumNodesPerZone,omitempty"`
 
-	// +kubebuilder:validation:Required
-	// +kubebuilder:default="m5.xlarge"
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:XValidation:message="instanceType is immutable",rule=(self == oldSelf)
-	// Instance type to use for all nodes within this MachinePool.  Please see the following for
-	// a list of supported instance types based on the provider type (ROSA/OSD only supported for now):
+	// Instance type to use for all nodes within this MachinePool (default: m5.xlarge).  The default
+	// machine pool of a ROSACluster uses the instance type of its ROSAClusterClass, if any, when this
+	// is unset.  Please see the following for a list of supported instance types based on the provider
+	// type (ROSA/OSD only supported for now):
 	//
 	// *ROSA/OSD: https://docs.openshift.com/rosa/rosa_architecture/rosa_policy_service_definition/rosa-service-definition.html
 	InstanceType string `json:"instanceType,omitempty"`
@@ -120,6 +269,36 @@ type MachinePoolProviderAWS struct {
 	// is not valid and is ignored if the cluster is using hosted
 	// control plane.
 	SpotInstances MachinePoolProviderAWSSpotInstances `json:"spotInstances,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=75
+	// +kubebuilder:validation:Maximum=16384
+	// +kubebuilder:validation:XValidation:message="aws.rootVolumeSize is immutable",rule=(self == oldSelf)
+	// Size of the root volume of the nodes in this MachinePool, in GiB.  If unset, the default
+	// root volume size of the provider is used.
+	RootVolumeSize int `json:"rootVolumeSize,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="aws.additionalSecurityGroupIDs is immutable",rule=(self == oldSelf)
+	// +listType=set
+	// Additional security group IDs to attach to the nodes in this MachinePool, in addition to the
+	// security groups of the cluster.  This is only valid for clusters which were provisioned
+	// into an existing VPC.
+	AdditionalSecurityGroupIDs []string `json:"additionalSecurityGroupIDs,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Enum=optional;required
+	// +kubebuilder:validation:XValidation:message="aws.instanceMetadataHTTPTokens is immutable",rule=(self == oldSelf)
+	// Whether the use of IMDSv2 tokens is required by the instance metadata service of the nodes
+	// in this MachinePool.  Must be one of optional or required.  If unset, the default of the
+	// provider is used.  This is only valid for clusters using a hosted control plane and is
+	// ignored otherwise, as it is configured on the cluster for other clusters.
+	InstanceMetadataHTTPTokens string `json:"instanceMetadataHTTPTokens,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="aws.tags is immutable",rule=(self == oldSelf)
+	// Additional AWS resource tags to apply to the resources created for this MachinePool.
+	Tags map[string]string `json:"tags,omitempty"`
 }
 
 // MachinePoolProviderAWSSpotInstances represents the AWS Spot Instance configuration.
@@ -161,6 +340,33 @@ type MachinePoolStatus struct {
 	// +kubebuilder:validation:XValidation:message="status.Hosted is immutable",rule=(self == oldSelf)
 	// Whether this cluster is using a hosted control plane.
 	Hosted bool `json:"hosted,omitempty"`
+
+	// Represents the OpenShift version of the nodes in this MachinePool, as reported
+	// by OpenShift Cluster Manager.  This is only set for clusters using a hosted
+	// control plane.
+	OpenShiftVersion string `json:"openshiftVersion,omitempty"`
+
+	// Represents the progress of the most recent upgrade of the nodes in this MachinePool.
+	// This is only set for clusters using a hosted control plane.
+	Upgrade MachinePoolUpgradeStatus `json:"upgrade,omitempty"`
+
+	// Represents the name of the schedule in spec.schedules which is currently active.  This
+	// is empty if no schedule is active.
+	ActiveSchedule string `json:"activeSchedule,omitempty"`
+}
+
+// MachinePoolUpgradeStatus represents the progress of an upgrade of a MachinePool.
+type MachinePoolUpgradeStatus struct {
+	// Represents the OpenShift version that the nodes are being upgraded to.
+	Version string `json:"version,omitempty"`
+
+	// Represents the state of the upgrade as reported by OpenShift Cluster Manager
+	// (e.g. pending, scheduled, started, completed, delayed, failed or cancelled).
+	State string `json:"state,omitempty"`
+
+	// Represents a description of the state of the upgrade as reported by
+	// OpenShift Cluster Manager.
+	Description string `json:"description,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -249,6 +455,12 @@ func (machinePool *MachinePool) DesiredState() *MachinePool {
 	// that the current state should have these labels.
 	desiredState.SetMachinePoolLabels()
 
+	// set the instance type, which is not defaulted by the api server as the default
+	// machine pool of a cluster may inherit it from a cluster class
+	if desiredState.Spec.InstanceType == "" {
+		desiredState.Spec.InstanceType = defaultMachinePoolInstanceType
+	}
+
 	return desiredState
 }
 
@@ -280,6 +492,30 @@ func (machinePool *MachinePool) GetDisplayName() string {
 	return machinePool.Spec.DisplayName
 }
 
+// ReferencesKubeletConfig determines if the MachinePool object references a kubelet config
+// by its name in OCM.
+func (machinePool *MachinePool) ReferencesKubeletConfig(name string) bool {
+	for i := range machinePool.Spec.KubeletConfigs {
+		if machinePool.Spec.KubeletConfigs[i] == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ReferencesTuningConfig determines if the MachinePool object references a tuning config
+// by its name in OCM.
+func (machinePool *MachinePool) ReferencesTuningConfig(name string) bool {
+	for i := range machinePool.Spec.TuningConfigs {
+		if machinePool.Spec.TuningConfigs[i] == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 // SetMachinePoolLabels sets the required labels on the object.
 func (machinePool *MachinePool) SetMachinePoolLabels() {
 	// create the labels if unset
@@ -317,6 +553,7 @@ func (machinePool *MachinePool) CopyFromMachinePool(source *clustersmgmtv1.Machi
 	machinePool.Spec.MinimumNodesPerZone = copyMachinePoolMinimumNodesPerZone(source)
 	machinePool.Spec.MaximumNodesPerZone = copyMachinePoolMaximumNodesPerZone(source)
 	machinePool.Spec.AWS = copyAWSConfig(source.AWS())
+	machinePool.Spec.AWS.RootVolumeSize = source.RootVolume().AWS().Size()
 
 	return nil
 }
@@ -332,8 +569,17 @@ func (machinePool *MachinePool) CopyFromNodePool(source *clustersmgmtv1.NodePool
 	machinePool.Spec.MinimumNodesPerZone = copyNodePoolMinimumNodesPerZone(source)
 	machinePool.Spec.MaximumNodesPerZone = copyNodePoolMaximumNodesPerZone(source)
 
-	// spot instances for node pools are not an option
-	machinePool.Spec.AWS = MachinePoolProviderAWS{}
+	machinePool.Spec.AWS = copyAWSNodePoolConfig(source.AWSNodePool())
+	machinePool.Spec.Upgrade = MachinePoolUpgrade{
+		MaxSurge:       source.ManagementUpgrade().MaxSurge(),
+		MaxUnavailable: source.ManagementUpgrade().MaxUnavailable(),
+	}
+	machinePool.Spec.KubeletConfigs = source.KubeletConfigs()
+	machinePool.Spec.TuningConfigs = source.TuningConfigs()
+	machinePool.Spec.NodeDrainGracePeriodMinutes = copyNodeDrainGracePeriodMinutes(source.NodeDrainGracePeriod())
+	if autoRepair, ok := source.GetAutoRepair(); ok {
+		machinePool.Spec.AutoRepair = &autoRepair
+	}
 
 	return nil
 }
@@ -347,10 +593,26 @@ func (machinePool *MachinePool) MachinePoolBuilder() *clustersmgmtv1.MachinePool
 		Taints(machinePool.convertTaints()...).
 		AWS(machinePool.convertAWSMachinePool())
 
+	if machinePool.Spec.AWS.RootVolumeSize > 0 {
+		builder = builder.RootVolume(
+			clustersmgmtv1.NewRootVolume().AWS(
+				clustersmgmtv1.NewAWSVolume().Size(machinePool.Spec.AWS.RootVolumeSize),
+			),
+		)
+	}
+
+	if machinePool.Spec.Subnet != "" {
+		builder = builder.Subnets(machinePool.Spec.Subnet)
+	}
+
+	if len(machinePool.Spec.AvailabilityZones) > 0 {
+		builder = builder.AvailabilityZones(machinePool.Spec.AvailabilityZones...)
+	}
+
 	if machinePool.Spec.MaximumNodesPerZone > 0 {
 		builder = builder.Autoscaling(machinePool.convertMachinePoolAutoscaling())
 	} else {
-		builder = builder.Replicas(machinePool.Spec.MinimumNodesPerZone)
+		builder = builder.Replicas(machinePool.Spec.MinimumNodesPerZone * machinePool.availabilityZoneCount())
 	}
 
 	return builder
@@ -362,7 +624,37 @@ func (machinePool *MachinePool) NodePoolBuilder() *clustersmgmtv1.NodePoolBuilde
 		ID(machinePool.Spec.DisplayName).
 		Labels(machinePool.Spec.Labels).
 		Taints(machinePool.convertTaints()...).
-		AWSNodePool(clustersmgmtv1.NewAWSNodePool().InstanceType(machinePool.Spec.InstanceType))
+		AWSNodePool(machinePool.convertAWSNodePool())
+
+	if machinePool.Spec.AutoRepair != nil {
+		builder = builder.AutoRepair(*machinePool.Spec.AutoRepair)
+	}
+
+	if machinePool.Spec.NodeDrainGracePeriodMinutes > 0 {
+		builder = builder.NodeDrainGracePeriod(
+			clustersmgmtv1.NewValue().
+				Unit(nodeDrainGracePeriodUnitMinutes).
+				Value(float64(machinePool.Spec.NodeDrainGracePeriodMinutes)),
+		)
+	}
+
+	if machinePool.Spec.Upgrade.MaxSurge != "" || machinePool.Spec.Upgrade.MaxUnavailable != "" {
+		builder = builder.ManagementUpgrade(machinePool.convertNodePoolManagementUpgrade())
+	}
+
+	// always send the configs, even when empty, so that removing the last reference to a config
+	// detaches it from the node pool
+	builder = builder.
+		KubeletConfigs(machinePool.Spec.KubeletConfigs...).
+		TuningConfigs(machinePool.Spec.TuningConfigs...)
+
+	if machinePool.Spec.Subnet != "" {
+		builder = builder.Subnet(machinePool.Spec.Subnet)
+	}
+
+	if len(machinePool.Spec.AvailabilityZones) > 0 {
+		builder = builder.AvailabilityZone(machinePool.Spec.AvailabilityZones[0])
+	}
 
 	if machinePool.Spec.MaximumNodesPerZone > 0 {
 		builder = builder.Autoscaling(machinePool.convertNodePoolAutoscaling())
@@ -402,32 +694,108 @@ func (machinePool *MachinePool) convertMachinePoolAutoscaling() (builder *cluste
 
 func (machinePool *MachinePool) convertNodePoolAutoscaling() (builder *clustersmgmtv1.NodePoolAutoscalingBuilder) {
 	if machinePool.Spec.MaximumNodesPerZone > 0 {
+		// node pools exist in a single availability zone, so the per zone values are
+		// the total values
 		return clustersmgmtv1.NewNodePoolAutoscaling().
-			MinReplica(machinePool.Spec.MinimumNodesPerZone * machinePool.availabilityZoneCount()).
-			MaxReplica(machinePool.Spec.MaximumNodesPerZone * machinePool.availabilityZoneCount())
+			MinReplica(machinePool.Spec.MinimumNodesPerZone).
+			MaxReplica(machinePool.Spec.MaximumNodesPerZone)
 	}
 
 	return clustersmgmtv1.NewNodePoolAutoscaling()
 }
 
+func (machinePool *MachinePool) convertNodePoolManagementUpgrade() (builder *clustersmgmtv1.NodePoolManagementUpgradeBuilder) {
+	builder = clustersmgmtv1.NewNodePoolManagementUpgrade()
+
+	if machinePool.Spec.Upgrade.MaxSurge != "" {
+		builder = builder.MaxSurge(machinePool.Spec.Upgrade.MaxSurge)
+	}
+
+	if machinePool.Spec.Upgrade.MaxUnavailable != "" {
+		builder = builder.MaxUnavailable(machinePool.Spec.Upgrade.MaxUnavailable)
+	}
+
+	return builder
+}
+
 func (machinePool *MachinePool) convertAWSMachinePool() (builder *clustersmgmtv1.AWSMachinePoolBuilder) {
+	builder = clustersmgmtv1.NewAWSMachinePool()
+
 	if machinePool.Spec.AWS.SpotInstances.Enabled {
 		if machinePool.Spec.AWS.SpotInstances.MaximumPrice > 0 {
-			return clustersmgmtv1.NewAWSMachinePool().
-				SpotMarketOptions(
-					clustersmgmtv1.NewAWSSpotMarketOptions().
-						MaxPrice(float64(machinePool.Spec.AWS.SpotInstances.MaximumPrice)),
-				)
+			builder = builder.SpotMarketOptions(
+				clustersmgmtv1.NewAWSSpotMarketOptions().
+					MaxPrice(float64(machinePool.Spec.AWS.SpotInstances.MaximumPrice)),
+			)
 		} else {
-			return clustersmgmtv1.NewAWSMachinePool().
-				SpotMarketOptions(&clustersmgmtv1.AWSSpotMarketOptionsBuilder{})
+			builder = builder.SpotMarketOptions(&clustersmgmtv1.AWSSpotMarketOptionsBuilder{})
 		}
 	}
 
+	if len(machinePool.Spec.AWS.AdditionalSecurityGroupIDs) > 0 {
+		builder = builder.AdditionalSecurityGroupIds(machinePool.Spec.AWS.AdditionalSecurityGroupIDs...)
+	}
+
+	if len(machinePool.Spec.AWS.Tags) > 0 {
+		builder = builder.Tags(machinePool.Spec.AWS.Tags)
+	}
+
+	if builder.Empty() {
+		return nil
+	}
+
 	return builder
 }
 
+func (machinePool *MachinePool) convertAWSNodePool() (builder *clustersmgmtv1.AWSNodePoolBuilder) {
+	builder = clustersmgmtv1.NewAWSNodePool().InstanceType(machinePool.Spec.InstanceType)
+
+	if machinePool.Spec.AWS.RootVolumeSize > 0 {
+		builder = builder.RootVolume(clustersmgmtv1.NewAWSVolume().Size(machinePool.Spec.AWS.RootVolumeSize))
+	}
+
+	if len(machinePool.Spec.AWS.AdditionalSecurityGroupIDs) > 0 {
+		builder = builder.AdditionalSecurityGroupIds(machinePool.Spec.AWS.AdditionalSecurityGroupIDs...)
+	}
+
+	if machinePool.Spec.AWS.InstanceMetadataHTTPTokens != "" {
+		builder = builder.Ec2MetadataHttpTokens(
+			clustersmgmtv1.Ec2MetadataHttpTokens(machinePool.Spec.AWS.InstanceMetadataHTTPTokens),
+		)
+	}
+
+	if len(machinePool.Spec.AWS.Tags) > 0 {
+		builder = builder.Tags(machinePool.Spec.AWS.Tags)
+	}
+
+	return builder
+}
+
+// MinimumReplicas returns the minimum total number of nodes across all availability zones
+// of the machine pool.
+func (machinePool *MachinePool) MinimumReplicas() int {
+	if machinePool.Status.Hosted {
+		// node pools exist in a single availability zone, so the per zone value is
+		// the total value
+		return machinePool.Spec.MinimumNodesPerZone
+	}
+
+	return machinePool.Spec.MinimumNodesPerZone * machinePool.availabilityZoneCount()
+}
+
+// availabilityZoneCount returns the number of availability zones that the nodes of the machine
+// pool are placed in.  It is used to calculate the total number of replicas from the per zone
+// values.
 func (machinePool *MachinePool) availabilityZoneCount() int {
+	// a subnet exists in a single availability zone
+	if machinePool.Spec.Subnet != "" {
+		return 1
+	}
+
+	if len(machinePool.Spec.AvailabilityZones) > 0 {
+		return len(machinePool.Spec.AvailabilityZones)
+	}
+
 	return len(machinePool.Status.AvailabilityZones)
 }
 
@@ -483,15 +851,48 @@ func copyNodePoolMaximumNodesPerZone(source *clustersmgmtv1.NodePool) int {
 	return 0
 }
 
+func copyNodeDrainGracePeriodMinutes(source *clustersmgmtv1.Value) int {
+	if source == nil {
+		return 0
+	}
+
+	if source.Unit() == nodeDrainGracePeriodUnitHours {
+		return int(source.Value() * minutesPerHour)
+	}
+
+	return int(source.Value())
+}
+
 func copyAWSConfig(source *clustersmgmtv1.AWSMachinePool) MachinePoolProviderAWS {
 	if source == nil {
 		return MachinePoolProviderAWS{}
 	}
 
-	return MachinePoolProviderAWS{
-		SpotInstances: MachinePoolProviderAWSSpotInstances{
+	aws := MachinePoolProviderAWS{
+		AdditionalSecurityGroupIDs: source.AdditionalSecurityGroupIds(),
+		Tags:                       source.Tags(),
+	}
+
+	if source.SpotMarketOptions() != nil {
+		aws.SpotInstances = MachinePoolProviderAWSSpotInstances{
 			Enabled:      true,
 			MaximumPrice: int(source.SpotMarketOptions().MaxPrice()),
-		},
+		}
+	}
+
+	return aws
+}
+
+func copyAWSNodePoolConfig(source *clustersmgmtv1.AWSNodePool) MachinePoolProviderAWS {
+	if source == nil {
+		return MachinePoolProviderAWS{}
+	}
+
+	// spot instances for node pools are not an option
+	return MachinePoolProviderAWS{
+		RootVolumeSize:             source.RootVolume().Size(),
+		AdditionalSecurityGroupIDs: source.AdditionalSecurityGroupIds(),
+		InstanceMetadataHTTPTokens: string(source.Ec2MetadataHttpTokens()),
+		Tags:                       source.Tags(),
 	}
 }
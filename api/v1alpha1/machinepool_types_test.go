@@ -0,0 +1,96 @@
+package v1alpha1
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+func TestMachinePool_NodePoolBuilder(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		spec   MachinePoolSpec
+		want   map[string]interface{}
+		absent []string
+	}{
+		{
+			name: "ensure unset configs are sent as empty to detach existing configs",
+			spec: MachinePoolSpec{},
+			want: map[string]interface{}{
+				"kubelet_configs": []interface{}{},
+				"tuning_configs":  []interface{}{},
+			},
+		},
+		{
+			name: "ensure configs are sent",
+			spec: MachinePoolSpec{
+				KubeletConfigs: []string{"kubelet"},
+				TuningConfigs:  []string{"tuning-a", "tuning-b"},
+			},
+			want: map[string]interface{}{
+				"kubelet_configs": []interface{}{"kubelet"},
+				"tuning_configs":  []interface{}{"tuning-a", "tuning-b"},
+			},
+		},
+		{
+			name:   "ensure unset node fields are defaulted by ocm",
+			spec:   MachinePoolSpec{},
+			absent: []string{"auto_repair", "node_drain_grace_period"},
+		},
+		{
+			name: "ensure disabled auto repair is sent",
+			spec: MachinePoolSpec{
+				AutoRepair:                  func() *bool { autoRepair := false; return &autoRepair }(),
+				NodeDrainGracePeriodMinutes: 30,
+			},
+			want: map[string]interface{}{
+				"auto_repair": false,
+				"node_drain_grace_period": map[string]interface{}{
+					"unit":  "minutes",
+					"value": float64(30),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			machinePool := &MachinePool{Spec: tt.spec}
+
+			nodePool, err := machinePool.NodePoolBuilder().Build()
+			if err != nil {
+				t.Fatalf("MachinePool.NodePoolBuilder() error = %v", err)
+			}
+
+			var buffer bytes.Buffer
+			if err := clustersmgmtv1.MarshalNodePool(nodePool, &buffer); err != nil {
+				t.Fatalf("MarshalNodePool() error = %v", err)
+			}
+
+			got := map[string]interface{}{}
+			if err := json.Unmarshal(buffer.Bytes(), &got); err != nil {
+				t.Fatalf("json.Unmarshal() error = %v", err)
+			}
+
+			for field, want := range tt.want {
+				if !reflect.DeepEqual(got[field], want) {
+					t.Errorf("MachinePool.NodePoolBuilder() %s = %v, want %v", field, got[field], want)
+				}
+			}
+
+			for _, field := range tt.absent {
+				if value, ok := got[field]; ok {
+					t.Errorf("MachinePool.NodePoolBuilder() %s = %v, want unset", field, value)
+				}
+			}
+		})
+	}
+}
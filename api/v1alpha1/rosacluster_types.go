@@ -17,15 +17,26 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
 	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	configv1 "github.com/openshift/api/config/v1"
 	"github.com/scottd018/go-utils/pkg/list"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
 )
 
 const (
+	ROSAClusterKubeconfigKey = "kubeconfig"
+
+	ROSAClusterPowerStateRunning     = "Running"
+	ROSAClusterPowerStateHibernating = "Hibernating"
+
 	rosaProduceID = "rosa"
 
 	rosaAccountRolePrefix           = "ManagedOpenShift"
@@ -37,6 +48,7 @@ const (
 	rosaPropertyProvisioner         = "rosa_provisioner"
 	rosaPropertyProvisionerOperator = "ocm-operator"
 
+	rosaDefaultRegion      = "us-east-1"
 	rosaDefaultMachineCIDR = "10.0.0.0/16"
 	rosaDefaultPodCIDR     = "10.128.0.0/14"
 	rosaDefaultServiceCIDR = "172.30.0.0/16"
@@ -53,6 +65,7 @@ const (
 
 // +kubebuilder:validation:XValidation:message="singleAZ clusters require a minimum of 2 nodes",rule=(self.multiAZ || self.defaultMachinePool.minimumNodesPerZone >= 2)
 // +kubebuilder:validation:XValidation:message="additionalTrustBundle only supported when network.subnets is specified",rule=(has(self.network.subnets) && has(self.additionalTrustBundle) && self.network.subnets.size() > 0 || !has(self.additionalTrustBundle))
+// +kubebuilder:validation:XValidation:message="hostedControlPlane clusters cannot be hibernated",rule=(!self.hostedControlPlane || ((!has(self.powerState) || self.powerState == 'Running') && (!has(self.hibernationSchedules) || self.hibernationSchedules.size() == 0)))
 // +kubebuilder:validation:XValidation:message="hostedControlPlane cannot have node labels",rule=(!self.hostedControlPlane || self.hostedControlPlane && !has(self.defaultMachinePool.labels) || self.hostedControlPlane && has(self.defaultMachinePool.labels) && self.defaultMachinePool.labels.size() == 0)
 // ROSAClusterSpec defines the desired state of ROSACluster.
 //
@@ -82,15 +95,24 @@ type ROSAClusterSpec struct {
 	HostedControlPlane bool `json:"hostedControlPlane,omitempty"`
 
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:validation:XValidation:message="openshiftVersion is immutable",rule=(self == oldSelf)
-	// +kubebuilder:validation:XValidation:message="openshiftVersion must either be blank or valid x.y.z format",rule=(self == "" || self.split(".").size() == 3)
 	// +kubebuilder:validation:XValidation:message="openshiftVersion cannot start with a 'v'",rule=(!self.startsWith('v'))
-	// OpenShift version used to provision the cluster with.  This is only used for initial provisioning
-	// and ignored for future updates.  Version must be in format of x.y.z.  If this is empty, the latest
-	// available and supportable version is selected.  If this is used, the version must be a part of
-	// the 'stable' channel group.
+	// OpenShift version used to provision the cluster with.  This is either an exact version in format
+	// of x.y.z or a version constraint, such as '~4.14' or '4.15.x', which is resolved to the latest
+	// matching version of the '.spec.channelGroup' channel group.  If this is empty, the default
+	// version of the channel group is selected.  The resolved version is stored in
+	// 'status.openshiftVersion' and is only resolved again once this field changes.  Changing this to
+	// a newer version once the cluster has been provisioned schedules an upgrade of the cluster to
+	// that version.  Downgrades are ignored.
 	OpenShiftVersion string `json:"openshiftVersion,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=stable
+	// +kubebuilder:validation:Enum=stable;fast;candidate;eus;nightly
+	// +kubebuilder:validation:XValidation:message="channelGroup is immutable",rule=(self == oldSelf)
+	// Channel group from which the OpenShift version of the cluster is selected (default: stable).  The
+	// 'nightly' channel group is only intended for internal testing.
+	ChannelGroup string `json:"channelGroup,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default=false
 	// +kubebuilder:validation:XValidation:message="multiAZ is immutable",rule=(self == oldSelf)
@@ -113,12 +135,12 @@ type ROSAClusterSpec struct {
 	DisableUserWorkloadMonitoring bool `json:"disableUserWorkloadMonitoring,omitempty"`
 
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:default=us-east-1
 	// +kubebuilder:validation:XValidation:message="region is immutable",rule=(self == oldSelf)
 	// +kubebuilder:validation:XValidation:message="region not a valid AWS region",rule=(self.split("-").size() == 3)
-	// Region used to provision the ROSA cluster.  Supported regions can be found using the
+	// Region used to provision the ROSA cluster (default: us-east-1).  Supported regions can be found using the
 	// supportability checker located at https://access.redhat.com/labs/rosasc/.  Be aware of
-	// valid region differences if using '.spec.hostedControlPlane = true'.
+	// valid region differences if using '.spec.hostedControlPlane = true'.  If unset, the region
+	// of the class referenced by '.spec.classRef' is used, if any.
 	Region string `json:"region,omitempty"`
 
 	// +kubebuilder:validation:Required
@@ -152,6 +174,76 @@ type ROSAClusterSpec struct {
 	// +kubebuilder:validation:Optional
 	// ROSA IAM configuration options including roles and prefixes.
 	IAM ROSAIAM `json:"iam,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// kubeconfigSecret is an optional reference to a secret by name containing a kubeconfig
+	// used to access the cluster.  The key "kubeconfig" is used to locate the data.  This allows
+	// the operator to inspect objects within the cluster (e.g. the nodes of a machine pool) when
+	// the operator is running in a separate management cluster.  This should exist in the same
+	// namespace as the resource.  If unset, the admin kubeconfig of the cluster is retrieved
+	// from OpenShift Cluster Manager, if available.
+	KubeconfigSecret configv1.SecretNameReference `json:"kubeconfigSecret,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="Running"
+	// +kubebuilder:validation:Enum=Running;Hibernating
+	// Desired power state of the cluster (default: Running).  Hibernating a cluster shuts down
+	// its instances to save cost while retaining the cluster.  Reconciliation of resources which
+	// belong to a hibernating cluster (e.g. machine pools) is paused until the cluster is resumed.
+	// This is only supported for clusters which are not using a hosted control plane.
+	PowerState string `json:"powerState,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// Schedules during which the cluster is automatically hibernated, regardless of the
+	// spec.powerState field (e.g. to hibernate a sandbox cluster overnight).  The cluster
+	// is resumed at the end of each window unless spec.powerState is Hibernating.  This is
+	// only supported for clusters which are not using a hosted control plane.
+	HibernationSchedules []ROSAHibernationSchedule `json:"hibernationSchedules,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="classRef is immutable",rule=(self == oldSelf)
+	// Reference to a ROSAClusterClass whose defaults are merged under this spec.  Fields which
+	// are set in this spec always take precedence over the class.  Fields which may only be
+	// set when the cluster is provisioned are inherited from the class at provisioning time
+	// only, while changes to the remaining fields of the class are applied to this cluster.
+	ClassRef ROSAClusterClassReference `json:"classRef,omitempty"`
+}
+
+// ROSAClusterClassReference represents a reference to a ROSAClusterClass.
+type ROSAClusterClassReference struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Name of the ROSAClusterClass.
+	Name string `json:"name"`
+}
+
+// ROSAHibernationSchedule represents a recurring window of time during which a ROSA cluster
+// is hibernated.
+type ROSAHibernationSchedule struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Unique name of the schedule.  This is reported in status.activeHibernationSchedule
+	// while the schedule is active.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Cron expression, in standard five field format (e.g. '0 19 * * 1-5'), representing the
+	// start of each window in which the cluster is hibernated.
+	Schedule string `json:"schedule"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="UTC"
+	// IANA time zone (e.g. 'America/New_York') in which the cron expression is evaluated.
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=10080
+	// Length of each window in which the cluster is hibernated, in minutes.
+	DurationMinutes int `json:"durationMinutes"`
 }
 
 // ROSAEncryption defines the encryption configuration for the ROSA cluster.  It is used to set things like
@@ -273,17 +365,18 @@ type ROSAIAM struct {
 	OperatorRolesPrefix string `json:"operatorRolesPrefix,omitempty"`
 
 	// +kubebuilder:validation:Optional
-	// +kubebuilder:default="ManagedOpenShift"
 	// +kubebuilder:validation:XValidation:message="iam.accountRolesPrefix is immutable",rule=(self == oldSelf)
 	// +kubebuilder:validation:XValidation:message="accountRolesPrefix may not be blank",rule=(self != "")
 	// Prefix used for provisioned account roles (default: ManagedOpenShift).  These should have been created as part of
-	// the prerequisite 'rosa create account-roles' step.
+	// the prerequisite 'rosa create account-roles' step.  If unset, the prefix of the class referenced by
+	// '.spec.classRef' is used, if any.
 	AccountRolesPrefix string `json:"accountRolesPrefix,omitempty"`
 
-	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:XValidation:message="iam.userRole is immutable",rule=(self == oldSelf)
 	// User role created with the prerequisite 'rosa create user-role' step.  This is the value used
-	// as the 'rosa_creator_arn' for the cluster.
+	// as the 'rosa_creator_arn' for the cluster.  This is required unless it is provided by the class
+	// referenced by '.spec.classRef'.
 	UserRole string `json:"userRole,omitempty"`
 }
 
@@ -310,15 +403,18 @@ type ROSAClusterStatus struct {
 	// set after the provider is created.
 	OIDCProviderARN string `json:"oidcProviderARN,omitempty"`
 
-	// +kubebuilder:validation:XValidation:message="status.openshiftVersion is immutable",rule=(self == oldSelf)
-	// Represents the OpenShift OCM Version Raw ID which was used
-	// to provision the cluster.  This is useful if the version
-	// is unset to reduce the amount of calls to the OCM API.
+	// Represents the OpenShift OCM Version Raw ID which was resolved
+	// from 'spec.openshiftVersion'.  This is useful if the version
+	// is unset or a constraint to reduce the amount of calls to the OCM API.
 	OpenShiftVersion string `json:"openshiftVersion,omitempty"`
 
-	// +kubebuilder:validation:XValidation:message="status.openshiftVersion is immutable",rule=(self == oldSelf)
-	// Represents the OpenShift OCM Version ID which was used
-	// to provision the cluster.  This is used to reduce
+	// Represents the value of 'spec.openshiftVersion' which 'status.openshiftVersion'
+	// was resolved from.  The version is resolved again once this differs from
+	// 'spec.openshiftVersion'.
+	OpenShiftVersionConstraint string `json:"openshiftVersionConstraint,omitempty"`
+
+	// Represents the OpenShift OCM Version ID which was resolved
+	// from 'spec.openshiftVersion'.  This is used to reduce
 	// the number of API calls to the OCM API.  This will differ
 	// from the 'spec.openshiftVersion' field.
 	OpenShiftVersionID string `json:"openshiftVersionID,omitempty"`
@@ -334,6 +430,35 @@ type ROSAClusterStatus struct {
 	// unset, this is the derived value containing a unique id which
 	// will be unknown to the requester.
 	OperatorRolesPrefix string `json:"operatorRolesPrefix,omitempty"`
+
+	// Represents the power state (Running or Hibernating) which the cluster has most
+	// recently reached.
+	PowerState string `json:"powerState,omitempty"`
+
+	// Represents the name of the schedule in spec.hibernationSchedules which is currently
+	// active.  This is empty if no schedule is active.
+	ActiveHibernationSchedule string `json:"activeHibernationSchedule,omitempty"`
+
+	// Represents the OpenShift version which the cluster is currently running.  This differs
+	// from 'status.openshiftVersion' once the cluster has been upgraded.
+	CurrentOpenShiftVersion string `json:"currentOpenShiftVersion,omitempty"`
+
+	// Represents the upgrade of the cluster which is currently scheduled or in progress, if any.
+	Upgrade ROSAClusterUpgradeStatus `json:"upgrade,omitempty"`
+}
+
+// ROSAClusterUpgradeStatus represents the status of an upgrade of a ROSA cluster.
+type ROSAClusterUpgradeStatus struct {
+	// Represents the OpenShift version that the cluster is being upgraded to.
+	Version string `json:"version,omitempty"`
+
+	// Represents the state of the upgrade as reported by OpenShift Cluster Manager
+	// (e.g. pending, scheduled, started, completed, delayed, failed or cancelled).
+	State string `json:"state,omitempty"`
+
+	// Represents a description of the state of the upgrade as reported by
+	// OpenShift Cluster Manager.
+	Description string `json:"description,omitempty"`
 }
 
 // +kubebuilder:resource:categories=cluster;clusters
@@ -359,6 +484,42 @@ type ROSAClusterList struct {
 	Items           []ROSACluster `json:"items"`
 }
 
+// FindAll gets a list of all resources.
+func (cluster *ROSACluster) FindAll(
+	ctx context.Context,
+	c kubernetes.Client,
+) ([]ROSACluster, error) {
+	objects := &ROSAClusterList{}
+
+	if err := c.List(ctx, objects); err != nil {
+		return []ROSACluster{}, fmt.Errorf("unable to retrieve rosa clusters - %w", err)
+	}
+
+	return objects.Items, nil
+}
+
+// FindByClusterID gets the resource in a namespace which has a particular cluster ID in the status
+// field.  It returns nil if no resource is found.
+func (cluster *ROSACluster) FindByClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	namespace, clusterID string,
+) (*ROSACluster, error) {
+	objects := &ROSAClusterList{}
+
+	if err := c.List(ctx, objects, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("unable to retrieve rosa clusters in namespace [%s] - %w", namespace, err)
+	}
+
+	for i := range objects.Items {
+		if objects.Items[i].Status.ClusterID == clusterID {
+			return &objects.Items[i], nil
+		}
+	}
+
+	return nil, nil
+}
+
 // GetClusterID gets the status.clusterID field from the object.  It is used to
 // satisfy the Workload interface.
 func (cluster *ROSACluster) GetClusterID() string {
@@ -444,7 +605,7 @@ func (cluster *ROSACluster) Builder(
 				rosaPropertyProvisioner: rosaPropertyProvisionerOperator,
 			},
 		).
-		Version(clustersmgmtv1.NewVersion().ID(versionID)).
+		Version(clustersmgmtv1.NewVersion().ID(versionID).ChannelGroup(cluster.Spec.ChannelGroup)).
 
 		// basic aws settings
 		MultiAZ(cluster.Spec.MultiAZ).
@@ -653,6 +814,29 @@ func (cluster *ROSACluster) GetWorkerRole() string {
 	return cluster.getIAMRoleName(rosaWorkerRolePrefix)
 }
 
+// SetDefaults sets the defaults for fields which may be inherited from a ROSAClusterClass and
+// therefore are not defaulted by the API server, as well as fields which may be unset on objects
+// that were created before the field was introduced.
+func (cluster *ROSACluster) SetDefaults() {
+	if cluster.Spec.Region == "" {
+		cluster.Spec.Region = rosaDefaultRegion
+	}
+
+	if cluster.Spec.IAM.AccountRolesPrefix == "" {
+		cluster.Spec.IAM.AccountRolesPrefix = rosaAccountRolePrefix
+	}
+
+	if cluster.Spec.DefaultMachinePool.InstanceType == "" {
+		cluster.Spec.DefaultMachinePool.InstanceType = defaultMachinePoolInstanceType
+	}
+
+	// clusters created prior to the introduction of channel groups were always
+	// provisioned from the stable channel group
+	if cluster.Spec.ChannelGroup == "" {
+		cluster.Spec.ChannelGroup = ocm.ChannelGroupStable
+	}
+}
+
 func (cluster *ROSACluster) SetNetworkDefaults() {
 	if cluster.Spec.Network.HostPrefix == 0 {
 		cluster.Spec.Network.HostPrefix = rosaDefaultHostPrefix
@@ -0,0 +1,75 @@
+package v1alpha1
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestROSACluster_FindByClusterID(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	if err := AddToScheme(scheme); err != nil {
+		t.Fatalf("unable to build scheme - %v", err)
+	}
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&ROSACluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "owner", Name: "owned"},
+			Status:     ROSAClusterStatus{ClusterID: "owned"},
+		},
+		&ROSACluster{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "other"},
+			Status:     ROSAClusterStatus{ClusterID: "other"},
+		},
+	).Build()
+
+	tests := []struct {
+		name      string
+		namespace string
+		clusterID string
+		want      string
+	}{
+		{
+			name:      "ensure cluster in the namespace is found",
+			namespace: "owner",
+			clusterID: "owned",
+			want:      "owned",
+		},
+		{
+			name:      "ensure cluster in another namespace is not found",
+			namespace: "owner",
+			clusterID: "other",
+		},
+		{
+			name:      "ensure missing cluster is not found",
+			namespace: "owner",
+			clusterID: "missing",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := (&ROSACluster{}).FindByClusterID(context.Background(), c, tt.namespace, tt.clusterID)
+			if err != nil {
+				t.Fatalf("ROSACluster.FindByClusterID() error = %v", err)
+			}
+
+			var name string
+			if got != nil {
+				name = got.Name
+			}
+
+			if name != tt.want {
+				t.Errorf("ROSACluster.FindByClusterID() = %v, want %v", name, tt.want)
+			}
+		})
+	}
+}
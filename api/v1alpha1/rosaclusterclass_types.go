@@ -0,0 +1,277 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ROSAClusterClassSpec defines the desired state of ROSAClusterClass.
+//
+//nolint:lll
+type ROSAClusterClassSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="region not a valid AWS region",rule=(self.split("-").size() == 3)
+	// Region used to provision member clusters which do not set '.spec.region'.  This is only
+	// used when a member cluster is provisioned.
+	Region string `json:"region,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="tags is limited to 10",rule=(self.size() <= 10)
+	// +kubebuilder:validation:XValidation:message="red-hat-managed is a reserved tag",rule=!('red-hat-managed' in self)
+	// +kubebuilder:validation:XValidation:message="red-hat-clustertype is a reserved tag",rule=!('red-hat-clustertype' in self)
+	// Additional tags to apply to all AWS objects of member clusters.  These are merged with
+	// the '.spec.tags' field of a member cluster, with the tags of the member cluster taking
+	// precedence.  This is only used when a member cluster is provisioned.
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// IAM configuration options used for member clusters which do not set them.
+	IAM ROSAClusterClassIAM `json:"iam,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Encryption configuration used for member clusters which do not set '.spec.encryption'.
+	// This is only used when a member cluster is provisioned.
+	Encryption ROSAClusterClassEncryption `json:"encryption,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Proxy configuration used for member clusters which do not set '.spec.network.proxy'.  This
+	// is ignored for member clusters which do not set '.spec.network.subnets' and is only used
+	// when a member cluster is provisioned.
+	Proxy ROSAClusterClassProxy `json:"proxy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// PEM-encoded X.509 certificate bundle used for member clusters which do not set
+	// '.spec.additionalTrustBundle'.  This is ignored for member clusters which do not set
+	// '.spec.network.subnets' and is only used when a member cluster is provisioned.
+	AdditionalTrustBundle string `json:"additionalTrustBundle,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Configuration of the default machine pool of member clusters.
+	DefaultMachinePool ROSAClusterClassMachinePool `json:"defaultMachinePool,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +listType=map
+	// +listMapKey=name
+	// Hibernation schedules used for member clusters which do not set '.spec.hibernationSchedules'.
+	// These are ignored for member clusters which are using a hosted control plane.  Changes
+	// to this field are applied to existing member clusters.
+	HibernationSchedules []ROSAHibernationSchedule `json:"hibernationSchedules,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// Maximum number of existing member clusters which are updated in OpenShift Cluster Manager
+	// at the same time (default: 1).  This limits the impact of a change to this class which
+	// applies to many member clusters.
+	MaxConcurrentUpdates int `json:"maxConcurrentUpdates,omitempty"`
+}
+
+// ROSAClusterClassIAM represents the IAM configuration of a ROSAClusterClass.
+type ROSAClusterClassIAM struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="accountRolesPrefix may not be blank",rule=(self != "")
+	// Prefix used for provisioned account roles of member clusters which do not set
+	// '.spec.iam.accountRolesPrefix'.  This is only used when a member cluster is provisioned.
+	AccountRolesPrefix string `json:"accountRolesPrefix,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// User role used for member clusters which do not set '.spec.iam.userRole'.  This is only
+	// used when a member cluster is provisioned.
+	UserRole string `json:"userRole,omitempty"`
+}
+
+// ROSAClusterClassEncryption represents the encryption configuration of a ROSAClusterClass.
+type ROSAClusterClassEncryption struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="etcd.kmsKey must be a valid aws arn",rule=(self.kmsKey.startsWith("arn:aws"))
+	// ETCD encryption configuration used for member clusters which do not set
+	// '.spec.encryption.etcd'.
+	ETCD ROSAClusterClassKey `json:"etcd,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="ebs.kmsKey must be a valid aws arn",rule=(self.kmsKey.startsWith("arn:aws"))
+	// EBS encryption configuration used for member clusters which do not set
+	// '.spec.encryption.ebs'.
+	EBS ROSAClusterClassKey `json:"ebs,omitempty"`
+}
+
+// ROSAClusterClassKey represents an AWS KMS key of a ROSAClusterClass.  Unlike ROSAKey, it may be
+// changed as it only applies to member clusters which are provisioned afterwards.
+type ROSAClusterClassKey struct {
+	// +kubebuilder:validation:Optional
+	// KMS Key ARN to use.  Must be a valid and existing KMS Key ARN.
+	Key string `json:"kmsKey,omitempty"`
+}
+
+// ROSAClusterClassProxy represents the proxy configuration of a ROSAClusterClass.  The proxy of
+// the class is only used for member clusters which set none of the proxy fields, as the fields
+// of the class and the member cluster are not meaningful when mixed.
+type ROSAClusterClassProxy struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="http proxy url must be a valid uri",rule=(self.contains("://"))
+	// Valid proxy URL to use for proxying HTTP requests from within the cluster.
+	HTTPProxy string `json:"httpProxy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="https proxy url must be a valid uri",rule=(self.contains("://"))
+	// Valid proxy URL to use for proxying HTTPS requests from within the cluster.
+	HTTPSProxy string `json:"httpsProxy,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Comma-separated list of URLs, IP addresses or Network CIDRs to skip proxying for.
+	NoProxy string `json:"noProxy,omitempty"`
+}
+
+// ROSAClusterClassMachinePool represents the default machine pool configuration of a ROSAClusterClass.
+//
+//nolint:lll
+type ROSAClusterClassMachinePool struct {
+	// +kubebuilder:validation:Optional
+	// Instance type of the default machine pool of member clusters which do not set
+	// '.spec.defaultMachinePool.instanceType'.  If neither is set, m5.xlarge is used.  This is only
+	// used when a member cluster is provisioned.
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:XValidation:message="ocm.mobb.redhat.com/name is a reserved label",rule=!('ocm.mobb.redhat.com/name' in self)
+	// +kubebuilder:validation:XValidation:message="ocm.mobb.redhat.com/managed is a reserved label",rule=!('ocm.mobb.redhat.com/managed' in self)
+	// Additional labels to apply to the default machine pool of member clusters.  These are merged
+	// with the '.spec.defaultMachinePool.labels' field of a member cluster, with the labels of the
+	// member cluster taking precedence.  These are ignored for member clusters which are using a
+	// hosted control plane.  Changes to this field are applied to existing member clusters.
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// +kubebuilder:resource:scope=Cluster,categories=cluster;clusters
+//+kubebuilder:object:root=true
+
+// ROSAClusterClass is the Schema for the rosaclusterclasses API.  It is a template of
+// defaults which are shared by each ROSACluster that references it via '.spec.classRef'.
+type ROSAClusterClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec ROSAClusterClassSpec `json:"spec,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// ROSAClusterClassList contains a list of ROSAClusterClass.
+type ROSAClusterClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ROSAClusterClass `json:"items"`
+}
+
+// Apply merges the defaults of the class under the spec of a cluster.  Fields which are set
+// on the cluster always take precedence over the class.  Fields which may only be set when a
+// cluster is provisioned are only merged when provisioning is true.
+func (class *ROSAClusterClass) Apply(cluster *ROSACluster, provisioning bool) {
+	if provisioning {
+		if cluster.Spec.Region == "" {
+			cluster.Spec.Region = class.Spec.Region
+		}
+
+		if cluster.Spec.IAM.AccountRolesPrefix == "" {
+			cluster.Spec.IAM.AccountRolesPrefix = class.Spec.IAM.AccountRolesPrefix
+		}
+
+		if cluster.Spec.IAM.UserRole == "" {
+			cluster.Spec.IAM.UserRole = class.Spec.IAM.UserRole
+		}
+
+		cluster.Spec.Tags = mergeClassMap(class.Spec.Tags, cluster.Spec.Tags)
+
+		if cluster.Spec.DefaultMachinePool.InstanceType == "" {
+			cluster.Spec.DefaultMachinePool.InstanceType = class.Spec.DefaultMachinePool.InstanceType
+		}
+
+		if cluster.Spec.Encryption.ETCD.Key == "" {
+			cluster.Spec.Encryption.ETCD.Key = class.Spec.Encryption.ETCD.Key
+		}
+
+		if cluster.Spec.Encryption.EBS.Key == "" {
+			cluster.Spec.Encryption.EBS.Key = class.Spec.Encryption.EBS.Key
+		}
+
+		// the proxy and trust bundle are only valid for clusters which are provisioned into
+		// pre-existing subnets
+		if cluster.HasSubnets() {
+			if !cluster.HasProxy() {
+				cluster.Spec.Network.Proxy = ROSAProxy{
+					HTTPProxy:  class.Spec.Proxy.HTTPProxy,
+					HTTPSProxy: class.Spec.Proxy.HTTPSProxy,
+					NoProxy:    class.Spec.Proxy.NoProxy,
+				}
+			}
+
+			if cluster.Spec.AdditionalTrustBundle == "" {
+				cluster.Spec.AdditionalTrustBundle = class.Spec.AdditionalTrustBundle
+			}
+		}
+	}
+
+	// clusters using a hosted control plane may not have node labels or be hibernated
+	if cluster.Spec.HostedControlPlane {
+		return
+	}
+
+	cluster.Spec.DefaultMachinePool.Labels = mergeClassMap(
+		class.Spec.DefaultMachinePool.Labels,
+		cluster.Spec.DefaultMachinePool.Labels,
+	)
+
+	if len(cluster.Spec.HibernationSchedules) == 0 && len(class.Spec.HibernationSchedules) > 0 {
+		cluster.Spec.HibernationSchedules = make([]ROSAHibernationSchedule, len(class.Spec.HibernationSchedules))
+		copy(cluster.Spec.HibernationSchedules, class.Spec.HibernationSchedules)
+	}
+}
+
+// GetMaxConcurrentUpdates returns the maximum number of member clusters which may be updated
+// at the same time.
+func (class *ROSAClusterClass) GetMaxConcurrentUpdates() int {
+	if class.Spec.MaxConcurrentUpdates < 1 {
+		return 1
+	}
+
+	return class.Spec.MaxConcurrentUpdates
+}
+
+// mergeClassMap merges the values of a class map under the values of a cluster map.  The
+// cluster map is returned unmodified if the class map is empty.
+func mergeClassMap(class, cluster map[string]string) map[string]string {
+	if len(class) == 0 {
+		return cluster
+	}
+
+	merged := make(map[string]string, len(class)+len(cluster))
+
+	for key, value := range class {
+		merged[key] = value
+	}
+
+	for key, value := range cluster {
+		merged[key] = value
+	}
+
+	return merged
+}
+
+func init() {
+	SchemeBuilder.Register(&ROSAClusterClass{}, &ROSAClusterClassList{})
+}
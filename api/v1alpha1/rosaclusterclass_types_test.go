@@ -0,0 +1,168 @@
+package v1alpha1
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestROSAClusterClass_Apply(t *testing.T) {
+	t.Parallel()
+
+	class := &ROSAClusterClass{
+		Spec: ROSAClusterClassSpec{
+			Region: "us-east-2",
+			Tags:   map[string]string{"environment": "sandbox", "owner": "platform"},
+			IAM: ROSAClusterClassIAM{
+				AccountRolesPrefix: "Sandbox",
+				UserRole:           "arn:aws:iam::111111111111:role/class-user-role",
+			},
+			Encryption: ROSAClusterClassEncryption{
+				ETCD: ROSAClusterClassKey{Key: "arn:aws:kms:us-east-2:111111111111:key/etcd"},
+				EBS:  ROSAClusterClassKey{Key: "arn:aws:kms:us-east-2:111111111111:key/ebs"},
+			},
+			Proxy: ROSAClusterClassProxy{
+				HTTPProxy:  "http://proxy.example.com:3128",
+				HTTPSProxy: "http://proxy.example.com:3128",
+				NoProxy:    ".example.com",
+			},
+			AdditionalTrustBundle: "class-bundle",
+			DefaultMachinePool: ROSAClusterClassMachinePool{
+				InstanceType: "m6i.xlarge",
+				Labels:       map[string]string{"environment": "sandbox", "tier": "class"},
+			},
+			HibernationSchedules: []ROSAHibernationSchedule{
+				{Name: "weeknights", Schedule: "0 19 * * 1-4", DurationMinutes: 720},
+			},
+		},
+	}
+
+	// inherited is a cluster with every field which may be inherited from the class set to
+	// the value of the class
+	inherited := func(cluster *ROSACluster) {
+		cluster.Spec.Region = "us-east-2"
+		cluster.Spec.Tags = map[string]string{"environment": "sandbox", "owner": "platform"}
+		cluster.Spec.IAM.AccountRolesPrefix = "Sandbox"
+		cluster.Spec.IAM.UserRole = "arn:aws:iam::111111111111:role/class-user-role"
+		cluster.Spec.Encryption.ETCD.Key = "arn:aws:kms:us-east-2:111111111111:key/etcd"
+		cluster.Spec.Encryption.EBS.Key = "arn:aws:kms:us-east-2:111111111111:key/ebs"
+		cluster.Spec.Network.Proxy = ROSAProxy{
+			HTTPProxy:  "http://proxy.example.com:3128",
+			HTTPSProxy: "http://proxy.example.com:3128",
+			NoProxy:    ".example.com",
+		}
+		cluster.Spec.AdditionalTrustBundle = "class-bundle"
+		cluster.Spec.DefaultMachinePool.InstanceType = "m6i.xlarge"
+		cluster.Spec.DefaultMachinePool.Labels = map[string]string{"environment": "sandbox", "tier": "class"}
+		cluster.Spec.HibernationSchedules = []ROSAHibernationSchedule{
+			{Name: "weeknights", Schedule: "0 19 * * 1-4", DurationMinutes: 720},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		cluster      func(*ROSACluster)
+		provisioning bool
+		want         func(*ROSACluster)
+	}{
+		{
+			name:         "ensure unset fields are inherited when provisioning",
+			provisioning: true,
+			want:         inherited,
+		},
+		{
+			name: "ensure fields set on the cluster take precedence over the class",
+			cluster: func(cluster *ROSACluster) {
+				cluster.Spec.Region = "us-west-2"
+				cluster.Spec.IAM.UserRole = "arn:aws:iam::111111111111:role/cluster-user-role"
+				cluster.Spec.Encryption.EBS.Key = "arn:aws:kms:us-west-2:111111111111:key/cluster"
+				cluster.Spec.Network.Proxy.NoProxy = ".cluster.example.com"
+				cluster.Spec.AdditionalTrustBundle = "cluster-bundle"
+				cluster.Spec.DefaultMachinePool.InstanceType = "m5.2xlarge"
+				cluster.Spec.HibernationSchedules = []ROSAHibernationSchedule{
+					{Name: "weekends", Schedule: "0 19 * * 5", DurationMinutes: 2880},
+				}
+			},
+			provisioning: true,
+			want: func(cluster *ROSACluster) {
+				inherited(cluster)
+				cluster.Spec.Region = "us-west-2"
+				cluster.Spec.IAM.UserRole = "arn:aws:iam::111111111111:role/cluster-user-role"
+				cluster.Spec.Encryption.EBS.Key = "arn:aws:kms:us-west-2:111111111111:key/cluster"
+				cluster.Spec.Network.Proxy = ROSAProxy{NoProxy: ".cluster.example.com"}
+				cluster.Spec.AdditionalTrustBundle = "cluster-bundle"
+				cluster.Spec.DefaultMachinePool.InstanceType = "m5.2xlarge"
+				cluster.Spec.HibernationSchedules = []ROSAHibernationSchedule{
+					{Name: "weekends", Schedule: "0 19 * * 5", DurationMinutes: 2880},
+				}
+			},
+		},
+		{
+			name: "ensure tags and labels are merged with the cluster taking precedence",
+			cluster: func(cluster *ROSACluster) {
+				cluster.Spec.Tags = map[string]string{"environment": "production", "team": "payments"}
+				cluster.Spec.DefaultMachinePool.Labels = map[string]string{"tier": "cluster"}
+			},
+			provisioning: true,
+			want: func(cluster *ROSACluster) {
+				inherited(cluster)
+				cluster.Spec.Tags = map[string]string{"environment": "production", "owner": "platform", "team": "payments"}
+				cluster.Spec.DefaultMachinePool.Labels = map[string]string{"environment": "sandbox", "tier": "cluster"}
+			},
+		},
+		{
+			name: "ensure provisioning only fields are not inherited by existing clusters",
+			want: func(cluster *ROSACluster) {
+				cluster.Spec.DefaultMachinePool.Labels = map[string]string{"environment": "sandbox", "tier": "class"}
+				cluster.Spec.HibernationSchedules = []ROSAHibernationSchedule{
+					{Name: "weeknights", Schedule: "0 19 * * 1-4", DurationMinutes: 720},
+				}
+			},
+		},
+		{
+			name: "ensure proxy and trust bundle are not inherited by clusters without subnets",
+			cluster: func(cluster *ROSACluster) {
+				cluster.Spec.Network.Subnets = nil
+			},
+			provisioning: true,
+			want: func(cluster *ROSACluster) {
+				inherited(cluster)
+				cluster.Spec.Network.Subnets = nil
+				cluster.Spec.Network.Proxy = ROSAProxy{}
+				cluster.Spec.AdditionalTrustBundle = ""
+			},
+		},
+		{
+			name: "ensure labels and hibernation schedules are not inherited by hosted clusters",
+			cluster: func(cluster *ROSACluster) {
+				cluster.Spec.HostedControlPlane = true
+			},
+			provisioning: true,
+			want: func(cluster *ROSACluster) {
+				inherited(cluster)
+				cluster.Spec.HostedControlPlane = true
+				cluster.Spec.DefaultMachinePool.Labels = nil
+				cluster.Spec.HibernationSchedules = nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cluster := &ROSACluster{Spec: ROSAClusterSpec{Network: ROSANetwork{Subnets: []string{"subnet-a"}}}}
+			if tt.cluster != nil {
+				tt.cluster(cluster)
+			}
+
+			want := &ROSACluster{Spec: ROSAClusterSpec{Network: ROSANetwork{Subnets: []string{"subnet-a"}}}}
+			tt.want(want)
+
+			class.DeepCopy().Apply(cluster, tt.provisioning)
+			if !reflect.DeepEqual(cluster.Spec, want.Spec) {
+				t.Errorf("ROSAClusterClass.Apply() = %+v, want %+v", cluster.Spec, want.Spec)
+			}
+		})
+	}
+}
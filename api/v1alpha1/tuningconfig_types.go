@@ -0,0 +1,214 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+)
+
+// TuningConfigSpec defines the desired state of TuningConfig.
+type TuningConfigSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:message="clusterName is immutable",rule=(self == oldSelf)
+	// Cluster name in OpenShift Cluster Manager by which this should be managed for.  A cluster with this
+	// name should exist in the organization by which the operator is associated.  If the cluster does
+	// not exist, the reconciliation process will continue until one does.  The cluster must be using
+	// a hosted control plane.
+	ClusterName string `json:"clusterName,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:XValidation:message="displayName is immutable",rule=(self == oldSelf)
+	// Name of the tuning config as it appears in OpenShift Cluster Manager.  If this is empty, the
+	// metadata.name field of the parent resource is used.  Machine pools reference the tuning config
+	// by this name.
+	DisplayName string `json:"displayName,omitempty"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=object
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// Specification of the Node Tuning Operator Tuned object (e.g. profile and recommend) to apply
+	// to the nodes of the machine pools which reference this tuning config.  For information
+	// please see https://docs.openshift.com/rosa/scalability_and_performance/rosa-tuning-config.html.
+	TunedSpec runtime.RawExtension `json:"tunedSpec,omitempty"`
+}
+
+// TuningConfigStatus defines the observed state of TuningConfig.
+type TuningConfigStatus struct {
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// +kubebuilder:validation:XValidation:message="status.clusterID is immutable",rule=(self == oldSelf)
+	// Represents the programmatic cluster ID of the cluster, as
+	// determined during reconciliation.  This is used to reduce
+	// the number of API calls to look up a cluster ID based on
+	// the cluster name.
+	ClusterID string `json:"clusterID,omitempty"`
+
+	// Whether this cluster is using a hosted control plane.  Tuning configs are only valid for
+	// clusters using a hosted control plane.
+	Hosted bool `json:"hosted,omitempty"`
+
+	// Represents the programmatic tuning config ID, as determined during reconciliation.
+	ConfigID string `json:"configID,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+//+kubebuilder:subresource:status
+
+// TuningConfig is the Schema for the tuningconfigs API.
+type TuningConfig struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   TuningConfigSpec   `json:"spec,omitempty"`
+	Status TuningConfigStatus `json:"status,omitempty"`
+}
+
+//+kubebuilder:object:root=true
+
+// TuningConfigList contains a list of TuningConfig.
+type TuningConfigList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []TuningConfig `json:"items"`
+}
+
+// FindAll gets a complete list of resources in the cluster for this type.
+func (tuningConfig *TuningConfig) FindAll(
+	ctx context.Context,
+	c kubernetes.Client,
+) ([]TuningConfig, error) {
+	objects := &TuningConfigList{}
+
+	if err := c.List(ctx, objects); err != nil {
+		return []TuningConfig{}, fmt.Errorf("unable to retrieve tuning configs - %w", err)
+	}
+
+	return objects.Items, nil
+}
+
+// FindAllByClusterID gets a list of resources which have a particular cluster ID in the status field.
+func (tuningConfig *TuningConfig) FindAllByClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) ([]*TuningConfig, error) {
+	objects, err := tuningConfig.FindAll(ctx, c)
+	if err != nil {
+		return []*TuningConfig{}, err
+	}
+
+	matches := []*TuningConfig{}
+
+	for i := range objects {
+		if objects[i].Status.ClusterID == clusterID {
+			matches = append(matches, &objects[i])
+		}
+	}
+
+	return matches, nil
+}
+
+// ExistsForClusterID returns if a particular object is associated with a cluster ID.
+func (tuningConfig *TuningConfig) ExistsForClusterID(
+	ctx context.Context,
+	c kubernetes.Client,
+	clusterID string,
+) (bool, error) {
+	objects, err := tuningConfig.FindAllByClusterID(ctx, c, clusterID)
+
+	return (len(objects) > 0), err
+}
+
+// GetClusterID gets the status.clusterID field from the object.  It is used to
+// satisfy the Workload interface.
+func (tuningConfig *TuningConfig) GetClusterID() string {
+	return tuningConfig.Status.ClusterID
+}
+
+// GetConditions returns the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (tuningConfig *TuningConfig) GetConditions() []metav1.Condition {
+	return tuningConfig.Status.Conditions
+}
+
+// SetConditions sets the status.conditions field from the object.  It is used to
+// satisfy the Workload interface.
+func (tuningConfig *TuningConfig) SetConditions(conditions []metav1.Condition) {
+	tuningConfig.Status.Conditions = conditions
+}
+
+// GetTunedSpec returns the tuned specification of the object as a generic map, which is the
+// representation that is both sent to and returned from OCM.
+func (tuningConfig *TuningConfig) GetTunedSpec() (map[string]interface{}, error) {
+	spec := map[string]interface{}{}
+
+	if len(tuningConfig.Spec.TunedSpec.Raw) == 0 {
+		return spec, nil
+	}
+
+	if err := json.Unmarshal(tuningConfig.Spec.TunedSpec.Raw, &spec); err != nil {
+		return spec, fmt.Errorf("unable to parse tuned spec - %w", err)
+	}
+
+	return spec, nil
+}
+
+// CopyFrom copies an OCM TuningConfig object into a TuningConfig object that is recognizable by this
+// controller.
+func (tuningConfig *TuningConfig) CopyFrom(source *clustersmgmtv1.TuningConfig) error {
+	raw, err := json.Marshal(source.Spec())
+	if err != nil {
+		return fmt.Errorf("unable to copy tuned spec - %w", err)
+	}
+
+	tuningConfig.Spec.TunedSpec = runtime.RawExtension{Raw: raw}
+
+	return nil
+}
+
+// Builder returns the builder object from a reconciler object.  This object is used to
+// pass into the OCM API for creating the object.
+func (tuningConfig *TuningConfig) Builder() (*clustersmgmtv1.TuningConfigBuilder, error) {
+	spec, err := tuningConfig.GetTunedSpec()
+	if err != nil {
+		return nil, err
+	}
+
+	builder := clustersmgmtv1.NewTuningConfig().
+		Name(tuningConfig.Spec.DisplayName).
+		Spec(spec)
+
+	if tuningConfig.Status.ConfigID != "" {
+		builder = builder.ID(tuningConfig.Status.ConfigID)
+	}
+
+	return builder, nil
+}
+
+func init() {
+	SchemeBuilder.Register(&TuningConfig{}, &TuningConfigList{})
+}
@@ -22,54 +22,33 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"github.com/openshift/api/config/v1"
 	corev1 "k8s.io/api/core/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
-	runtime "k8s.io/apimachinery/pkg/runtime"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DefaultMachinePoolFields) DeepCopyInto(out *DefaultMachinePoolFields) {
-	*out = *in
-	if in.Labels != nil {
-		in, out := &in.Labels, &out.Labels
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultMachinePoolFields.
-func (in *DefaultMachinePoolFields) DeepCopy() *DefaultMachinePoolFields {
-	if in == nil {
-		return nil
-	}
-	out := new(DefaultMachinePoolFields)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitLabIdentityProvider) DeepCopyInto(out *GitLabIdentityProvider) {
+func (in *AddOn) DeepCopyInto(out *AddOn) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabIdentityProvider.
-func (in *GitLabIdentityProvider) DeepCopy() *GitLabIdentityProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddOn.
+func (in *AddOn) DeepCopy() *AddOn {
 	if in == nil {
 		return nil
 	}
-	out := new(GitLabIdentityProvider)
+	out := new(AddOn)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GitLabIdentityProvider) DeepCopyObject() runtime.Object {
+func (in *AddOn) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -77,31 +56,31 @@ func (in *GitLabIdentityProvider) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitLabIdentityProviderList) DeepCopyInto(out *GitLabIdentityProviderList) {
+func (in *AddOnList) DeepCopyInto(out *AddOnList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]GitLabIdentityProvider, len(*in))
+		*out = make([]AddOn, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabIdentityProviderList.
-func (in *GitLabIdentityProviderList) DeepCopy() *GitLabIdentityProviderList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddOnList.
+func (in *AddOnList) DeepCopy() *AddOnList {
 	if in == nil {
 		return nil
 	}
-	out := new(GitLabIdentityProviderList)
+	out := new(AddOnList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *GitLabIdentityProviderList) DeepCopyObject() runtime.Object {
+func (in *AddOnList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -109,65 +88,90 @@ func (in *GitLabIdentityProviderList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitLabIdentityProviderSpec) DeepCopyInto(out *GitLabIdentityProviderSpec) {
+func (in *AddOnParameter) DeepCopyInto(out *AddOnParameter) {
 	*out = *in
-	out.ClientSecret = in.ClientSecret
-	out.CA = in.CA
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = new(v1.SecretNameReference)
+		**out = **in
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabIdentityProviderSpec.
-func (in *GitLabIdentityProviderSpec) DeepCopy() *GitLabIdentityProviderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddOnParameter.
+func (in *AddOnParameter) DeepCopy() *AddOnParameter {
 	if in == nil {
 		return nil
 	}
-	out := new(GitLabIdentityProviderSpec)
+	out := new(AddOnParameter)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitLabIdentityProviderStatus) DeepCopyInto(out *GitLabIdentityProviderStatus) {
+func (in *AddOnSpec) DeepCopyInto(out *AddOnSpec) {
+	*out = *in
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make([]AddOnParameter, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddOnSpec.
+func (in *AddOnSpec) DeepCopy() *AddOnSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AddOnSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AddOnStatus) DeepCopyInto(out *AddOnStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabIdentityProviderStatus.
-func (in *GitLabIdentityProviderStatus) DeepCopy() *GitLabIdentityProviderStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AddOnStatus.
+func (in *AddOnStatus) DeepCopy() *AddOnStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(GitLabIdentityProviderStatus)
+	out := new(AddOnStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPIdentityProvider) DeepCopyInto(out *LDAPIdentityProvider) {
+func (in *ClusterAutoscaler) DeepCopyInto(out *ClusterAutoscaler) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	out.Spec = in.Spec
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPIdentityProvider.
-func (in *LDAPIdentityProvider) DeepCopy() *LDAPIdentityProvider {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscaler.
+func (in *ClusterAutoscaler) DeepCopy() *ClusterAutoscaler {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPIdentityProvider)
+	out := new(ClusterAutoscaler)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LDAPIdentityProvider) DeepCopyObject() runtime.Object {
+func (in *ClusterAutoscaler) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -175,31 +179,31 @@ func (in *LDAPIdentityProvider) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPIdentityProviderList) DeepCopyInto(out *LDAPIdentityProviderList) {
+func (in *ClusterAutoscalerList) DeepCopyInto(out *ClusterAutoscalerList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]LDAPIdentityProvider, len(*in))
+		*out = make([]ClusterAutoscaler, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPIdentityProviderList.
-func (in *LDAPIdentityProviderList) DeepCopy() *LDAPIdentityProviderList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerList.
+func (in *ClusterAutoscalerList) DeepCopy() *ClusterAutoscalerList {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPIdentityProviderList)
+	out := new(ClusterAutoscalerList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *LDAPIdentityProviderList) DeepCopyObject() runtime.Object {
+func (in *ClusterAutoscalerList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -207,47 +211,76 @@ func (in *LDAPIdentityProviderList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPIdentityProviderSpec) DeepCopyInto(out *LDAPIdentityProviderSpec) {
+func (in *ClusterAutoscalerResourceLimits) DeepCopyInto(out *ClusterAutoscalerResourceLimits) {
 	*out = *in
-	out.BindPassword = in.BindPassword
-	out.CA = in.CA
-	in.Attributes.DeepCopyInto(&out.Attributes)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPIdentityProviderSpec.
-func (in *LDAPIdentityProviderSpec) DeepCopy() *LDAPIdentityProviderSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerResourceLimits.
+func (in *ClusterAutoscalerResourceLimits) DeepCopy() *ClusterAutoscalerResourceLimits {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPIdentityProviderSpec)
+	out := new(ClusterAutoscalerResourceLimits)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *LDAPIdentityProviderStatus) DeepCopyInto(out *LDAPIdentityProviderStatus) {
+func (in *ClusterAutoscalerScaleDown) DeepCopyInto(out *ClusterAutoscalerScaleDown) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerScaleDown.
+func (in *ClusterAutoscalerScaleDown) DeepCopy() *ClusterAutoscalerScaleDown {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscalerScaleDown)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscalerSpec) DeepCopyInto(out *ClusterAutoscalerSpec) {
+	*out = *in
+	out.ResourceLimits = in.ResourceLimits
+	out.ScaleDown = in.ScaleDown
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerSpec.
+func (in *ClusterAutoscalerSpec) DeepCopy() *ClusterAutoscalerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterAutoscalerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterAutoscalerStatus) DeepCopyInto(out *ClusterAutoscalerStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPIdentityProviderStatus.
-func (in *LDAPIdentityProviderStatus) DeepCopy() *LDAPIdentityProviderStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAutoscalerStatus.
+func (in *ClusterAutoscalerStatus) DeepCopy() *ClusterAutoscalerStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(LDAPIdentityProviderStatus)
+	out := new(ClusterAutoscalerStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePool) DeepCopyInto(out *MachinePool) {
+func (in *ClusterGroupMembership) DeepCopyInto(out *ClusterGroupMembership) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -255,18 +288,18 @@ func (in *MachinePool) DeepCopyInto(out *MachinePool) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePool.
-func (in *MachinePool) DeepCopy() *MachinePool {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupMembership.
+func (in *ClusterGroupMembership) DeepCopy() *ClusterGroupMembership {
 	if in == nil {
 		return nil
 	}
-	out := new(MachinePool)
+	out := new(ClusterGroupMembership)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MachinePool) DeepCopyObject() runtime.Object {
+func (in *ClusterGroupMembership) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -274,31 +307,31 @@ func (in *MachinePool) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePoolList) DeepCopyInto(out *MachinePoolList) {
+func (in *ClusterGroupMembershipList) DeepCopyInto(out *ClusterGroupMembershipList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]MachinePool, len(*in))
+		*out = make([]ClusterGroupMembership, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolList.
-func (in *MachinePoolList) DeepCopy() *MachinePoolList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupMembershipList.
+func (in *ClusterGroupMembershipList) DeepCopy() *ClusterGroupMembershipList {
 	if in == nil {
 		return nil
 	}
-	out := new(MachinePoolList)
+	out := new(ClusterGroupMembershipList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *MachinePoolList) DeepCopyObject() runtime.Object {
+func (in *ClusterGroupMembershipList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -306,94 +339,49 @@ func (in *MachinePoolList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePoolProviderAWS) DeepCopyInto(out *MachinePoolProviderAWS) {
-	*out = *in
-	out.SpotInstances = in.SpotInstances
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolProviderAWS.
-func (in *MachinePoolProviderAWS) DeepCopy() *MachinePoolProviderAWS {
-	if in == nil {
-		return nil
-	}
-	out := new(MachinePoolProviderAWS)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePoolProviderAWSSpotInstances) DeepCopyInto(out *MachinePoolProviderAWSSpotInstances) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolProviderAWSSpotInstances.
-func (in *MachinePoolProviderAWSSpotInstances) DeepCopy() *MachinePoolProviderAWSSpotInstances {
-	if in == nil {
-		return nil
-	}
-	out := new(MachinePoolProviderAWSSpotInstances)
-	in.DeepCopyInto(out)
-	return out
-}
-
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePoolSpec) DeepCopyInto(out *MachinePoolSpec) {
+func (in *ClusterGroupMembershipSpec) DeepCopyInto(out *ClusterGroupMembershipSpec) {
 	*out = *in
-	in.DefaultMachinePoolFields.DeepCopyInto(&out.DefaultMachinePoolFields)
-	if in.Taints != nil {
-		in, out := &in.Taints, &out.Taints
-		*out = make([]corev1.Taint, len(*in))
-		for i := range *in {
-			(*in)[i].DeepCopyInto(&(*out)[i])
-		}
+	if in.Users != nil {
+		in, out := &in.Users, &out.Users
+		*out = make([]string, len(*in))
+		copy(*out, *in)
 	}
-	out.AWS = in.AWS
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolSpec.
-func (in *MachinePoolSpec) DeepCopy() *MachinePoolSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupMembershipSpec.
+func (in *ClusterGroupMembershipSpec) DeepCopy() *ClusterGroupMembershipSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(MachinePoolSpec)
+	out := new(ClusterGroupMembershipSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *MachinePoolStatus) DeepCopyInto(out *MachinePoolStatus) {
+func (in *ClusterGroupMembershipStatus) DeepCopyInto(out *ClusterGroupMembershipStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.AvailabilityZones != nil {
-		in, out := &in.AvailabilityZones, &out.AvailabilityZones
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.Subnets != nil {
-		in, out := &in.Subnets, &out.Subnets
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolStatus.
-func (in *MachinePoolStatus) DeepCopy() *MachinePoolStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterGroupMembershipStatus.
+func (in *ClusterGroupMembershipStatus) DeepCopy() *ClusterGroupMembershipStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(MachinePoolStatus)
+	out := new(ClusterGroupMembershipStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ROSACluster) DeepCopyInto(out *ROSACluster) {
+func (in *ClusterRollout) DeepCopyInto(out *ClusterRollout) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -401,18 +389,18 @@ func (in *ROSACluster) DeepCopyInto(out *ROSACluster) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSACluster.
-func (in *ROSACluster) DeepCopy() *ROSACluster {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRollout.
+func (in *ClusterRollout) DeepCopy() *ClusterRollout {
 	if in == nil {
 		return nil
 	}
-	out := new(ROSACluster)
+	out := new(ClusterRollout)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ROSACluster) DeepCopyObject() runtime.Object {
+func (in *ClusterRollout) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -420,31 +408,50 @@ func (in *ROSACluster) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ROSAClusterList) DeepCopyInto(out *ROSAClusterList) {
+func (in *ClusterRolloutClusterStatus) DeepCopyInto(out *ClusterRolloutClusterStatus) {
+	*out = *in
+	if in.UpgradeStartTime != nil {
+		in, out := &in.UpgradeStartTime, &out.UpgradeStartTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutClusterStatus.
+func (in *ClusterRolloutClusterStatus) DeepCopy() *ClusterRolloutClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutList) DeepCopyInto(out *ClusterRolloutList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ROSACluster, len(*in))
+		*out = make([]ClusterRollout, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterList.
-func (in *ROSAClusterList) DeepCopy() *ROSAClusterList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutList.
+func (in *ClusterRolloutList) DeepCopy() *ClusterRolloutList {
 	if in == nil {
 		return nil
 	}
-	out := new(ROSAClusterList)
+	out := new(ClusterRolloutList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ROSAClusterList) DeepCopyObject() runtime.Object {
+func (in *ClusterRolloutList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -452,96 +459,1305 @@ func (in *ROSAClusterList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ROSAClusterSpec) DeepCopyInto(out *ROSAClusterSpec) {
+func (in *ClusterRolloutSpec) DeepCopyInto(out *ClusterRolloutSpec) {
 	*out = *in
-	in.DefaultMachinePool.DeepCopyInto(&out.DefaultMachinePool)
-	out.Encryption = in.Encryption
-	if in.Tags != nil {
-		in, out := &in.Tags, &out.Tags
-		*out = make(map[string]string, len(*in))
-		for key, val := range *in {
-			(*out)[key] = val
-		}
-	}
-	in.Network.DeepCopyInto(&out.Network)
-	out.IAM = in.IAM
+	in.Selector.DeepCopyInto(&out.Selector)
+	out.Strategy = in.Strategy
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterSpec.
-func (in *ROSAClusterSpec) DeepCopy() *ROSAClusterSpec {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutSpec.
+func (in *ClusterRolloutSpec) DeepCopy() *ClusterRolloutSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(ROSAClusterSpec)
+	out := new(ClusterRolloutSpec)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ROSAClusterStatus) DeepCopyInto(out *ROSAClusterStatus) {
+func (in *ClusterRolloutStatus) DeepCopyInto(out *ClusterRolloutStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.WaveCompletionTime != nil {
+		in, out := &in.WaveCompletionTime, &out.WaveCompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterRolloutClusterStatus, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterStatus.
-func (in *ROSAClusterStatus) DeepCopy() *ROSAClusterStatus {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutStatus.
+func (in *ClusterRolloutStatus) DeepCopy() *ClusterRolloutStatus {
 	if in == nil {
 		return nil
 	}
-	out := new(ROSAClusterStatus)
+	out := new(ClusterRolloutStatus)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ROSAEncryption) DeepCopyInto(out *ROSAEncryption) {
+func (in *ClusterRolloutStrategy) DeepCopyInto(out *ClusterRolloutStrategy) {
 	*out = *in
-	out.ETCD = in.ETCD
-	out.EBS = in.EBS
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAEncryption.
-func (in *ROSAEncryption) DeepCopy() *ROSAEncryption {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutStrategy.
+func (in *ClusterRolloutStrategy) DeepCopy() *ClusterRolloutStrategy {
 	if in == nil {
 		return nil
 	}
-	out := new(ROSAEncryption)
+	out := new(ClusterRolloutStrategy)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ROSAIAM) DeepCopyInto(out *ROSAIAM) {
+func (in *DefaultMachinePoolFields) DeepCopyInto(out *DefaultMachinePoolFields) {
 	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAIAM.
-func (in *ROSAIAM) DeepCopy() *ROSAIAM {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultMachinePoolFields.
+func (in *DefaultMachinePoolFields) DeepCopy() *DefaultMachinePoolFields {
 	if in == nil {
 		return nil
 	}
-	out := new(ROSAIAM)
+	out := new(DefaultMachinePoolFields)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ROSAKey) DeepCopyInto(out *ROSAKey) {
+func (in *GitLabIdentityProvider) DeepCopyInto(out *GitLabIdentityProvider) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAKey.
-func (in *ROSAKey) DeepCopy() *ROSAKey {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabIdentityProvider.
+func (in *GitLabIdentityProvider) DeepCopy() *GitLabIdentityProvider {
 	if in == nil {
 		return nil
 	}
-	out := new(ROSAKey)
+	out := new(GitLabIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitLabIdentityProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabIdentityProviderList) DeepCopyInto(out *GitLabIdentityProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GitLabIdentityProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabIdentityProviderList.
+func (in *GitLabIdentityProviderList) DeepCopy() *GitLabIdentityProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabIdentityProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GitLabIdentityProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabIdentityProviderSpec) DeepCopyInto(out *GitLabIdentityProviderSpec) {
+	*out = *in
+	out.ClientSecret = in.ClientSecret
+	out.CA = in.CA
+	out.AccessTokenSecret = in.AccessTokenSecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabIdentityProviderSpec.
+func (in *GitLabIdentityProviderSpec) DeepCopy() *GitLabIdentityProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabIdentityProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitLabIdentityProviderStatus) DeepCopyInto(out *GitLabIdentityProviderStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitLabIdentityProviderStatus.
+func (in *GitLabIdentityProviderStatus) DeepCopy() *GitLabIdentityProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GitLabIdentityProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleIdentityProvider) DeepCopyInto(out *GoogleIdentityProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleIdentityProvider.
+func (in *GoogleIdentityProvider) DeepCopy() *GoogleIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleIdentityProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleIdentityProviderList) DeepCopyInto(out *GoogleIdentityProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]GoogleIdentityProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleIdentityProviderList.
+func (in *GoogleIdentityProviderList) DeepCopy() *GoogleIdentityProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleIdentityProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *GoogleIdentityProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleIdentityProviderSpec) DeepCopyInto(out *GoogleIdentityProviderSpec) {
+	*out = *in
+	out.ClientSecret = in.ClientSecret
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleIdentityProviderSpec.
+func (in *GoogleIdentityProviderSpec) DeepCopy() *GoogleIdentityProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleIdentityProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GoogleIdentityProviderStatus) DeepCopyInto(out *GoogleIdentityProviderStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GoogleIdentityProviderStatus.
+func (in *GoogleIdentityProviderStatus) DeepCopy() *GoogleIdentityProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(GoogleIdentityProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTPasswdIdentityProvider) DeepCopyInto(out *HTPasswdIdentityProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTPasswdIdentityProvider.
+func (in *HTPasswdIdentityProvider) DeepCopy() *HTPasswdIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(HTPasswdIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTPasswdIdentityProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTPasswdIdentityProviderList) DeepCopyInto(out *HTPasswdIdentityProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]HTPasswdIdentityProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTPasswdIdentityProviderList.
+func (in *HTPasswdIdentityProviderList) DeepCopy() *HTPasswdIdentityProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(HTPasswdIdentityProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *HTPasswdIdentityProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTPasswdIdentityProviderSpec) DeepCopyInto(out *HTPasswdIdentityProviderSpec) {
+	*out = *in
+	if in.UserSecrets != nil {
+		in, out := &in.UserSecrets, &out.UserSecrets
+		*out = make([]v1.SecretNameReference, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTPasswdIdentityProviderSpec.
+func (in *HTPasswdIdentityProviderSpec) DeepCopy() *HTPasswdIdentityProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(HTPasswdIdentityProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTPasswdIdentityProviderStatus) DeepCopyInto(out *HTPasswdIdentityProviderStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.UserChecksums != nil {
+		in, out := &in.UserChecksums, &out.UserChecksums
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTPasswdIdentityProviderStatus.
+func (in *HTPasswdIdentityProviderStatus) DeepCopy() *HTPasswdIdentityProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(HTPasswdIdentityProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Ingress) DeepCopyInto(out *Ingress) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Ingress.
+func (in *Ingress) DeepCopy() *Ingress {
+	if in == nil {
+		return nil
+	}
+	out := new(Ingress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *Ingress) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressList) DeepCopyInto(out *IngressList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Ingress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressList.
+func (in *IngressList) DeepCopy() *IngressList {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *IngressList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressSpec) DeepCopyInto(out *IngressSpec) {
+	*out = *in
+	if in.RouteSelectors != nil {
+		in, out := &in.RouteSelectors, &out.RouteSelectors
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.ExcludedNamespaces != nil {
+		in, out := &in.ExcludedNamespaces, &out.ExcludedNamespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressSpec.
+func (in *IngressSpec) DeepCopy() *IngressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IngressStatus) DeepCopyInto(out *IngressStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IngressStatus.
+func (in *IngressStatus) DeepCopy() *IngressStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(IngressStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfig) DeepCopyInto(out *KubeletConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfig.
+func (in *KubeletConfig) DeepCopy() *KubeletConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubeletConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfigList) DeepCopyInto(out *KubeletConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]KubeletConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigList.
+func (in *KubeletConfigList) DeepCopy() *KubeletConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *KubeletConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfigSpec) DeepCopyInto(out *KubeletConfigSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigSpec.
+func (in *KubeletConfigSpec) DeepCopy() *KubeletConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *KubeletConfigStatus) DeepCopyInto(out *KubeletConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new KubeletConfigStatus.
+func (in *KubeletConfigStatus) DeepCopy() *KubeletConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(KubeletConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPIdentityProvider) DeepCopyInto(out *LDAPIdentityProvider) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPIdentityProvider.
+func (in *LDAPIdentityProvider) DeepCopy() *LDAPIdentityProvider {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPIdentityProvider)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPIdentityProvider) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPIdentityProviderList) DeepCopyInto(out *LDAPIdentityProviderList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]LDAPIdentityProvider, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPIdentityProviderList.
+func (in *LDAPIdentityProviderList) DeepCopy() *LDAPIdentityProviderList {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPIdentityProviderList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *LDAPIdentityProviderList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPIdentityProviderSpec) DeepCopyInto(out *LDAPIdentityProviderSpec) {
+	*out = *in
+	out.BindPassword = in.BindPassword
+	out.CA = in.CA
+	in.Attributes.DeepCopyInto(&out.Attributes)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPIdentityProviderSpec.
+func (in *LDAPIdentityProviderSpec) DeepCopy() *LDAPIdentityProviderSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPIdentityProviderSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LDAPIdentityProviderStatus) DeepCopyInto(out *LDAPIdentityProviderStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LDAPIdentityProviderStatus.
+func (in *LDAPIdentityProviderStatus) DeepCopy() *LDAPIdentityProviderStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(LDAPIdentityProviderStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePool) DeepCopyInto(out *MachinePool) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePool.
+func (in *MachinePool) DeepCopy() *MachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachinePool) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolList) DeepCopyInto(out *MachinePoolList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]MachinePool, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolList.
+func (in *MachinePoolList) DeepCopy() *MachinePoolList {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *MachinePoolList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolProviderAWS) DeepCopyInto(out *MachinePoolProviderAWS) {
+	*out = *in
+	out.SpotInstances = in.SpotInstances
+	if in.AdditionalSecurityGroupIDs != nil {
+		in, out := &in.AdditionalSecurityGroupIDs, &out.AdditionalSecurityGroupIDs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolProviderAWS.
+func (in *MachinePoolProviderAWS) DeepCopy() *MachinePoolProviderAWS {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolProviderAWS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolProviderAWSSpotInstances) DeepCopyInto(out *MachinePoolProviderAWSSpotInstances) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolProviderAWSSpotInstances.
+func (in *MachinePoolProviderAWSSpotInstances) DeepCopy() *MachinePoolProviderAWSSpotInstances {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolProviderAWSSpotInstances)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolSchedule) DeepCopyInto(out *MachinePoolSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolSchedule.
+func (in *MachinePoolSchedule) DeepCopy() *MachinePoolSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolSpec) DeepCopyInto(out *MachinePoolSpec) {
+	*out = *in
+	in.DefaultMachinePoolFields.DeepCopyInto(&out.DefaultMachinePoolFields)
+	if in.Taints != nil {
+		in, out := &in.Taints, &out.Taints
+		*out = make([]corev1.Taint, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Upgrade = in.Upgrade
+	if in.AutoRepair != nil {
+		in, out := &in.AutoRepair, &out.AutoRepair
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KubeletConfigs != nil {
+		in, out := &in.KubeletConfigs, &out.KubeletConfigs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TuningConfigs != nil {
+		in, out := &in.TuningConfigs, &out.TuningConfigs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.AWS.DeepCopyInto(&out.AWS)
+	if in.Schedules != nil {
+		in, out := &in.Schedules, &out.Schedules
+		*out = make([]MachinePoolSchedule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolSpec.
+func (in *MachinePoolSpec) DeepCopy() *MachinePoolSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolStatus) DeepCopyInto(out *MachinePoolStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.AvailabilityZones != nil {
+		in, out := &in.AvailabilityZones, &out.AvailabilityZones
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Subnets != nil {
+		in, out := &in.Subnets, &out.Subnets
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.Upgrade = in.Upgrade
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolStatus.
+func (in *MachinePoolStatus) DeepCopy() *MachinePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolUpgrade) DeepCopyInto(out *MachinePoolUpgrade) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolUpgrade.
+func (in *MachinePoolUpgrade) DeepCopy() *MachinePoolUpgrade {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolUpgrade)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MachinePoolUpgradeStatus) DeepCopyInto(out *MachinePoolUpgradeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MachinePoolUpgradeStatus.
+func (in *MachinePoolUpgradeStatus) DeepCopy() *MachinePoolUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(MachinePoolUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSACluster) DeepCopyInto(out *ROSACluster) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSACluster.
+func (in *ROSACluster) DeepCopy() *ROSACluster {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSACluster)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ROSACluster) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterClass) DeepCopyInto(out *ROSAClusterClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterClass.
+func (in *ROSAClusterClass) DeepCopy() *ROSAClusterClass {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ROSAClusterClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterClassEncryption) DeepCopyInto(out *ROSAClusterClassEncryption) {
+	*out = *in
+	out.ETCD = in.ETCD
+	out.EBS = in.EBS
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterClassEncryption.
+func (in *ROSAClusterClassEncryption) DeepCopy() *ROSAClusterClassEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterClassEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterClassIAM) DeepCopyInto(out *ROSAClusterClassIAM) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterClassIAM.
+func (in *ROSAClusterClassIAM) DeepCopy() *ROSAClusterClassIAM {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterClassIAM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterClassKey) DeepCopyInto(out *ROSAClusterClassKey) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterClassKey.
+func (in *ROSAClusterClassKey) DeepCopy() *ROSAClusterClassKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterClassKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterClassList) DeepCopyInto(out *ROSAClusterClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ROSAClusterClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterClassList.
+func (in *ROSAClusterClassList) DeepCopy() *ROSAClusterClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ROSAClusterClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterClassMachinePool) DeepCopyInto(out *ROSAClusterClassMachinePool) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterClassMachinePool.
+func (in *ROSAClusterClassMachinePool) DeepCopy() *ROSAClusterClassMachinePool {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterClassMachinePool)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterClassProxy) DeepCopyInto(out *ROSAClusterClassProxy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterClassProxy.
+func (in *ROSAClusterClassProxy) DeepCopy() *ROSAClusterClassProxy {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterClassProxy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterClassReference) DeepCopyInto(out *ROSAClusterClassReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterClassReference.
+func (in *ROSAClusterClassReference) DeepCopy() *ROSAClusterClassReference {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterClassReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterClassSpec) DeepCopyInto(out *ROSAClusterClassSpec) {
+	*out = *in
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.IAM = in.IAM
+	out.Encryption = in.Encryption
+	out.Proxy = in.Proxy
+	in.DefaultMachinePool.DeepCopyInto(&out.DefaultMachinePool)
+	if in.HibernationSchedules != nil {
+		in, out := &in.HibernationSchedules, &out.HibernationSchedules
+		*out = make([]ROSAHibernationSchedule, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterClassSpec.
+func (in *ROSAClusterClassSpec) DeepCopy() *ROSAClusterClassSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterClassSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterList) DeepCopyInto(out *ROSAClusterList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ROSACluster, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterList.
+func (in *ROSAClusterList) DeepCopy() *ROSAClusterList {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ROSAClusterList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterSpec) DeepCopyInto(out *ROSAClusterSpec) {
+	*out = *in
+	in.DefaultMachinePool.DeepCopyInto(&out.DefaultMachinePool)
+	out.Encryption = in.Encryption
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Network.DeepCopyInto(&out.Network)
+	out.IAM = in.IAM
+	out.KubeconfigSecret = in.KubeconfigSecret
+	if in.HibernationSchedules != nil {
+		in, out := &in.HibernationSchedules, &out.HibernationSchedules
+		*out = make([]ROSAHibernationSchedule, len(*in))
+		copy(*out, *in)
+	}
+	out.ClassRef = in.ClassRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterSpec.
+func (in *ROSAClusterSpec) DeepCopy() *ROSAClusterSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterStatus) DeepCopyInto(out *ROSAClusterStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	out.Upgrade = in.Upgrade
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterStatus.
+func (in *ROSAClusterStatus) DeepCopy() *ROSAClusterStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAClusterUpgradeStatus) DeepCopyInto(out *ROSAClusterUpgradeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAClusterUpgradeStatus.
+func (in *ROSAClusterUpgradeStatus) DeepCopy() *ROSAClusterUpgradeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAClusterUpgradeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAEncryption) DeepCopyInto(out *ROSAEncryption) {
+	*out = *in
+	out.ETCD = in.ETCD
+	out.EBS = in.EBS
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAEncryption.
+func (in *ROSAEncryption) DeepCopy() *ROSAEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAHibernationSchedule) DeepCopyInto(out *ROSAHibernationSchedule) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAHibernationSchedule.
+func (in *ROSAHibernationSchedule) DeepCopy() *ROSAHibernationSchedule {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAHibernationSchedule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAIAM) DeepCopyInto(out *ROSAIAM) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAIAM.
+func (in *ROSAIAM) DeepCopy() *ROSAIAM {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAIAM)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ROSAKey) DeepCopyInto(out *ROSAKey) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ROSAKey.
+func (in *ROSAKey) DeepCopy() *ROSAKey {
+	if in == nil {
+		return nil
+	}
+	out := new(ROSAKey)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -581,3 +1797,100 @@ func (in *ROSAProxy) DeepCopy() *ROSAProxy {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TuningConfig) DeepCopyInto(out *TuningConfig) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TuningConfig.
+func (in *TuningConfig) DeepCopy() *TuningConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(TuningConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TuningConfig) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TuningConfigList) DeepCopyInto(out *TuningConfigList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]TuningConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TuningConfigList.
+func (in *TuningConfigList) DeepCopy() *TuningConfigList {
+	if in == nil {
+		return nil
+	}
+	out := new(TuningConfigList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *TuningConfigList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TuningConfigSpec) DeepCopyInto(out *TuningConfigSpec) {
+	*out = *in
+	in.TunedSpec.DeepCopyInto(&out.TunedSpec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TuningConfigSpec.
+func (in *TuningConfigSpec) DeepCopy() *TuningConfigSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TuningConfigSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TuningConfigStatus) DeepCopyInto(out *TuningConfigStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TuningConfigStatus.
+func (in *TuningConfigStatus) DeepCopy() *TuningConfigStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(TuningConfigStatus)
+	in.DeepCopyInto(out)
+	return out
+}
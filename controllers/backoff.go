@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+)
+
+const (
+	transientRequeueBase = 5 * time.Second
+	transientRequeueMax  = 10 * time.Minute
+)
+
+// transientBackoff tracks the consecutive transient failures of each object across all controllers.
+var transientBackoff = newBackoff(transientRequeueBase, transientRequeueMax)
+
+// TransientRequeue records a transient failure for the object of a request and returns the delay
+// before the object is retried.  The delay increases exponentially with each consecutive failure.
+func TransientRequeue(req request.Request) time.Duration {
+	return transientBackoff.next(backoffKey(req.GetReconciler(), client.ObjectKeyFromObject(req.GetObject())))
+}
+
+// ResetTransientRequeue forgets the transient failures of the object of a request.
+func ResetTransientRequeue(req request.Request) {
+	transientBackoff.reset(backoffKey(req.GetReconciler(), client.ObjectKeyFromObject(req.GetObject())))
+}
+
+// backoff tracks the number of consecutive failures per object in order to retry each object with
+// an exponentially increasing delay.
+type backoff struct {
+	base     time.Duration
+	maximum  time.Duration
+	mutex    sync.Mutex
+	failures map[string]int
+}
+
+func newBackoff(base, maximum time.Duration) *backoff {
+	return &backoff{
+		base:     base,
+		maximum:  maximum,
+		failures: map[string]int{},
+	}
+}
+
+// next records a failure for an object and returns the delay before the object is retried.
+func (b *backoff) next(key string) time.Duration {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	failures := b.failures[key]
+	b.failures[key] = failures + 1
+
+	delay := b.base
+	for i := 0; i < failures && delay < b.maximum; i++ {
+		delay *= 2
+	}
+
+	if delay > b.maximum {
+		return b.maximum
+	}
+
+	return delay
+}
+
+// reset forgets the failures of an object.
+func (b *backoff) reset(key string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	delete(b.failures, key)
+}
+
+// backoffKey returns the key used to track the failures of an object.  Objects are identified by
+// the controller which reconciles them and their name, rather than their uid, so that the failures
+// of an object may also be forgotten once it no longer exists.
+func backoffKey(reconciler interface{}, name types.NamespacedName) string {
+	return fmt.Sprintf("%T/%s", reconciler, name)
+}
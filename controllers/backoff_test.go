@@ -0,0 +1,67 @@
+package controllers
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/rh-mobb/ocm-operator/internal/factory"
+)
+
+func Test_backoff_next(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		failures int
+		reset    bool
+		want     time.Duration
+	}{
+		{
+			name:     "ensure first failure uses the base delay",
+			failures: 1,
+			want:     time.Second,
+		},
+		{
+			name:     "ensure delay doubles with each consecutive failure",
+			failures: 3,
+			want:     4 * time.Second,
+		},
+		{
+			name:     "ensure delay is limited to the maximum",
+			failures: 10,
+			want:     10 * time.Second,
+		},
+		{
+			name:     "ensure delay is reset after a success",
+			failures: 3,
+			reset:    true,
+			want:     time.Second,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			b := newBackoff(time.Second, 10*time.Second)
+			key := backoffKey(factory.NewTestController(), types.NamespacedName{Namespace: "test", Name: "test"})
+
+			var got time.Duration
+			for i := 0; i < tt.failures; i++ {
+				got = b.next(key)
+			}
+
+			if tt.reset {
+				b.reset(key)
+				got = b.next(key)
+			}
+
+			if got != tt.want {
+				t.Errorf("backoff.next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -16,10 +16,16 @@ import (
 const (
 	conditionTypeReconciling               = "Reconciling"
 	conditionTypeUpstreamClusterExists     = "UpstreamClusterExists"
+	conditionTypeUpstreamClusterPaused     = "UpstreamClusterPaused"
+	conditionTypeFailed                    = "Failed"
 	conditionMessageReconcilingStart       = "beginning reconciliation"
 	conditionMessageReconcilingStop        = "ending reconciliation"
 	conditionMessageUpstreamClusterExists  = "upstream cluster exists"
 	conditionMessageUpstreamClusterMissing = "upstream cluster is missing"
+	conditionMessageUpstreamClusterPaused  = "upstream cluster is hibernating; reconciliation is paused"
+	conditionMessageUpstreamClusterRunning = "upstream cluster is running"
+	conditionMessageRecovered              = "reconciliation succeeded"
+	conditionReasonRecovered               = "Recovered"
 )
 
 var (
@@ -73,6 +79,80 @@ func UpstreamCluster(trigger triggers.Trigger, exists bool) *metav1.Condition {
 	}
 }
 
+// UpstreamClusterPaused returns a condition that gives the status of whether reconciliation is paused
+// because the upstream cluster is hibernating.
+func UpstreamClusterPaused(trigger triggers.Trigger, paused bool) *metav1.Condition {
+	message := conditionMessageUpstreamClusterRunning
+	status := metav1.ConditionFalse
+
+	if paused {
+		message = conditionMessageUpstreamClusterPaused
+		status = metav1.ConditionTrue
+	}
+
+	return &metav1.Condition{
+		Type:               conditionTypeUpstreamClusterPaused,
+		LastTransitionTime: metav1.Now(),
+		Status:             status,
+		Reason:             trigger.String(),
+		Message:            message,
+	}
+}
+
+// Failed returns a condition indicating that reconciliation failed with an error which will not
+// be resolved by retrying.
+func Failed(reason, message string, generation int64) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               conditionTypeFailed,
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: generation,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// Recovered returns a condition indicating that reconciliation succeeded after it had previously
+// failed.
+func Recovered(generation int64) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               conditionTypeFailed,
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: generation,
+		Reason:             conditionReasonRecovered,
+		Message:            conditionMessageRecovered,
+	}
+}
+
+// HasFailed determines if a workload has a failed condition set.
+func HasFailed(on workload.Workload) bool {
+	for _, existing := range on.GetConditions() {
+		if existing.Type == conditionTypeFailed {
+			return existing.Status == metav1.ConditionTrue
+		}
+	}
+
+	return false
+}
+
+// CurrentFailure returns the failed condition of a workload if it was set for the current generation
+// of the workload.  A failure which preceded the most recent change to the spec is not returned, as
+// the change may resolve it.
+func CurrentFailure(on workload.Workload) *metav1.Condition {
+	for i, existing := range on.GetConditions() {
+		if existing.Type == conditionTypeFailed {
+			if existing.Status != metav1.ConditionTrue || existing.ObservedGeneration < on.GetGeneration() {
+				return nil
+			}
+
+			return &on.GetConditions()[i]
+		}
+	}
+
+	return nil
+}
+
 // Update updates the conditions on a workload.
 func Update(req request.Request, condition *metav1.Condition) error {
 	// return if we already have the condition set
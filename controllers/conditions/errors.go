@@ -13,3 +13,9 @@ func UpdateDeletedConditionError(err error) error {
 func UpdateReconcilingConditionError(err error) error {
 	return fmt.Errorf("error updating reconciling condition - %w", err)
 }
+
+// UpdateFailedConditionError returns an error indicating an object was unable to update
+// the failed condition.
+func UpdateFailedConditionError(err error) error {
+	return fmt.Errorf("error updating failed condition - %w", err)
+}
@@ -1,5 +1,12 @@
 package controllers
 
+import (
+	"time"
+
+	"github.com/rh-mobb/ocm-operator/pkg/ratelimit"
+	"github.com/rh-mobb/ocm-operator/pkg/tracing"
+)
+
 // Config represents the startup options used to start each of the controllers
 // in this operator.  These are the options used across all controllers in
 // the operator.
@@ -9,4 +16,18 @@ type Config struct {
 	ProbeAddress          string
 	TokenFile             string
 	PollerIntervalMinutes int
+
+	// OCMRateLimit and AWSRateLimit are the rate limits and retries of requests
+	// to the OCM API, per connection, and to the AWS API, per account.
+	OCMRateLimit ratelimit.Config
+	AWSRateLimit ratelimit.Config
+
+	// RemoteAdminCredentials permits the admin credentials of a cluster to be retrieved from OCM
+	// to access it when it has no kubeconfig secret.  RemoteAdminCredentialsTTL is the duration for
+	// which the retrieved credentials are reused.
+	RemoteAdminCredentials    bool
+	RemoteAdminCredentialsTTL time.Duration
+
+	// Tracing is the configuration of the exporter of traces of reconciles.
+	Tracing tracing.Config
 }
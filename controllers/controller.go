@@ -10,10 +10,12 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/metrics"
 	"github.com/rh-mobb/ocm-operator/controllers/request"
 	"github.com/rh-mobb/ocm-operator/controllers/requeue"
 	"github.com/rh-mobb/ocm-operator/controllers/triggers"
 	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/tracing"
 )
 
 const (
@@ -44,8 +46,12 @@ type Controller interface {
 
 // Reconcile is a centralized, reusable reconciliation loop by which all controllers can
 // use as their reconciliation function.  It requires that a new request for each reconciliation
-// loop is created to track that status throughout each request.
-func Reconcile(ctx context.Context, controller Controller, ctrlReq ctrl.Request) (ctrl.Result, error) {
+// loop is created to track that status throughout each request.  Each reconciliation is traced
+// as a root span, which the phases of the reconciliation are children of.
+func Reconcile(ctx context.Context, controller Controller, ctrlReq ctrl.Request) (result ctrl.Result, err error) {
+	ctx, span := tracing.StartReconcile(ctx, ctrlReq.NamespacedName)
+	defer func() { tracing.End(span, err) }()
+
 	// create the reconcile request
 	req, err := controller.NewRequest(ctx, ctrlReq)
 	if err != nil {
@@ -53,9 +59,14 @@ func Reconcile(ctx context.Context, controller Controller, ctrlReq ctrl.Request)
 			return requeue.Skip(fmt.Errorf("unable to create request - %w", err))
 		}
 
+		// forget the failures of objects which were deleted while they were still failing
+		transientBackoff.reset(backoffKey(controller, ctrlReq.NamespacedName))
+
 		return requeue.Skip(nil)
 	}
 
+	tracing.SetObject(ctx, metrics.Controller(req.GetObject()), req.GetObject())
+
 	// determine what triggered the reconcile request
 	trigger := triggers.GetTrigger(req.GetObject())
 
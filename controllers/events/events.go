@@ -15,32 +15,44 @@ const (
 	Created
 	Updated
 	Deleted
+	Upgraded
+	Hibernated
+	Resumed
 )
 
 const (
-	UnknownString = "Unknown"
-	CreatedString = "Created"
-	UpdatedString = "Updated"
-	DeletedString = "Deleted"
+	UnknownString    = "Unknown"
+	CreatedString    = "Created"
+	UpdatedString    = "Updated"
+	DeletedString    = "Deleted"
+	UpgradedString   = "Upgraded"
+	HibernatedString = "Hibernated"
+	ResumedString    = "Resumed"
 )
 
 // String returns the string value of an event.
 func (event Event) String() string {
 	return map[Event]string{
-		Unknown: UnknownString,
-		Created: CreatedString,
-		Updated: UpdatedString,
-		Deleted: DeletedString,
+		Unknown:    UnknownString,
+		Created:    CreatedString,
+		Updated:    UpdatedString,
+		Deleted:    DeletedString,
+		Upgraded:   UpgradedString,
+		Hibernated: HibernatedString,
+		Resumed:    ResumedString,
 	}[event]
 }
 
 // Type returns the type of event.
 func (event Event) Type() string {
 	return map[Event]string{
-		Unknown: UnknownString,
-		Created: corev1.EventTypeNormal,
-		Updated: corev1.EventTypeNormal,
-		Deleted: corev1.EventTypeNormal,
+		Unknown:    UnknownString,
+		Created:    corev1.EventTypeNormal,
+		Updated:    corev1.EventTypeNormal,
+		Deleted:    corev1.EventTypeNormal,
+		Upgraded:   corev1.EventTypeNormal,
+		Hibernated: corev1.EventTypeNormal,
+		Resumed:    corev1.EventTypeNormal,
 	}[event]
 }
 
@@ -34,6 +34,21 @@ func TestEvent_String(t *testing.T) {
 			event: Deleted,
 			want:  DeletedString,
 		},
+		{
+			name:  "ensure upgraded event returns correct string",
+			event: Upgraded,
+			want:  UpgradedString,
+		},
+		{
+			name:  "ensure hibernated event returns correct string",
+			event: Hibernated,
+			want:  HibernatedString,
+		},
+		{
+			name:  "ensure resumed event returns correct string",
+			event: Resumed,
+			want:  ResumedString,
+		},
 	}
 
 	for _, tt := range tests {
@@ -75,6 +90,21 @@ func TestEvent_Type(t *testing.T) {
 			event: Deleted,
 			want:  corev1.EventTypeNormal,
 		},
+		{
+			name:  "ensure upgraded event returns correct type",
+			event: Upgraded,
+			want:  corev1.EventTypeNormal,
+		},
+		{
+			name:  "ensure hibernated event returns correct type",
+			event: Hibernated,
+			want:  corev1.EventTypeNormal,
+		},
+		{
+			name:  "ensure resumed event returns correct type",
+			event: Resumed,
+			want:  corev1.EventTypeNormal,
+		},
 	}
 
 	for _, tt := range tests {
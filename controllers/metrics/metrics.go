@@ -0,0 +1,133 @@
+package metrics
+
+import (
+	"reflect"
+	"strings"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	FailureTerminal  = "terminal"
+	FailureTransient = "transient"
+)
+
+var (
+	// PhaseDuration is the time taken to run each phase of a controller.
+	PhaseDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ocm_operator_phase_duration_seconds",
+			Help:    "Time taken to run a phase of a controller.",
+			Buckets: prometheus.ExponentialBuckets(0.01, 4, 8),
+		},
+		[]string{"controller", "phase"},
+	)
+
+	// PhaseFailures is the number of phases of a controller which returned an error, by whether the
+	// error was terminal or transient.
+	PhaseFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ocm_operator_phase_failures_total",
+			Help: "Number of phases of a controller which failed.",
+		},
+		[]string{"controller", "phase", "type"},
+	)
+
+	// ClusterProvisioningDuration is the time taken for a cluster to become ready after it was
+	// created in OpenShift Cluster Manager.
+	ClusterProvisioningDuration = prometheus.NewHistogram(
+		prometheus.HistogramOpts{
+			Name:    "ocm_operator_cluster_provisioning_duration_seconds",
+			Help:    "Time taken for a cluster to become ready after it was created.",
+			Buckets: []float64{600, 1200, 1800, 2400, 3000, 3600, 5400, 7200, 10800},
+		},
+	)
+
+	// ClusterStates and MachinePoolStates are the states of the clusters and machine pools which
+	// are managed by the operator.
+	ClusterStates = NewStateGauge(
+		"ocm_operator_cluster_state",
+		"State of a cluster in OpenShift Cluster Manager.  The value is 1 for the current state of the cluster.",
+	)
+
+	MachinePoolStates = NewStateGauge(
+		"ocm_operator_machine_pool_state",
+		"State of a machine pool in OpenShift Cluster Manager.  The value is 1 for the current state of the machine pool.",
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(
+		PhaseDuration,
+		PhaseFailures,
+		ClusterProvisioningDuration,
+		ClusterStates.gauge,
+		MachinePoolStates.gauge,
+	)
+}
+
+// Controller returns the name of the controller which reconciles an object, for use as the value
+// of a label.  The name is derived from the type of the object, as the kind is not always set on
+// objects which are retrieved from the cache.
+func Controller(object client.Object) string {
+	if kind := object.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return strings.ToLower(kind)
+	}
+
+	return strings.ToLower(reflect.Indirect(reflect.ValueOf(object)).Type().Name())
+}
+
+// StateGauge is a gauge which reports the current state of a set of objects.  Each object has a
+// single series, labelled by its state, with a value of 1.
+type StateGauge struct {
+	gauge *prometheus.GaugeVec
+
+	mutex  sync.Mutex
+	states map[types.NamespacedName]string
+}
+
+// NewStateGauge returns a new instance of a state gauge.
+func NewStateGauge(name, help string) *StateGauge {
+	return &StateGauge{
+		gauge: prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Name: name,
+				Help: help,
+			},
+			[]string{"namespace", "name", "state"},
+		),
+		states: map[types.NamespacedName]string{},
+	}
+}
+
+// Set sets the current state of an object, removing the series for its previous state.
+func (states *StateGauge) Set(object client.Object, state string) {
+	states.mutex.Lock()
+	defer states.mutex.Unlock()
+
+	key := client.ObjectKeyFromObject(object)
+
+	if previous, found := states.states[key]; found && previous != state {
+		states.gauge.DeleteLabelValues(key.Namespace, key.Name, previous)
+	}
+
+	states.states[key] = state
+	states.gauge.WithLabelValues(key.Namespace, key.Name, state).Set(1)
+}
+
+// Delete removes the state of an object which no longer exists.
+func (states *StateGauge) Delete(object client.Object) {
+	states.mutex.Lock()
+	defer states.mutex.Unlock()
+
+	key := client.ObjectKeyFromObject(object)
+
+	if previous, found := states.states[key]; found {
+		states.gauge.DeleteLabelValues(key.Namespace, key.Name, previous)
+		delete(states.states, key)
+	}
+}
@@ -0,0 +1,103 @@
+package metrics
+
+import (
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+)
+
+func TestStateGauge(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		states     []string
+		delete     bool
+		wantSeries int
+		wantState  string
+	}{
+		{
+			name:       "ensure a single series is reported for an object",
+			states:     []string{"installing"},
+			wantSeries: 1,
+			wantState:  "installing",
+		},
+		{
+			name:       "ensure the previous state is removed when the state changes",
+			states:     []string{"installing", "ready"},
+			wantSeries: 1,
+			wantState:  "ready",
+		},
+		{
+			name:       "ensure no series are reported once the object is deleted",
+			states:     []string{"installing", "ready"},
+			delete:     true,
+			wantSeries: 0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			states := NewStateGauge("test_state", "test")
+			object := &ocmv1alpha1.ROSACluster{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "test"}}
+
+			for _, state := range tt.states {
+				states.Set(object, state)
+			}
+
+			if tt.delete {
+				states.Delete(object)
+			}
+
+			if got := testutil.CollectAndCount(states.gauge); got != tt.wantSeries {
+				t.Fatalf("StateGauge series = %v, want %v", got, tt.wantSeries)
+			}
+
+			if tt.wantState == "" {
+				return
+			}
+
+			if got := testutil.ToFloat64(states.gauge.WithLabelValues("test", "test", tt.wantState)); got != 1 {
+				t.Errorf("StateGauge value for state %s = %v, want %v", tt.wantState, got, 1)
+			}
+		})
+	}
+}
+
+func TestController(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		object *ocmv1alpha1.ROSACluster
+		want   string
+	}{
+		{
+			name:   "ensure the type name is used when the kind is not set",
+			object: &ocmv1alpha1.ROSACluster{},
+			want:   "rosacluster",
+		},
+		{
+			name:   "ensure the kind is used when it is set",
+			object: &ocmv1alpha1.ROSACluster{TypeMeta: metav1.TypeMeta{Kind: "ROSACluster"}},
+			want:   "rosacluster",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Controller(tt.object); got != tt.want {
+				t.Errorf("Controller() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
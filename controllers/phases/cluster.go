@@ -15,7 +15,8 @@ import (
 )
 
 const (
-	defaultMissingUpstreamRequeue = 60 * time.Second
+	defaultMissingUpstreamRequeue     = 60 * time.Second
+	defaultHibernatingUpstreamRequeue = 5 * time.Minute
 )
 
 // HandleClusterPhase is the common phase that handles the upstream cluster for a child request.  It
@@ -56,6 +57,33 @@ func HandleClusterPhase(
 		return requeue.After(defaultMissingUpstreamRequeue, nil)
 	}
 
+	// pause reconciliation if the cluster is hibernating.  this is an expected state of the
+	// cluster, rather than a cluster which is not yet ready, so we check again less frequently.
+	paused := isHibernating(cluster)
+
+	if err := conditions.Update(
+		req,
+		conditions.UpstreamClusterPaused(trigger, paused),
+	); err != nil {
+		return requeue.After(defaultMissingUpstreamRequeue, fmt.Errorf(
+			"unable to update status on cluster: [%s] - %w",
+			req.GetClusterName(),
+			err,
+		))
+	}
+
+	if paused {
+		logger.Info(
+			fmt.Sprintf(
+				"cluster [%s] with state [%s] is hibernating; pausing reconciliation",
+				req.GetClusterName(),
+				cluster.State(),
+			), request.LogValues(req)...)
+		logger.Info(fmt.Sprintf("checking again in %s", defaultHibernatingUpstreamRequeue.String()), request.LogValues(req)...)
+
+		return requeue.After(defaultHibernatingUpstreamRequeue, nil)
+	}
+
 	// return if the cluster is not ready
 	if cluster.State() != clustersmgmtv1.ClusterStateReady {
 		logger.Info(
@@ -71,3 +99,16 @@ func HandleClusterPhase(
 
 	return Next()
 }
+
+// isHibernating returns whether the cluster is hibernating or is transitioning in or out
+// of hibernation.
+func isHibernating(cluster *clustersmgmtv1.Cluster) bool {
+	switch cluster.State() {
+	case clustersmgmtv1.ClusterStateHibernating,
+		clustersmgmtv1.ClusterStatePoweringDown,
+		clustersmgmtv1.ClusterStateResuming:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,6 +1,7 @@
 package phases
 
 import (
+	"context"
 	"errors"
 	"reflect"
 	"testing"
@@ -40,13 +41,16 @@ func newTestClusterFetcher(state clustersmgmtv1.ClusterState, err bool) *testClu
 	return fetcher
 }
 
-func (t *testClusterFetcher) Get() (*clustersmgmtv1.Cluster, error) { return t.cluster, t.err }
-func (t *testClusterFetcher) For(s string) *clustersmgmtv1.ClusterClient {
-	return &clustersmgmtv1.ClusterClient{}
+func (t *testClusterFetcher) Get(context.Context) (*clustersmgmtv1.Cluster, error) {
+	return t.cluster, t.err
+}
+func (t *testClusterFetcher) GetByID(context.Context, string) (*clustersmgmtv1.Cluster, error) {
+	return t.cluster, t.err
 }
 
 func TestHandleClusterPhase(t *testing.T) {
 	requeueResult, _ := requeue.After(defaultMissingUpstreamRequeue, nil)
+	pausedResult, _ := requeue.After(defaultHibernatingUpstreamRequeue, nil)
 
 	t.Parallel()
 
@@ -106,6 +110,17 @@ func TestHandleClusterPhase(t *testing.T) {
 			want:    requeueResult,
 			wantErr: false,
 		},
+		{
+			name: "ensure paused requeue without error on hibernating cluster",
+			args: args{
+				req:     factory.NewTestRequest(defaultMissingUpstreamRequeue, factory.NewTestWorkload("")),
+				client:  newTestClusterFetcher(clustersmgmtv1.ClusterStateHibernating, false),
+				trigger: triggers.Create,
+				logger:  ctrl.Log.WithName("requeue-on-hibernating"),
+			},
+			want:    pausedResult,
+			wantErr: false,
+		},
 		{
 			name: "ensure no requeue without error on ready cluster",
 			args: args{
@@ -33,6 +33,8 @@ func CompleteDestroy(req request.Request, controller controllers.Controller) (ct
 		return requeue.OnError(req, controllers.RemoveFinalizerError(err))
 	}
 
+	controllers.ResetTransientRequeue(req)
+
 	controller.Log().Info("completed object deletion", request.LogValues(req)...)
 
 	// do not requeue since the object is now deleted
@@ -2,11 +2,17 @@ package phases
 
 import (
 	"fmt"
+	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	"github.com/rh-mobb/ocm-operator/controllers"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/metrics"
 	"github.com/rh-mobb/ocm-operator/controllers/request"
 	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/pkg/tracing"
 )
 
 // handler represents an object that handles individual phases.
@@ -25,22 +31,86 @@ func NewHandler(req request.Request, phases ...phase) *handler {
 
 // Execute executes the phases for a handler.
 func (handler *handler) Execute() (ctrl.Result, error) {
+	controller := metrics.Controller(handler.Request.GetObject())
+
 	for execute := range handler.Phases {
+		name := handler.Phases[execute].Name
+
 		// run each phase function and return if we receive any errors
+		start := time.Now()
+		span := tracing.StartPhase(handler.Request.GetContext(), name)
 		result, err := handler.Phases[execute].Function()
+
+		tracing.EndPhase(handler.Request.GetContext(), span, err)
+		metrics.PhaseDuration.WithLabelValues(controller, name).Observe(time.Since(start).Seconds())
+
 		if err != nil {
-			return requeue.OnError(handler.Request, request.Error(handler.Request, fmt.Errorf(
+			return handler.fail(name, request.Error(handler.Request, fmt.Errorf(
 				"error in phase [%s] - %w",
-				handler.Phases[execute].Name,
+				name,
 				err,
 			)))
 		}
 
 		// requeue if we are instructed to requeue
 		if result.Requeue {
-			return result, nil
+			return handler.succeed(result)
+		}
+	}
+
+	return handler.succeed(ctrl.Result{})
+}
+
+// fail handles an error returned by a phase.  Terminal errors set a failed condition on the object
+// and are not retried, so that the object is only reconciled again once it, or an object it
+// references, changes.  Transient errors are retried with a delay which
+// increases exponentially with each consecutive failure of the object.
+func (handler *handler) fail(phase string, err error) (ctrl.Result, error) {
+	object := handler.Request.GetObject()
+	controller := metrics.Controller(object)
+	logger := log.FromContext(handler.Request.GetContext()).WithValues(request.LogValues(handler.Request)...)
+
+	if terminal, ok := request.AsTerminal(err); ok && object.GetDeletionTimestamp() == nil {
+		metrics.PhaseFailures.WithLabelValues(controller, phase, metrics.FailureTerminal).Inc()
+
+		controllers.ResetTransientRequeue(handler.Request)
+
+		message := terminal.Message
+		if message == "" {
+			message = err.Error()
+		}
+
+		if err := conditions.Update(handler.Request, conditions.Failed(terminal.Reason, message, object.GetGeneration())); err != nil {
+			return requeue.OnError(handler.Request, conditions.UpdateFailedConditionError(err))
+		}
+
+		logger.Error(err, "reconciliation failed with a terminal error; waiting for object to change")
+
+		return requeue.None()
+	}
+
+	metrics.PhaseFailures.WithLabelValues(controller, phase, metrics.FailureTransient).Inc()
+
+	delay := controllers.TransientRequeue(handler.Request)
+
+	logger.Error(err, fmt.Sprintf("reconciliation failed; retrying in %s", delay))
+
+	return requeue.After(delay, nil)
+}
+
+// succeed handles a result returned by a phase without an error.  It resets the failures of the
+// object and clears the failed condition if one was previously set, regardless of whether the
+// object changed since it failed.
+func (handler *handler) succeed(result ctrl.Result) (ctrl.Result, error) {
+	object := handler.Request.GetObject()
+
+	controllers.ResetTransientRequeue(handler.Request)
+
+	if object.GetDeletionTimestamp() == nil && conditions.HasFailed(object) {
+		if err := conditions.Update(handler.Request, conditions.Recovered(object.GetGeneration())); err != nil {
+			return requeue.OnError(handler.Request, conditions.UpdateFailedConditionError(err))
 		}
 	}
 
-	return requeue.Skip(nil)
+	return result, nil
 }
@@ -4,9 +4,11 @@ import (
 	"errors"
 	"reflect"
 	"testing"
+	"time"
 
 	ctrl "sigs.k8s.io/controller-runtime"
 
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
 	"github.com/rh-mobb/ocm-operator/controllers/request"
 	"github.com/rh-mobb/ocm-operator/internal/factory"
 )
@@ -22,28 +24,62 @@ func Test_handler_Execute(t *testing.T) {
 		return ctrl.Result{RequeueAfter: testRequest.DefaultRequeue()}, errors.New("fail")
 	})
 
+	terminalWorkload := factory.NewTestWorkload("")
+	terminalWorkload.SetName("terminal")
+	terminalRequest := factory.NewTestRequest(factory.DefaultRequeue, terminalWorkload)
+	terminalPhase := NewPhase("invalid", func() (ctrl.Result, error) {
+		return ctrl.Result{}, request.NewValidationError("invalid")
+	})
+
+	failedWorkload := factory.NewTestWorkload("")
+	failedWorkload.SetName("failed")
+	failedWorkload.SetConditions(append(failedWorkload.GetConditions(), *conditions.Failed("Invalid", "invalid", 0)))
+	failedRequest := factory.NewTestRequest(factory.DefaultRequeue, failedWorkload)
+
 	requeueHandler := NewHandler(testRequest, successPhase, requeuePhase)
 	errorHandler := NewHandler(testRequest, successPhase, errorPhase, successPhase)
 	successHandler := NewHandler(testRequest, successPhase, successPhase, successPhase)
+	terminalHandler := NewHandler(terminalRequest, successPhase, terminalPhase, successPhase)
+	recoveredHandler := NewHandler(failedRequest, successPhase, successPhase)
 
 	type fields struct {
 		Phases  []phase
 		Request request.Request
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		want    ctrl.Result
-		wantErr bool
+		name       string
+		fields     fields
+		want       ctrl.Result
+		wantErr    bool
+		wantFailed bool
 	}{
 		{
-			name: "ensure phase with error returns a requeue result with error",
+			name: "ensure phase with transient error returns a requeue result with backoff",
 			fields: fields{
 				Request: errorHandler.Request,
 				Phases:  errorHandler.Phases,
 			},
-			want:    ctrl.Result{Requeue: true, RequeueAfter: testRequest.DefaultRequeue()},
-			wantErr: true,
+			want:    ctrl.Result{Requeue: true, RequeueAfter: 5 * time.Second},
+			wantErr: false,
+		},
+		{
+			name: "ensure phase with terminal error does not requeue",
+			fields: fields{
+				Request: terminalHandler.Request,
+				Phases:  terminalHandler.Phases,
+			},
+			want:       ctrl.Result{},
+			wantErr:    false,
+			wantFailed: true,
+		},
+		{
+			name: "ensure object which previously failed is reconciled and recovers without changing",
+			fields: fields{
+				Request: recoveredHandler.Request,
+				Phases:  recoveredHandler.Phases,
+			},
+			want:    ctrl.Result{},
+			wantErr: false,
 		},
 		{
 			name: "ensure phase with no error returns a result without an error",
@@ -82,6 +118,9 @@ func Test_handler_Execute(t *testing.T) {
 			if !reflect.DeepEqual(got, tt.want) {
 				t.Errorf("handler.Execute() = %v, want %v", got, tt.want)
 			}
+			if failed := conditions.HasFailed(tt.fields.Request.GetObject()); failed != tt.wantFailed {
+				t.Errorf("handler.Execute() failed = %v, want %v", failed, tt.wantFailed)
+			}
 		})
 	}
 }
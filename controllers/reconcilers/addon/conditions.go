@@ -0,0 +1,60 @@
+package addon
+
+import (
+	"fmt"
+	"strings"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+)
+
+const (
+	addOnConditionTypeDeleted = "AddOnDeleted"
+	addOnMessageDeleted       = "add-on has been deleted from openshift cluster manager"
+
+	addOnConditionTypeInstalled = "AddOnInstalled"
+	addOnReasonUnknown          = "Unknown"
+	addOnMessageInstalled       = "add-on installation is %s"
+)
+
+// AddOnDeleted return a condition indicating that the add-on has
+// been deleted from OpenShift Cluster Manager.
+func AddOnDeleted() *metav1.Condition {
+	return &metav1.Condition{
+		Type:               addOnConditionTypeDeleted,
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             triggers.Delete.String(),
+		Message:            addOnMessageDeleted,
+	}
+}
+
+// AddOnInstalled return a condition indicating the installation state of the add-on as reported
+// by OpenShift Cluster Manager.  The condition is only true when the add-on is ready.
+func AddOnInstalled(generation int64, state clustersmgmtv1.AddOnInstallationState, description string) *metav1.Condition {
+	status := metav1.ConditionFalse
+	if state == clustersmgmtv1.AddOnInstallationStateReady {
+		status = metav1.ConditionTrue
+	}
+
+	reason := addOnReasonUnknown
+	if state != "" {
+		reason = strings.ToUpper(string(state[0:1])) + string(state[1:])
+	}
+
+	message := fmt.Sprintf(addOnMessageInstalled, state)
+	if description != "" {
+		message = description
+	}
+
+	return &metav1.Condition{
+		Type:               addOnConditionTypeInstalled,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: generation,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+	}
+}
@@ -0,0 +1,59 @@
+package addon
+
+import (
+	"errors"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+)
+
+var (
+	ErrAddOnMissingParameter = errors.New("missing add-on parameter value")
+	ErrAddOnFailed           = errors.New("add-on installation failed")
+)
+
+// errGetParameter produces an error indicating the value of an add-on parameter was unable to
+// be retrieved.
+func errGetParameter(request *AddOnRequest, name string, err error) error {
+	return fmt.Errorf(
+		"unable to retrieve value of parameter [%s] for add-on [%s] - %w",
+		name,
+		request.GetName(),
+		err,
+	)
+}
+
+// errUnableToUpdateStatus produces an error indicating the add-on status was unable
+// to be updated.
+func errUnableToUpdateStatus(request *AddOnRequest, state, version string, err error) (ctrl.Result, error) {
+	return requeue.OnError(request, fmt.Errorf(
+		"unable to update add-on [%s] status [state=%s, version=%s] - %w",
+		request.GetName(),
+		state,
+		version,
+		err,
+	))
+}
+
+// errUpdateAddOnInstalledCondition produces an error indicating the installed condition of the
+// add-on was unable to be updated.
+func errUpdateAddOnInstalledCondition(request *AddOnRequest, err error) error {
+	return fmt.Errorf(
+		"unable to update installed condition for add-on [%s] - %w",
+		request.GetName(),
+		err,
+	)
+}
+
+// errAddOnFailed produces an error indicating the add-on installation has failed in OCM.
+func errAddOnFailed(request *AddOnRequest, description string) error {
+	return fmt.Errorf(
+		"add-on [%s] on cluster [%s] reported [%s] - %w",
+		request.GetName(),
+		request.GetClusterName(),
+		description,
+		ErrAddOnFailed,
+	)
+}
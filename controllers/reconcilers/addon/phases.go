@@ -0,0 +1,209 @@
+package addon
+
+import (
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/events"
+	"github.com/rh-mobb/ocm-operator/controllers/phases"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// GetCurrentState gets the current state of the AddOn resource.  The current state of the AddOn resource
+// is stored in OpenShift Cluster Manager.  It will be compared against the desired state which exists
+// within the OpenShift cluster in which this controller is reconciling against.
+func (r *Controller) GetCurrentState(req *AddOnRequest) (ctrl.Result, error) {
+	// retrieve the desired parameter values, some of which may be stored in secrets
+	parameters, err := req.getParameters()
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	req.Parameters = parameters
+
+	req.OCMClient = ocm.NewAddOnClient(req.Reconciler.Connection, req.Desired.Spec.AddOnID, req.Original.Status.ClusterID)
+
+	addOn, err := req.OCMClient.Get(req.Context)
+	if err != nil {
+		return requeue.OnError(req, ocm.GetError(req, err))
+	}
+
+	// return if there is no add-on installation found
+	if addOn == nil {
+		return phases.Next()
+	}
+
+	// store the current state
+	req.Current = &ocmv1alpha1.AddOn{}
+	req.Current.Spec.ClusterName = req.Desired.Spec.ClusterName
+	req.Current.CopyFrom(addOn)
+
+	return phases.Next()
+}
+
+// ApplyAddOn applies the add-on state to OCM.  This includes installing and/or updating
+// the add-on based on the provided attributes from the custom resource.
+func (r *Controller) ApplyAddOn(req *AddOnRequest) (ctrl.Result, error) {
+	// return if it is already in its desired state
+	if req.desired() {
+		r.Logger.V(controllers.LogLevelDebug).Info(
+			"add-on already in desired state",
+			request.LogValues(req)...,
+		)
+
+		return phases.Next()
+	}
+
+	// install the add-on if it does not exist
+	if req.Current == nil {
+		r.Logger.Info("installing add-on", request.LogValues(req)...)
+		if _, err := req.OCMClient.Create(req.Context, req.Desired.Builder(req.Parameters)); err != nil {
+			return requeue.OnError(req, ocm.CreateError(req, err))
+		}
+
+		// create an event indicating that the add-on has been installed
+		events.RegisterAction(events.Created, req.Original, r.Recorder, req.GetName(), req.Original.Status.ClusterID)
+
+		return phases.Next()
+	}
+
+	// update the add-on if it does exist
+	r.Logger.Info("updating add-on", request.LogValues(req)...)
+	if _, err := req.OCMClient.Update(req.Context, req.Desired.Builder(req.Parameters)); err != nil {
+		return requeue.OnError(req, ocm.UpdateError(req, err))
+	}
+
+	// create an event indicating that the add-on has been updated
+	events.RegisterAction(events.Updated, req.Original, r.Recorder, req.GetName(), req.Original.Status.ClusterID)
+
+	return phases.Next()
+}
+
+// WaitUntilReady will requeue until the add-on installation is reported as ready by OCM.  The
+// installation state is stored in the status and reflected in the conditions of the object.
+func (r *Controller) WaitUntilReady(req *AddOnRequest) (ctrl.Result, error) {
+	addOn, err := req.OCMClient.Get(req.Context)
+	if err != nil {
+		return requeue.OnError(req, ocm.GetError(req, err))
+	}
+
+	// requeue if the add-on installation is not yet visible
+	if addOn == nil {
+		return requeue.Retry(req)
+	}
+
+	// store the installation state in the status
+	if req.Original.Status.State != string(addOn.State()) || req.Original.Status.Version != addOn.AddonVersion().ID() {
+		original := req.Original.DeepCopy()
+		req.Original.Status.State = string(addOn.State())
+		req.Original.Status.Version = addOn.AddonVersion().ID()
+
+		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+			return errUnableToUpdateStatus(req, req.Original.Status.State, req.Original.Status.Version, err)
+		}
+	}
+
+	if err := conditions.Update(
+		req,
+		AddOnInstalled(req.Original.GetGeneration(), addOn.State(), addOn.StateDescription()),
+	); err != nil {
+		return requeue.OnError(req, errUpdateAddOnInstalledCondition(req, err))
+	}
+
+	switch addOn.State() {
+	case clustersmgmtv1.AddOnInstallationStateReady:
+		r.Logger.Info("add-on is ready", request.LogValues(req)...)
+
+		return phases.Next()
+	case clustersmgmtv1.AddOnInstallationStateFailed:
+		return requeue.OnError(req, errAddOnFailed(req, addOn.StateDescription()))
+	default:
+		r.Logger.Info(
+			"waiting for add-on to become ready",
+			append(request.LogValues(req), "state", addOn.State())...,
+		)
+
+		return requeue.Retry(req)
+	}
+}
+
+// Destroy will uninstall an OpenShift Cluster Manager add-on.
+func (r *Controller) Destroy(req *AddOnRequest) (ctrl.Result, error) {
+	// return immediately if we have already deleted the add-on
+	if conditions.IsSet(AddOnDeleted(), req.Original) {
+		return phases.Next()
+	}
+
+	// return if the cluster does not exist (has been deleted)
+	_, exists, err := ocm.ClusterExists(req.Context, req.Desired.Spec.ClusterName, req.Reconciler.Connection)
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	if !exists {
+		return phases.Next()
+	}
+
+	// return if the add-on was never installed
+	if req.Original.Status.ClusterID == "" {
+		return phases.Next()
+	}
+
+	// delete the object
+	r.Logger.Info("uninstalling add-on", request.LogValues(req)...)
+	if err := ocm.NewAddOnClient(
+		req.Reconciler.Connection,
+		req.Desired.Spec.AddOnID,
+		req.Original.Status.ClusterID,
+	).Delete(req.Context); err != nil {
+		return requeue.OnError(req, ocm.DeleteError(req, err))
+	}
+
+	// create an event indicating that the add-on has been deleted
+	events.RegisterAction(events.Deleted, req.Original, r.Recorder, req.GetName(), req.Original.Status.ClusterID)
+
+	// set the deleted condition
+	if err := conditions.Update(req, AddOnDeleted()); err != nil {
+		return requeue.OnError(req, conditions.UpdateDeletedConditionError(err))
+	}
+
+	return phases.Next()
+}
+
+// WaitUntilMissing will requeue until the add-on has been uninstalled from the cluster.
+func (r *Controller) WaitUntilMissing(req *AddOnRequest) (ctrl.Result, error) {
+	// return if the add-on was never installed
+	if req.Original.Status.ClusterID == "" {
+		return phases.Next()
+	}
+
+	// a missing add-on installation is reported for clusters which no longer exist, so we
+	// do not need to check for the existence of the cluster here
+	addOn, err := ocm.NewAddOnClient(
+		req.Reconciler.Connection,
+		req.Desired.Spec.AddOnID,
+		req.Original.Status.ClusterID,
+	).Get(req.Context)
+	if err != nil {
+		return requeue.OnError(req, ocm.GetError(req, err))
+	}
+
+	if addOn != nil {
+		r.Logger.Info(
+			"waiting for add-on to be uninstalled",
+			append(request.LogValues(req), "state", addOn.State())...,
+		)
+
+		return requeue.Retry(req)
+	}
+
+	r.Logger.Info("add-on has been uninstalled", request.LogValues(req)...)
+
+	return phases.Next()
+}
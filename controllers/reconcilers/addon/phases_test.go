@@ -0,0 +1,338 @@
+package addon
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm/ocmtest"
+)
+
+const (
+	addOnsPath = "/api/clusters_mgmt/v1/clusters/abc/addons"
+	addOnPath  = addOnsPath + "/cluster-logging-operator"
+)
+
+// addOnResponse returns the response for an add-on installation as it is reported by ocm.
+func addOnResponse(
+	t *testing.T,
+	state clustersmgmtv1.AddOnInstallationState,
+	description string,
+	parameters map[string]string,
+) ocmtest.Response {
+	t.Helper()
+
+	items := []*clustersmgmtv1.AddOnInstallationParameterBuilder{}
+	for name, value := range parameters {
+		items = append(items, clustersmgmtv1.NewAddOnInstallationParameter().ID(name).Value(value))
+	}
+
+	object, err := clustersmgmtv1.NewAddOnInstallation().
+		ID("cluster-logging-operator").
+		Addon(clustersmgmtv1.NewAddOn().ID("cluster-logging-operator")).
+		AddonVersion(clustersmgmtv1.NewAddOnVersion().ID("1.0.0")).
+		State(state).
+		StateDescription(description).
+		Parameters(clustersmgmtv1.NewAddOnInstallationParameterList().Items(items...)).
+		Build()
+	if err != nil {
+		t.Fatalf("unable to build add-on installation - %v", err)
+	}
+
+	return ocmtest.Response{Body: ocmtest.Marshal(t, object, clustersmgmtv1.MarshalAddOnInstallation)}
+}
+
+// newTestRequest returns a request for an add-on, installed on the cluster with an id of abc,
+// which has one parameter set directly and another which is read from a secret.
+func newTestRequest(t *testing.T, server *ocmtest.Server) *AddOnRequest {
+	t.Helper()
+
+	addOn := &ocmv1alpha1.AddOn{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "logging", Generation: 3},
+		Spec: ocmv1alpha1.AddOnSpec{
+			ClusterName: "test",
+			AddOnID:     "cluster-logging-operator",
+			Parameters: []ocmv1alpha1.AddOnParameter{
+				{Name: "retention", Value: "7d"},
+				{Name: "token", Secret: &configv1.SecretNameReference{Name: "logging"}},
+			},
+		},
+		Status: ocmv1alpha1.AddOnStatus{ClusterID: "abc"},
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "logging"},
+		Data:       map[string][]byte{"token": []byte("secret-token")},
+	}
+
+	dependencies := controllertest.New(t, server, addOn, secret)
+
+	controller := &Controller{
+		Client:     dependencies.Client,
+		Scheme:     dependencies.Scheme,
+		Connection: dependencies.Connection,
+		Recorder:   dependencies.Recorder,
+		Logger:     dependencies.Logger,
+	}
+
+	return &AddOnRequest{
+		Context:    context.Background(),
+		Original:   addOn,
+		Desired:    addOn.DeepCopy(),
+		Reconciler: controller,
+	}
+}
+
+func TestController_ApplyAddOn(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		current     map[string]string
+		wantRequest string
+	}{
+		{
+			name:        "ensure missing add-on is installed with parameters from secrets",
+			wantRequest: http.MethodPost + " " + addOnsPath,
+		},
+		{
+			name:        "ensure add-on with a drifted parameter is updated",
+			current:     map[string]string{"retention": "7d", "token": "old-token"},
+			wantRequest: http.MethodPatch + " " + addOnPath,
+		},
+		{
+			name:    "ensure add-on with additional parameters determined by ocm is unchanged",
+			current: map[string]string{"retention": "7d", "token": "secret-token", "replicas": "3"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			routes := map[string]ocmtest.Response{
+				http.MethodPost + " " + addOnsPath: {Status: http.StatusCreated, Body: `{"kind":"AddOnInstallation"}`},
+				http.MethodPatch + " " + addOnPath: {Body: `{"kind":"AddOnInstallation"}`},
+			}
+			if tt.current != nil {
+				routes[http.MethodGet+" "+addOnPath] = addOnResponse(t, clustersmgmtv1.AddOnInstallationStateReady, "", tt.current)
+			}
+
+			server := ocmtest.NewServer(t, routes)
+			req := newTestRequest(t, server)
+
+			if _, err := req.Reconciler.GetCurrentState(req); err != nil {
+				t.Fatalf("Controller.GetCurrentState() error = %v", err)
+			}
+
+			if _, err := req.Reconciler.ApplyAddOn(req); err != nil {
+				t.Fatalf("Controller.ApplyAddOn() error = %v", err)
+			}
+
+			requests := server.Requests()
+			if tt.wantRequest == "" {
+				if len(requests) != 0 {
+					t.Errorf("Controller.ApplyAddOn() requests = %v, want none", requests)
+				}
+
+				return
+			}
+
+			if len(requests) != 1 || requests[0].Method+" "+requests[0].Path != tt.wantRequest {
+				t.Fatalf("Controller.ApplyAddOn() requests = %v, want %s", requests, tt.wantRequest)
+			}
+
+			if !strings.Contains(requests[0].Body, `"secret-token"`) {
+				t.Errorf("Controller.ApplyAddOn() body = %s, want value of secret parameter", requests[0].Body)
+			}
+		})
+	}
+}
+
+func TestController_WaitUntilReady(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		state       clustersmgmtv1.AddOnInstallationState
+		description string
+		missing     bool
+		wantStatus  metav1.ConditionStatus
+		wantReason  string
+		wantMessage string
+		wantRequeue bool
+		wantErr     error
+	}{
+		{
+			name:        "ensure ready add-on is reported as installed",
+			state:       clustersmgmtv1.AddOnInstallationStateReady,
+			wantStatus:  metav1.ConditionTrue,
+			wantReason:  "Ready",
+			wantMessage: "add-on installation is ready",
+		},
+		{
+			name:        "ensure installing add-on is reported as not installed and requeued",
+			state:       clustersmgmtv1.AddOnInstallationStateInstalling,
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  "Installing",
+			wantMessage: "add-on installation is installing",
+			wantRequeue: true,
+		},
+		{
+			name:        "ensure failed add-on reports the description from ocm",
+			state:       clustersmgmtv1.AddOnInstallationStateFailed,
+			description: "missing required quota",
+			wantStatus:  metav1.ConditionFalse,
+			wantReason:  "Failed",
+			wantMessage: "missing required quota",
+			wantErr:     ErrAddOnFailed,
+		},
+		{
+			name:        "ensure add-on which is not yet visible is requeued",
+			missing:     true,
+			wantRequeue: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			routes := map[string]ocmtest.Response{}
+			if !tt.missing {
+				routes[http.MethodGet+" "+addOnPath] = addOnResponse(t, tt.state, tt.description, nil)
+			}
+
+			server := ocmtest.NewServer(t, routes)
+			req := newTestRequest(t, server)
+
+			if _, err := req.Reconciler.GetCurrentState(req); err != nil {
+				t.Fatalf("Controller.GetCurrentState() error = %v", err)
+			}
+
+			result, err := req.Reconciler.WaitUntilReady(req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Controller.WaitUntilReady() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && result.Requeue != tt.wantRequeue {
+				t.Errorf("Controller.WaitUntilReady() requeue = %v, want %v", result.Requeue, tt.wantRequeue)
+			}
+
+			// the installation state must be stored in the cluster rather than only on the request
+			stored := &ocmv1alpha1.AddOn{}
+			if err := req.Reconciler.Get(context.Background(), client.ObjectKeyFromObject(req.Original), stored); err != nil {
+				t.Fatalf("unable to get add-on - %v", err)
+			}
+
+			if stored.Status.State != string(tt.state) {
+				t.Errorf("Controller.WaitUntilReady() status.state = %v, want %v", stored.Status.State, tt.state)
+			}
+
+			var installed *metav1.Condition
+			for i := range stored.Status.Conditions {
+				if stored.Status.Conditions[i].Type == addOnConditionTypeInstalled {
+					installed = &stored.Status.Conditions[i]
+				}
+			}
+
+			if tt.missing {
+				if installed != nil {
+					t.Errorf("Controller.WaitUntilReady() condition = %v, want none", installed)
+				}
+
+				return
+			}
+
+			if installed == nil {
+				t.Fatalf("Controller.WaitUntilReady() condition missing")
+			}
+
+			if installed.Status != tt.wantStatus || installed.Reason != tt.wantReason || installed.Message != tt.wantMessage {
+				t.Errorf(
+					"Controller.WaitUntilReady() condition = [%s, %s, %s], want [%s, %s, %s]",
+					installed.Status, installed.Reason, installed.Message,
+					tt.wantStatus, tt.wantReason, tt.wantMessage,
+				)
+			}
+
+			if installed.ObservedGeneration != req.Original.Generation {
+				t.Errorf("Controller.WaitUntilReady() condition generation = %v, want %v", installed.ObservedGeneration, req.Original.Generation)
+			}
+		})
+	}
+}
+
+func TestController_Destroy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		clusterName string
+		installed   bool
+		wantDelete  bool
+		wantRequeue bool
+	}{
+		{
+			name:        "ensure installed add-on is uninstalled and waited for",
+			clusterName: "destroy-installed",
+			installed:   true,
+			wantDelete:  true,
+			wantRequeue: true,
+		},
+		{
+			name:        "ensure add-on which has finished uninstalling is not waited for",
+			clusterName: "destroy-uninstalled",
+			wantDelete:  true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			routes := map[string]ocmtest.Response{
+				http.MethodGet + " /api/clusters_mgmt/v1/clusters": ocmtest.ClusterList("abc", tt.clusterName),
+				http.MethodDelete + " " + addOnPath:                {Status: http.StatusNoContent},
+			}
+			if tt.installed {
+				routes[http.MethodGet+" "+addOnPath] = addOnResponse(t, clustersmgmtv1.AddOnInstallationStateDeleting, "", nil)
+			}
+
+			server := ocmtest.NewServer(t, routes)
+			req := newTestRequest(t, server)
+			req.Desired.Spec.ClusterName = tt.clusterName
+
+			if _, err := req.Reconciler.Destroy(req); err != nil {
+				t.Fatalf("Controller.Destroy() error = %v", err)
+			}
+
+			deleted := len(server.Requests()) == 1 && server.Requests()[0].Method == http.MethodDelete
+			if deleted != tt.wantDelete {
+				t.Errorf("Controller.Destroy() deleted = %v, want %v", deleted, tt.wantDelete)
+			}
+
+			result, err := req.Reconciler.WaitUntilMissing(req)
+			if err != nil {
+				t.Fatalf("Controller.WaitUntilMissing() error = %v", err)
+			}
+
+			if result.Requeue != tt.wantRequeue {
+				t.Errorf("Controller.WaitUntilMissing() requeue = %v, want %v", result.Requeue, tt.wantRequeue)
+			}
+		})
+	}
+}
@@ -0,0 +1,159 @@
+package addon
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+	"github.com/rh-mobb/ocm-operator/controllers/workload"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// AddOnRequest is an object that is unique to each reconciliation
+// req.
+type AddOnRequest struct {
+	Context           context.Context
+	ControllerRequest ctrl.Request
+	Current           *ocmv1alpha1.AddOn
+	Original          *ocmv1alpha1.AddOn
+	Desired           *ocmv1alpha1.AddOn
+	Trigger           triggers.Trigger
+	Reconciler        *Controller
+	OCMClient         *ocm.AddOnClient
+
+	// data obtained during request reconciliation
+	Parameters map[string]string
+}
+
+// This controller must have the ability to pull secrets which store the values of
+// add-on parameters.
+
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
+	original := &ocmv1alpha1.AddOn{}
+
+	// get the object (desired state) from the cluster
+	if err := r.Get(ctx, ctrlReq.NamespacedName, original); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return &AddOnRequest{}, fmt.Errorf("unable to fetch cluster object - %w", err)
+		}
+
+		return &AddOnRequest{}, err
+	}
+
+	return &AddOnRequest{
+		Original:          original,
+		Desired:           original.DeepCopy(),
+		ControllerRequest: ctrlReq,
+		Context:           ctx,
+		Trigger:           triggers.GetTrigger(original),
+		Reconciler:        r,
+	}, nil
+}
+
+// DefaultRequeue returns the default requeue time for a request.
+func (req *AddOnRequest) DefaultRequeue() time.Duration {
+	return defaultAddOnRequeue
+}
+
+// GetObject returns the original object to satisfy the controllers.Request interface.
+func (req *AddOnRequest) GetObject() workload.Workload {
+	return req.Original
+}
+
+// GetName returns the name as it should appear in OCM.  Add-on installations are identified
+// by the ID of the add-on.
+func (req *AddOnRequest) GetName() string {
+	return req.Desired.Spec.AddOnID
+}
+
+// GetClusterName returns the cluster name that this object belongs to.
+func (req *AddOnRequest) GetClusterName() string {
+	return req.Desired.Spec.ClusterName
+}
+
+// GetContext returns the context of the request.
+func (req *AddOnRequest) GetContext() context.Context {
+	return req.Context
+}
+
+// GetReconciler returns the context of the request.
+func (req *AddOnRequest) GetReconciler() kubernetes.Client {
+	return req.Reconciler
+}
+
+// SetClusterStatus sets the relevant cluster fields in the status.  It is used
+// to satisfy the request.Request interface.
+func (req *AddOnRequest) SetClusterStatus(cluster *clustersmgmtv1.Cluster) {
+	if req.Original.Status.ClusterID == "" {
+		req.Original.Status.ClusterID = cluster.ID()
+	}
+}
+
+// getParameters retrieves the values of the desired parameters, reading the values which are
+// stored in secrets from the cluster.
+func (req *AddOnRequest) getParameters() (map[string]string, error) {
+	parameters := map[string]string{}
+
+	for _, parameter := range req.Desired.Spec.Parameters {
+		if parameter.Secret == nil {
+			parameters[parameter.Name] = parameter.Value
+
+			continue
+		}
+
+		value, err := kubernetes.GetSecretData(
+			req.Context,
+			req.Reconciler,
+			parameter.Secret.Name,
+			req.Original.Namespace,
+			parameter.Name,
+		)
+		if err != nil {
+			return map[string]string{}, errGetParameter(req, parameter.Name, err)
+		}
+
+		if value == "" {
+			return map[string]string{}, errGetParameter(req, parameter.Name, ErrAddOnMissingParameter)
+		}
+
+		parameters[parameter.Name] = value
+	}
+
+	return parameters, nil
+}
+
+// desired determines if the add-on installation is in its desired state.  Parameters which are
+// not requested are ignored as ocm may set default values for them.
+func (req *AddOnRequest) desired() bool {
+	if req.Desired == nil || req.Current == nil {
+		return false
+	}
+
+	// ignore the version if it was not requested and has been determined by ocm
+	if req.Desired.Spec.Version != "" && req.Desired.Spec.Version != req.Current.Spec.Version {
+		return false
+	}
+
+	current := map[string]string{}
+	for _, parameter := range req.Current.Spec.Parameters {
+		current[parameter.Name] = parameter.Value
+	}
+
+	for name, value := range req.Parameters {
+		if currentValue, exists := current[name]; !exists || currentValue != value {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,79 @@
+package addon
+
+import (
+	"testing"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+)
+
+func TestAddOnRequest_desired(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		currentVersion    string
+		currentParameters []ocmv1alpha1.AddOnParameter
+		desiredVersion    string
+		parameters        map[string]string
+		missing           bool
+		want              bool
+	}{
+		{
+			name:              "ensure version and parameters determined by ocm reflect desired state",
+			currentVersion:    "1.0.0",
+			currentParameters: []ocmv1alpha1.AddOnParameter{{Name: "a", Value: "1"}, {Name: "b", Value: "2"}},
+			parameters:        map[string]string{"a": "1"},
+			want:              true,
+		},
+		{
+			name:           "ensure changed version does not reflect desired state",
+			currentVersion: "1.0.0",
+			desiredVersion: "1.1.0",
+			want:           false,
+		},
+		{
+			name:              "ensure changed parameters do not reflect desired state",
+			currentVersion:    "1.0.0",
+			currentParameters: []ocmv1alpha1.AddOnParameter{{Name: "a", Value: "1"}},
+			parameters:        map[string]string{"a": "2"},
+			want:              false,
+		},
+		{
+			name:           "ensure missing parameters do not reflect desired state",
+			currentVersion: "1.0.0",
+			parameters:     map[string]string{"a": "1"},
+			want:           false,
+		},
+		{
+			name:    "ensure missing current state does not reflect desired state",
+			missing: true,
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &AddOnRequest{
+				Desired:    &ocmv1alpha1.AddOn{Spec: ocmv1alpha1.AddOnSpec{AddOnID: "test", Version: tt.desiredVersion}},
+				Parameters: tt.parameters,
+			}
+
+			if !tt.missing {
+				request.Current = &ocmv1alpha1.AddOn{
+					Spec: ocmv1alpha1.AddOnSpec{
+						AddOnID:    "test",
+						Version:    tt.currentVersion,
+						Parameters: tt.currentParameters,
+					},
+				}
+			}
+
+			if got := request.desired(); got != tt.want {
+				t.Errorf("AddOnRequest.desired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package clusterautoscaler
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+)
+
+const (
+	clusterAutoscalerConditionTypeDeleted = "ClusterAutoscalerDeleted"
+	clusterAutoscalerMessageDeleted       = "cluster autoscaler has been deleted from openshift cluster manager"
+)
+
+// ClusterAutoscalerDeleted return a condition indicating that the cluster autoscaler has
+// been deleted from OpenShift Cluster Manager.
+func ClusterAutoscalerDeleted() *metav1.Condition {
+	return &metav1.Condition{
+		Type:               clusterAutoscalerConditionTypeDeleted,
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             triggers.Delete.String(),
+		Message:            clusterAutoscalerMessageDeleted,
+	}
+}
@@ -0,0 +1,22 @@
+package clusterautoscaler
+
+import (
+	"fmt"
+
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+)
+
+var (
+	ErrClusterAutoscalerHosted = request.NewValidationError("cluster autoscalers are not valid for clusters using a hosted control plane")
+)
+
+// errClusterAutoscalerHosted produces an error indicating the cluster autoscaler is unable to be created
+// because the cluster is using a hosted control plane.
+func errClusterAutoscalerHosted(request *ClusterAutoscalerRequest) error {
+	return fmt.Errorf(
+		"unable to apply cluster autoscaler [%s] to cluster [%s] - %w",
+		request.GetName(),
+		request.GetClusterName(),
+		ErrClusterAutoscalerHosted,
+	)
+}
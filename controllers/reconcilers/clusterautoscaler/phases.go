@@ -0,0 +1,121 @@
+package clusterautoscaler
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/events"
+	"github.com/rh-mobb/ocm-operator/controllers/phases"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// GetCurrentState gets the current state of the ClusterAutoscaler resource.  The current state of the ClusterAutoscaler
+// resource is stored in OpenShift Cluster Manager.  It will be compared against the desired state which exists
+// within the OpenShift cluster in which this controller is reconciling against.
+func (r *Controller) GetCurrentState(req *ClusterAutoscalerRequest) (ctrl.Result, error) {
+	// cluster autoscalers are only valid for clusters which are not using a hosted control plane
+	if req.Original.Status.Hosted {
+		return requeue.OnError(req, errClusterAutoscalerHosted(req))
+	}
+
+	req.OCMClient = ocm.NewClusterAutoscalerClient(req.Reconciler.Connection, req.Original.Status.ClusterID)
+
+	autoscaler, err := req.OCMClient.Get(req.Context)
+	if err != nil {
+		return requeue.OnError(req, ocm.GetError(req, err))
+	}
+
+	// return if there is no cluster autoscaler found
+	if autoscaler == nil {
+		return phases.Next()
+	}
+
+	// store the current state
+	req.Current = &ocmv1alpha1.ClusterAutoscaler{}
+	req.Current.Spec.ClusterName = req.Desired.Spec.ClusterName
+	req.Current.CopyFrom(autoscaler)
+
+	return phases.Next()
+}
+
+// ApplyClusterAutoscaler applies the cluster autoscaler state to OCM.  This includes creating and/or updating
+// the cluster autoscaler based on the provided attributes from the custom resource.
+func (r *Controller) ApplyClusterAutoscaler(req *ClusterAutoscalerRequest) (ctrl.Result, error) {
+	// return if it is already in its desired state
+	if req.desired() {
+		r.Logger.V(controllers.LogLevelDebug).Info(
+			"cluster autoscaler already in desired state",
+			request.LogValues(req)...,
+		)
+
+		return phases.Next()
+	}
+
+	// create the cluster autoscaler if it does not exist
+	if req.Current == nil {
+		r.Logger.Info("creating cluster autoscaler", request.LogValues(req)...)
+		if _, err := req.OCMClient.Create(req.Context, req.Desired.Builder()); err != nil {
+			return requeue.OnError(req, ocm.CreateError(req, err))
+		}
+
+		// create an event indicating that the cluster autoscaler has been created
+		events.RegisterAction(events.Created, req.Original, r.Recorder, req.GetName(), req.Original.Status.ClusterID)
+
+		return phases.Next()
+	}
+
+	// update the cluster autoscaler if it does exist
+	r.Logger.Info("updating cluster autoscaler", request.LogValues(req)...)
+	if _, err := req.OCMClient.Update(req.Context, req.Desired.Builder()); err != nil {
+		return requeue.OnError(req, ocm.UpdateError(req, err))
+	}
+
+	// create an event indicating that the cluster autoscaler has been updated
+	events.RegisterAction(events.Updated, req.Original, r.Recorder, req.GetName(), req.Original.Status.ClusterID)
+
+	return phases.Next()
+}
+
+// Destroy will destroy an OpenShift Cluster Manager cluster autoscaler.
+func (r *Controller) Destroy(req *ClusterAutoscalerRequest) (ctrl.Result, error) {
+	// return immediately if we have already deleted the cluster autoscaler
+	if conditions.IsSet(ClusterAutoscalerDeleted(), req.Original) {
+		return phases.Next()
+	}
+
+	// return if the cluster does not exist (has been deleted)
+	_, exists, err := ocm.ClusterExists(req.Context, req.Desired.Spec.ClusterName, req.Reconciler.Connection)
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	if !exists {
+		return phases.Next()
+	}
+
+	// return if the cluster autoscaler was never created.  clusters which are using a hosted
+	// control plane never have a cluster autoscaler created by this controller.
+	if req.Original.Status.ClusterID == "" || req.Original.Status.Hosted {
+		return phases.Next()
+	}
+
+	// delete the object
+	r.Logger.Info("deleting cluster autoscaler", request.LogValues(req)...)
+	if err := ocm.NewClusterAutoscalerClient(req.Reconciler.Connection, req.Original.Status.ClusterID).Delete(req.Context); err != nil {
+		return requeue.OnError(req, ocm.DeleteError(req, err))
+	}
+
+	// create an event indicating that the cluster autoscaler has been deleted
+	events.RegisterAction(events.Deleted, req.Original, r.Recorder, req.GetName(), req.Original.Status.ClusterID)
+
+	// set the deleted condition
+	if err := conditions.Update(req, ClusterAutoscalerDeleted()); err != nil {
+		return requeue.OnError(req, conditions.UpdateDeletedConditionError(err))
+	}
+
+	return phases.Next()
+}
@@ -0,0 +1,216 @@
+package clusterautoscaler
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm/ocmtest"
+)
+
+const autoscalerPath = "/api/clusters_mgmt/v1/clusters/abc/autoscaler"
+
+// testClusterAutoscaler returns a cluster autoscaler which has been associated with the classic
+// cluster with an id of abc.
+func testClusterAutoscaler(clusterName string) *ocmv1alpha1.ClusterAutoscaler {
+	return &ocmv1alpha1.ClusterAutoscaler{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "test", Generation: 1},
+		Spec: ocmv1alpha1.ClusterAutoscalerSpec{
+			ClusterName:          clusterName,
+			LogVerbosity:         1,
+			MaxNodeProvisionTime: "15m",
+			ResourceLimits:       ocmv1alpha1.ClusterAutoscalerResourceLimits{MaxNodesTotal: 180},
+			ScaleDown: ocmv1alpha1.ClusterAutoscalerScaleDown{
+				Enabled:              true,
+				UtilizationThreshold: "0.5",
+				UnneededTime:         "10m",
+			},
+		},
+		Status: ocmv1alpha1.ClusterAutoscalerStatus{ClusterID: "abc"},
+	}
+}
+
+func newTestController(t *testing.T, server *ocmtest.Server, autoscaler *ocmv1alpha1.ClusterAutoscaler) *Controller {
+	t.Helper()
+
+	dependencies := controllertest.New(t, server, autoscaler)
+
+	return &Controller{
+		Client:     dependencies.Client,
+		Scheme:     dependencies.Scheme,
+		Connection: dependencies.Connection,
+		Recorder:   dependencies.Recorder,
+		Logger:     dependencies.Logger,
+	}
+}
+
+func TestController_ApplyClusterAutoscaler(t *testing.T) {
+	t.Parallel()
+
+	// current returns the response for a cluster autoscaler which exists in ocm
+	current := func(t *testing.T, modify func(*ocmv1alpha1.ClusterAutoscaler)) ocmtest.Response {
+		existing := testClusterAutoscaler("test")
+		modify(existing)
+
+		object, err := existing.Builder().Build()
+		if err != nil {
+			t.Fatalf("unable to build current cluster autoscaler - %v", err)
+		}
+
+		return ocmtest.Response{Body: ocmtest.Marshal(t, object, clustersmgmtv1.MarshalClusterAutoscaler)}
+	}
+
+	tests := []struct {
+		name       string
+		current    func(*ocmv1alpha1.ClusterAutoscaler)
+		wantMethod string
+	}{
+		{
+			name:       "ensure missing cluster autoscaler is created",
+			wantMethod: http.MethodPost,
+		},
+		{
+			name: "ensure cluster autoscaler with drifted limits is updated",
+			current: func(existing *ocmv1alpha1.ClusterAutoscaler) {
+				existing.Spec.ResourceLimits.MaxNodesTotal = 100
+			},
+			wantMethod: http.MethodPatch,
+		},
+		{
+			name: "ensure cluster autoscaler with disabled scale down is updated",
+			current: func(existing *ocmv1alpha1.ClusterAutoscaler) {
+				existing.Spec.ScaleDown.Enabled = false
+			},
+			wantMethod: http.MethodPatch,
+		},
+		{
+			name: "ensure cluster autoscaler returned in a different format is unchanged",
+			current: func(existing *ocmv1alpha1.ClusterAutoscaler) {
+				existing.Spec.MaxNodeProvisionTime = "15m0s"
+				existing.Spec.ScaleDown.UtilizationThreshold = "0.50"
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			routes := map[string]ocmtest.Response{
+				http.MethodPost + " " + autoscalerPath:  {Status: http.StatusCreated, Body: `{"kind":"ClusterAutoscaler"}`},
+				http.MethodPatch + " " + autoscalerPath: {Body: `{"kind":"ClusterAutoscaler"}`},
+			}
+			if tt.current != nil {
+				routes[http.MethodGet+" "+autoscalerPath] = current(t, tt.current)
+			}
+
+			server := ocmtest.NewServer(t, routes)
+			autoscaler := testClusterAutoscaler("test")
+			controller := newTestController(t, server, autoscaler)
+			req := &ClusterAutoscalerRequest{
+				Context:    context.Background(),
+				Original:   autoscaler,
+				Desired:    autoscaler.DeepCopy(),
+				Reconciler: controller,
+			}
+
+			if _, err := controller.GetCurrentState(req); err != nil {
+				t.Fatalf("Controller.GetCurrentState() error = %v", err)
+			}
+
+			if _, err := controller.ApplyClusterAutoscaler(req); err != nil {
+				t.Fatalf("Controller.ApplyClusterAutoscaler() error = %v", err)
+			}
+
+			requests := server.Requests()
+			if tt.wantMethod == "" {
+				if len(requests) != 0 {
+					t.Errorf("Controller.ApplyClusterAutoscaler() requests = %v, want none", requests)
+				}
+
+				return
+			}
+
+			if len(requests) != 1 || requests[0].Method != tt.wantMethod || requests[0].Path != autoscalerPath {
+				t.Errorf("Controller.ApplyClusterAutoscaler() requests = %v, want %s %s", requests, tt.wantMethod, autoscalerPath)
+			}
+		})
+	}
+}
+
+func TestController_Destroy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		clusterName   string
+		hosted        bool
+		clusterExists bool
+		wantDelete    bool
+	}{
+		{
+			name:          "ensure cluster autoscaler is deleted",
+			clusterName:   "destroy-classic",
+			clusterExists: true,
+			wantDelete:    true,
+		},
+		{
+			name:          "ensure cluster autoscaler is not deleted for hosted clusters",
+			clusterName:   "destroy-hosted",
+			hosted:        true,
+			clusterExists: true,
+		},
+		{
+			name:        "ensure cluster autoscaler is not deleted for missing clusters",
+			clusterName: "destroy-missing",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			routes := map[string]ocmtest.Response{
+				http.MethodDelete + " " + autoscalerPath: {Status: http.StatusNoContent},
+				http.MethodGet + " /api/clusters_mgmt/v1/clusters": {
+					Body: `{"kind":"ClusterList","page":1,"size":0,"total":0,"items":[]}`,
+				},
+			}
+			if tt.clusterExists {
+				routes[http.MethodGet+" /api/clusters_mgmt/v1/clusters"] = ocmtest.ClusterList("abc", tt.clusterName)
+			}
+
+			server := ocmtest.NewServer(t, routes)
+			autoscaler := testClusterAutoscaler(tt.clusterName)
+			autoscaler.Status.Hosted = tt.hosted
+			controller := newTestController(t, server, autoscaler)
+			req := &ClusterAutoscalerRequest{
+				Context:    context.Background(),
+				Original:   autoscaler,
+				Desired:    autoscaler.DeepCopy(),
+				Reconciler: controller,
+			}
+
+			if _, err := controller.Destroy(req); err != nil {
+				t.Fatalf("Controller.Destroy() error = %v", err)
+			}
+
+			deleted := len(server.Requests()) == 1 && server.Requests()[0].Method == http.MethodDelete
+			if deleted != tt.wantDelete {
+				t.Errorf("Controller.Destroy() deleted = %v, want %v", deleted, tt.wantDelete)
+			}
+
+			if got := conditions.IsSet(ClusterAutoscalerDeleted(), req.Original); got != tt.wantDelete {
+				t.Errorf("Controller.Destroy() deleted condition = %v, want %v", got, tt.wantDelete)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+package clusterautoscaler
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"time"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+	"github.com/rh-mobb/ocm-operator/controllers/workload"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// ClusterAutoscalerRequest is an object that is unique to each reconciliation
+// req.
+type ClusterAutoscalerRequest struct {
+	Context           context.Context
+	ControllerRequest ctrl.Request
+	Current           *ocmv1alpha1.ClusterAutoscaler
+	Original          *ocmv1alpha1.ClusterAutoscaler
+	Desired           *ocmv1alpha1.ClusterAutoscaler
+	Trigger           triggers.Trigger
+	Reconciler        *Controller
+	OCMClient         *ocm.ClusterAutoscalerClient
+}
+
+func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
+	original := &ocmv1alpha1.ClusterAutoscaler{}
+
+	// get the object (desired state) from the cluster
+	if err := r.Get(ctx, ctrlReq.NamespacedName, original); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return &ClusterAutoscalerRequest{}, fmt.Errorf("unable to fetch cluster object - %w", err)
+		}
+
+		return &ClusterAutoscalerRequest{}, err
+	}
+
+	return &ClusterAutoscalerRequest{
+		Original:          original,
+		Desired:           original.DeepCopy(),
+		ControllerRequest: ctrlReq,
+		Context:           ctx,
+		Trigger:           triggers.GetTrigger(original),
+		Reconciler:        r,
+	}, nil
+}
+
+// DefaultRequeue returns the default requeue time for a request.
+func (req *ClusterAutoscalerRequest) DefaultRequeue() time.Duration {
+	return defaultClusterAutoscalerRequeue
+}
+
+// GetObject returns the original object to satisfy the controllers.Request interface.
+func (req *ClusterAutoscalerRequest) GetObject() workload.Workload {
+	return req.Original
+}
+
+// GetName returns the name of the object.  A cluster autoscaler has no name in OCM as only a
+// single cluster autoscaler may exist for a cluster.
+func (req *ClusterAutoscalerRequest) GetName() string {
+	return req.Original.Name
+}
+
+// GetClusterName returns the cluster name that this object belongs to.
+func (req *ClusterAutoscalerRequest) GetClusterName() string {
+	return req.Desired.Spec.ClusterName
+}
+
+// GetContext returns the context of the request.
+func (req *ClusterAutoscalerRequest) GetContext() context.Context {
+	return req.Context
+}
+
+// GetReconciler returns the context of the request.
+func (req *ClusterAutoscalerRequest) GetReconciler() kubernetes.Client {
+	return req.Reconciler
+}
+
+// SetClusterStatus sets the relevant cluster fields in the status.  It is used
+// to satisfy the request.Request interface.
+func (req *ClusterAutoscalerRequest) SetClusterStatus(cluster *clustersmgmtv1.Cluster) {
+	if req.Original.Status.ClusterID == "" {
+		req.Original.Status.ClusterID = cluster.ID()
+	}
+
+	req.Original.Status.Hosted = cluster.Hypershift().Enabled()
+}
+
+func (req *ClusterAutoscalerRequest) desired() bool {
+	if req.Desired == nil || req.Current == nil {
+		return false
+	}
+
+	// durations and the utilization threshold are stored as strings and may be returned
+	// from ocm in a different format than requested (e.g. 10m vs. 10m0s)
+	desired, current := req.Desired.Spec.DeepCopy(), req.Current.Spec.DeepCopy()
+	for _, spec := range []*ocmv1alpha1.ClusterAutoscalerSpec{desired, current} {
+		spec.MaxNodeProvisionTime = normalizeDuration(spec.MaxNodeProvisionTime)
+		spec.ScaleDown.UtilizationThreshold = normalizeThreshold(spec.ScaleDown.UtilizationThreshold)
+		spec.ScaleDown.UnneededTime = normalizeDuration(spec.ScaleDown.UnneededTime)
+		spec.ScaleDown.DelayAfterAdd = normalizeDuration(spec.ScaleDown.DelayAfterAdd)
+		spec.ScaleDown.DelayAfterDelete = normalizeDuration(spec.ScaleDown.DelayAfterDelete)
+		spec.ScaleDown.DelayAfterFailure = normalizeDuration(spec.ScaleDown.DelayAfterFailure)
+	}
+
+	return reflect.DeepEqual(desired, current)
+}
+
+// normalizeDuration returns a duration string in a consistent format.  The input is returned
+// unchanged if it is unable to be parsed.
+func normalizeDuration(value string) string {
+	duration, err := time.ParseDuration(value)
+	if err != nil {
+		return value
+	}
+
+	return duration.String()
+}
+
+// normalizeThreshold returns a threshold string in a consistent format.  The input is returned
+// unchanged if it is unable to be parsed.
+func normalizeThreshold(value string) string {
+	threshold, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return value
+	}
+
+	return strconv.FormatFloat(threshold, 'f', -1, 64)
+}
@@ -0,0 +1,76 @@
+package clusterautoscaler
+
+import (
+	"testing"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+)
+
+func TestClusterAutoscalerRequest_desired(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		current func(*ocmv1alpha1.ClusterAutoscaler)
+		desired func(*ocmv1alpha1.ClusterAutoscaler)
+		missing bool
+		want    bool
+	}{
+		{
+			name: "ensure differently formatted durations and thresholds reflect desired state",
+			current: func(autoscaler *ocmv1alpha1.ClusterAutoscaler) {
+				autoscaler.Spec.MaxNodeProvisionTime = "15m0s"
+				autoscaler.Spec.ScaleDown.UnneededTime = "10m0s"
+				autoscaler.Spec.ScaleDown.UtilizationThreshold = "0.50"
+			},
+			want: true,
+		},
+		{
+			name: "ensure changed durations do not reflect desired state",
+			desired: func(autoscaler *ocmv1alpha1.ClusterAutoscaler) {
+				autoscaler.Spec.ScaleDown.UnneededTime = "15m"
+			},
+			want: false,
+		},
+		{
+			name: "ensure changed limits do not reflect desired state",
+			desired: func(autoscaler *ocmv1alpha1.ClusterAutoscaler) {
+				autoscaler.Spec.ResourceLimits.MaxNodesTotal = 100
+			},
+			want: false,
+		},
+		{
+			name:    "ensure missing current state does not reflect desired state",
+			missing: true,
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &ClusterAutoscalerRequest{
+				Current: testClusterAutoscaler("test"),
+				Desired: testClusterAutoscaler("test"),
+			}
+
+			if tt.current != nil {
+				tt.current(request.Current)
+			}
+
+			if tt.desired != nil {
+				tt.desired(request.Desired)
+			}
+
+			if tt.missing {
+				request.Current = nil
+			}
+
+			if got := request.desired(); got != tt.want {
+				t.Errorf("ClusterAutoscalerRequest.desired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package clustergroupmembership
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+)
+
+const (
+	groupMembershipConditionTypeDeleted = "GroupMembershipDeleted"
+	groupMembershipMessageDeleted       = "group membership has been deleted from openshift cluster manager"
+)
+
+// GroupMembershipDeleted return a condition indicating that the members of the group have
+// been deleted from OpenShift Cluster Manager.
+func GroupMembershipDeleted() *metav1.Condition {
+	return &metav1.Condition{
+		Type:               groupMembershipConditionTypeDeleted,
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             triggers.Delete.String(),
+		Message:            groupMembershipMessageDeleted,
+	}
+}
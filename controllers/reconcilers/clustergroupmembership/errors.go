@@ -0,0 +1,52 @@
+package clustergroupmembership
+
+import (
+	"errors"
+	"fmt"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+)
+
+var (
+	ErrGroupManaged = errors.New("cluster group is managed by another cluster group membership")
+)
+
+// errUnableToListUsers produces an error indicating the users of the cluster group were unable
+// to be retrieved from OCM.
+func errUnableToListUsers(request *ClusterGroupMembershipRequest, err error) error {
+	return fmt.Errorf(
+		"unable to list users for cluster group [%s] from ocm - %w",
+		request.GetName(),
+		err,
+	)
+}
+
+// errUnableToApplyUser produces an error indicating an action against a user of the cluster group
+// was unable to be performed in OCM.
+func errUnableToApplyUser(request *ClusterGroupMembershipRequest, action, username string, err error) error {
+	return fmt.Errorf(
+		"unable to %s user [%s] for cluster group [%s] in ocm - %w",
+		action,
+		username,
+		request.GetName(),
+		err,
+	)
+}
+
+// errGroupManaged produces an error indicating the cluster group is already managed by another cluster
+// group membership.  It is terminal, as the membership is only able to manage the cluster group once
+// the other membership is deleted.
+func errGroupManaged(req *ClusterGroupMembershipRequest, other *ocmv1alpha1.ClusterGroupMembership) error {
+	return &request.TerminalError{
+		Reason: request.ReasonInvalidConfiguration,
+		Err: fmt.Errorf(
+			"cluster group [%s] of cluster [%s] is managed by [%s/%s] - %w",
+			req.GetName(),
+			req.GetClusterName(),
+			other.Namespace,
+			other.Name,
+			ErrGroupManaged,
+		),
+	}
+}
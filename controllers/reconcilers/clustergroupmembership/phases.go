@@ -0,0 +1,122 @@
+package clustergroupmembership
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/rh-mobb/ocm-operator/controllers"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/events"
+	"github.com/rh-mobb/ocm-operator/controllers/phases"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// ApplyUsers applies the desired members of the cluster group to OCM.  This includes adding missing
+// users to the group and removing users from the group which are no longer desired.
+func (r *Controller) ApplyUsers(req *ClusterGroupMembershipRequest) (ctrl.Result, error) {
+	// reject the membership if another membership manages the cluster group, as each would
+	// remove the users of the other
+	managing, err := r.managingMembership(req.Context, req.Original)
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	if managing != nil {
+		return requeue.OnError(req, errGroupManaged(req, managing))
+	}
+
+	usersClient := ocm.NewClusterGroupUsersClient(
+		req.Reconciler.Connection,
+		req.Original.Status.ClusterID,
+		req.Desired.Spec.Group,
+	)
+
+	current, err := usersClient.List(req.Context)
+	if err != nil {
+		return requeue.OnError(req, errUnableToListUsers(req, err))
+	}
+
+	// return if the users are already in their desired state
+	changes := req.changes(current)
+	if changes.empty() {
+		r.Logger.V(controllers.LogLevelDebug).Info(
+			"cluster group users already in desired state",
+			request.LogValues(req)...,
+		)
+
+		return phases.Next()
+	}
+
+	for _, username := range changes.add {
+		r.Logger.Info("adding cluster group user", append(request.LogValues(req), "user", username)...)
+		if _, err := usersClient.Create(req.Context, username); err != nil {
+			return requeue.OnError(req, errUnableToApplyUser(req, "add", username, err))
+		}
+	}
+
+	for _, username := range changes.remove {
+		r.Logger.Info("removing cluster group user", append(request.LogValues(req), "user", username)...)
+		if err := usersClient.Delete(req.Context, username); err != nil {
+			return requeue.OnError(req, errUnableToApplyUser(req, "remove", username, err))
+		}
+	}
+
+	// create an event indicating that the cluster group membership has been updated
+	events.RegisterAction(events.Updated, req.Original, r.Recorder, req.Desired.Spec.Group, req.Original.Status.ClusterID)
+
+	return phases.Next()
+}
+
+// Destroy will remove the users managed by the object from the OpenShift Cluster Manager cluster group.
+func (r *Controller) Destroy(req *ClusterGroupMembershipRequest) (ctrl.Result, error) {
+	// return immediately if we have already removed the users
+	if conditions.IsSet(GroupMembershipDeleted(), req.Original) {
+		return phases.Next()
+	}
+
+	// return if another membership manages the cluster group, as the users were never
+	// added by this membership
+	managing, err := r.managingMembership(req.Context, req.Original)
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	if managing != nil {
+		return phases.Next()
+	}
+
+	// return if the cluster does not exist (has been deleted)
+	_, exists, err := ocm.ClusterExists(req.Context, req.Desired.Spec.ClusterName, req.Reconciler.Connection)
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	if !exists {
+		return phases.Next()
+	}
+
+	usersClient := ocm.NewClusterGroupUsersClient(
+		req.Reconciler.Connection,
+		req.Original.Status.ClusterID,
+		req.Desired.Spec.Group,
+	)
+
+	// remove the users
+	for _, username := range req.Desired.Spec.Users {
+		r.Logger.Info("removing cluster group user", append(request.LogValues(req), "user", username)...)
+		if err := usersClient.Delete(req.Context, username); err != nil {
+			return requeue.OnError(req, errUnableToApplyUser(req, "remove", username, err))
+		}
+	}
+
+	// create an event indicating that the cluster group membership has been deleted
+	events.RegisterAction(events.Deleted, req.Original, r.Recorder, req.Desired.Spec.Group, req.Original.Status.ClusterID)
+
+	// set the deleted condition
+	if err := conditions.Update(req, GroupMembershipDeleted()); err != nil {
+		return requeue.OnError(req, conditions.UpdateDeletedConditionError(err))
+	}
+
+	return phases.Next()
+}
@@ -0,0 +1,225 @@
+package clustergroupmembership
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm/ocmtest"
+)
+
+const usersPath = "/api/clusters_mgmt/v1/clusters/abc/groups/dedicated-admins/users"
+
+var created = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// testMembership returns a membership of a group of the cluster with an id of abc, which was created
+// the given amount of time after the others.
+func testMembership(namespace, name, clusterName, group string, after time.Duration, users ...string) *ocmv1alpha1.ClusterGroupMembership {
+	return &ocmv1alpha1.ClusterGroupMembership{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:         namespace,
+			Name:              name,
+			CreationTimestamp: metav1.NewTime(created.Add(after)),
+		},
+		Spec: ocmv1alpha1.ClusterGroupMembershipSpec{
+			ClusterName: clusterName,
+			Group:       group,
+			Users:       users,
+		},
+		Status: ocmv1alpha1.ClusterGroupMembershipStatus{ClusterID: "abc"},
+	}
+}
+
+func newTestRequest(
+	t *testing.T,
+	server *ocmtest.Server,
+	membership *ocmv1alpha1.ClusterGroupMembership,
+	others ...client.Object,
+) *ClusterGroupMembershipRequest {
+	t.Helper()
+
+	dependencies := controllertest.New(t, server, append(others, membership)...)
+	controller := &Controller{
+		Client:     dependencies.Client,
+		Scheme:     dependencies.Scheme,
+		Connection: dependencies.Connection,
+		Recorder:   dependencies.Recorder,
+		Logger:     dependencies.Logger,
+	}
+
+	desired := membership.DeepCopy()
+	desired.Spec.Group = clusterGroup(membership)
+
+	return &ClusterGroupMembershipRequest{
+		Context:    context.Background(),
+		Original:   membership,
+		Desired:    desired,
+		Reconciler: controller,
+	}
+}
+
+func TestController_ApplyUsers(t *testing.T) {
+	t.Parallel()
+
+	deleting := testMembership("test", "deleting", "test", "", -time.Hour, "guest")
+	deleting.Finalizers = []string{"test"}
+	deleting.DeletionTimestamp = &metav1.Time{Time: created}
+
+	tests := []struct {
+		name        string
+		others      []client.Object
+		wantRequest string
+		wantErr     error
+	}{
+		{
+			name:        "ensure users of membership are added",
+			wantRequest: http.MethodPost + " " + usersPath,
+		},
+		{
+			name: "ensure membership of a group managed by an older membership is rejected",
+			others: []client.Object{
+				testMembership("other", "older", "test", "dedicated-admins", -time.Hour, "guest"),
+			},
+			wantErr: ErrGroupManaged,
+		},
+		{
+			name: "ensure membership of a group with a newer membership manages the group",
+			others: []client.Object{
+				testMembership("other", "newer", "test", "dedicated-admins", time.Hour, "guest"),
+			},
+			wantRequest: http.MethodPost + " " + usersPath,
+		},
+		{
+			name: "ensure membership of a group whose older membership is being deleted manages the group",
+			others: []client.Object{
+				deleting,
+			},
+			wantRequest: http.MethodPost + " " + usersPath,
+		},
+		{
+			name: "ensure older memberships of other groups and clusters are ignored",
+			others: []client.Object{
+				testMembership("test", "cluster-admins", "test", "cluster-admins", -time.Hour, "guest"),
+				testMembership("test", "other-cluster", "other", "", -time.Hour, "guest"),
+			},
+			wantRequest: http.MethodPost + " " + usersPath,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := ocmtest.NewServer(t, map[string]ocmtest.Response{
+				http.MethodGet + " " + usersPath:  {Body: `{"kind":"UserList","page":1,"size":0,"total":0,"items":[]}`},
+				http.MethodPost + " " + usersPath: {Status: http.StatusCreated, Body: `{"kind":"User","id":"admin"}`},
+			})
+
+			req := newTestRequest(t, server, testMembership("test", "test", "test", "", 0, "admin"), tt.others...)
+
+			_, err := req.Reconciler.ApplyUsers(req)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Controller.ApplyUsers() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if _, terminal := request.AsTerminal(err); terminal != (tt.wantErr != nil) {
+				t.Errorf("Controller.ApplyUsers() error = %v, want terminal %v", err, tt.wantErr != nil)
+			}
+
+			requests := server.Requests()
+			if tt.wantRequest == "" && len(requests) != 0 {
+				t.Errorf("Controller.ApplyUsers() requests = %v, want none", requests)
+			}
+
+			if tt.wantRequest != "" && (len(requests) != 1 || requests[0].Method+" "+requests[0].Path != tt.wantRequest) {
+				t.Errorf("Controller.ApplyUsers() requests = %v, want %s", requests, tt.wantRequest)
+			}
+		})
+	}
+}
+
+func TestController_Destroy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		clusterName string
+		others      []client.Object
+		wantRequest string
+	}{
+		{
+			name:        "ensure users of membership are removed",
+			clusterName: "membership-destroy",
+			wantRequest: http.MethodDelete + " " + usersPath + "/admin",
+		},
+		{
+			name:        "ensure users of rejected membership are not removed",
+			clusterName: "membership-destroy-rejected",
+			others: []client.Object{
+				testMembership("other", "older", "membership-destroy-rejected", "", -time.Hour, "admin"),
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := ocmtest.NewServer(t, map[string]ocmtest.Response{
+				http.MethodGet + " /api/clusters_mgmt/v1/clusters": ocmtest.ClusterList("abc", tt.clusterName),
+				http.MethodDelete + " " + usersPath + "/admin":     {Status: http.StatusNoContent},
+			})
+
+			req := newTestRequest(t, server, testMembership("test", "test", tt.clusterName, "", 0, "admin"), tt.others...)
+
+			if _, err := req.Reconciler.Destroy(req); err != nil {
+				t.Fatalf("Controller.Destroy() error = %v", err)
+			}
+
+			requests := server.Requests()
+			if tt.wantRequest == "" && len(requests) != 0 {
+				t.Errorf("Controller.Destroy() requests = %v, want none", requests)
+			}
+
+			if tt.wantRequest != "" && (len(requests) != 1 || requests[0].Method+" "+requests[0].Path != tt.wantRequest) {
+				t.Errorf("Controller.Destroy() requests = %v, want %s", requests, tt.wantRequest)
+			}
+
+			if tt.wantRequest != "" && !conditions.IsSet(GroupMembershipDeleted(), req.Original) {
+				t.Errorf("Controller.Destroy() did not set the deleted condition")
+			}
+		})
+	}
+}
+
+func TestController_membershipsForGroup(t *testing.T) {
+	t.Parallel()
+
+	membership := testMembership("test", "test", "test", "", 0)
+	req := newTestRequest(t, nil, membership,
+		testMembership("other", "explicit-group", "test", "dedicated-admins", 0),
+		testMembership("test", "other-group", "test", "cluster-admins", 0),
+		testMembership("test", "other-cluster", "other", "", 0),
+	)
+
+	got := []string{}
+	for _, request := range req.Reconciler.membershipsForGroup(context.Background(), membership) {
+		got = append(got, request.Namespace+"/"+request.Name)
+	}
+
+	if want := []string{"other/explicit-group"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Controller.membershipsForGroup() = %v, want %v", got, want)
+	}
+}
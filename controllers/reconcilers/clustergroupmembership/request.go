@@ -0,0 +1,199 @@
+package clustergroupmembership
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+	"github.com/rh-mobb/ocm-operator/controllers/workload"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// ClusterGroupMembershipRequest is an object that is unique to each reconciliation
+// req.
+type ClusterGroupMembershipRequest struct {
+	Context           context.Context
+	ControllerRequest ctrl.Request
+	Original          *ocmv1alpha1.ClusterGroupMembership
+	Desired           *ocmv1alpha1.ClusterGroupMembership
+	Trigger           triggers.Trigger
+	Reconciler        *Controller
+}
+
+func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
+	original := &ocmv1alpha1.ClusterGroupMembership{}
+
+	// get the object (desired state) from the cluster
+	if err := r.Get(ctx, ctrlReq.NamespacedName, original); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return &ClusterGroupMembershipRequest{}, fmt.Errorf("unable to fetch cluster object - %w", err)
+		}
+
+		return &ClusterGroupMembershipRequest{}, err
+	}
+
+	// create the desired state of the request based on the inputs
+	desired := original.DeepCopy()
+	desired.Spec.Group = clusterGroup(original)
+
+	return &ClusterGroupMembershipRequest{
+		Original:          original,
+		Desired:           desired,
+		ControllerRequest: ctrlReq,
+		Context:           ctx,
+		Trigger:           triggers.GetTrigger(original),
+		Reconciler:        r,
+	}, nil
+}
+
+// DefaultRequeue returns the default requeue time for a request.
+func (req *ClusterGroupMembershipRequest) DefaultRequeue() time.Duration {
+	return defaultClusterGroupMembershipRequeue
+}
+
+// GetObject returns the original object to satisfy the controllers.Request interface.
+func (req *ClusterGroupMembershipRequest) GetObject() workload.Workload {
+	return req.Original
+}
+
+// GetName returns the name of the group as it appears in OCM.
+func (req *ClusterGroupMembershipRequest) GetName() string {
+	return req.Desired.Spec.Group
+}
+
+// GetClusterName returns the cluster name that this object belongs to.
+func (req *ClusterGroupMembershipRequest) GetClusterName() string {
+	return req.Desired.Spec.ClusterName
+}
+
+// GetContext returns the context of the request.
+func (req *ClusterGroupMembershipRequest) GetContext() context.Context {
+	return req.Context
+}
+
+// GetReconciler returns the context of the request.
+func (req *ClusterGroupMembershipRequest) GetReconciler() kubernetes.Client {
+	return req.Reconciler
+}
+
+// SetClusterStatus sets the relevant cluster fields in the status.  It is used
+// to satisfy the request.Request interface.
+func (req *ClusterGroupMembershipRequest) SetClusterStatus(cluster *clustersmgmtv1.Cluster) {
+	if req.Original.Status.ClusterID == "" {
+		req.Original.Status.ClusterID = cluster.ID()
+	}
+}
+
+// membershipChanges represents the changes that are needed to bring the members of the
+// group in OCM into their desired state.
+type membershipChanges struct {
+	add    []string
+	remove []string
+}
+
+// changes returns the changes needed to bring the current members of the group in OCM into
+// the desired state.  Users which are not listed in the desired state are removed.
+func (req *ClusterGroupMembershipRequest) changes(current []*clustersmgmtv1.User) *membershipChanges {
+	changes := &membershipChanges{
+		add:    []string{},
+		remove: []string{},
+	}
+
+	desired := map[string]bool{}
+	for _, username := range req.Desired.Spec.Users {
+		desired[username] = true
+	}
+
+	found := map[string]bool{}
+
+	for _, user := range current {
+		found[user.ID()] = true
+
+		if !desired[user.ID()] {
+			changes.remove = append(changes.remove, user.ID())
+		}
+	}
+
+	for username := range desired {
+		if !found[username] {
+			changes.add = append(changes.add, username)
+		}
+	}
+
+	sort.Strings(changes.add)
+	sort.Strings(changes.remove)
+
+	return changes
+}
+
+// empty determines if there are no changes to be made.
+func (changes *membershipChanges) empty() bool {
+	return len(changes.add) == 0 && len(changes.remove) == 0
+}
+
+// clusterGroup returns the cluster group of a cluster group membership, defaulting to the
+// dedicated-admins group.
+func clusterGroup(membership *ocmv1alpha1.ClusterGroupMembership) string {
+	if membership.Spec.Group == "" {
+		return ocm.ClusterGroupDedicatedAdmins
+	}
+
+	return membership.Spec.Group
+}
+
+// managingMembership returns the cluster group membership which manages the cluster group of
+// a membership, if it is not the membership itself.  As the users of a cluster group which are
+// not listed by a membership are removed, only the oldest membership of a cluster group, which
+// is not being deleted, manages it.
+func (r *Controller) managingMembership(
+	ctx context.Context,
+	membership *ocmv1alpha1.ClusterGroupMembership,
+) (*ocmv1alpha1.ClusterGroupMembership, error) {
+	memberships := &ocmv1alpha1.ClusterGroupMembershipList{}
+	if err := r.List(ctx, memberships); err != nil {
+		return nil, fmt.Errorf("unable to list cluster group memberships - %w", err)
+	}
+
+	var managing *ocmv1alpha1.ClusterGroupMembership
+
+	for i := range memberships.Items {
+		other := &memberships.Items[i]
+
+		if other.Namespace == membership.Namespace && other.Name == membership.Name {
+			continue
+		}
+
+		if other.Spec.ClusterName != membership.Spec.ClusterName || clusterGroup(other) != clusterGroup(membership) {
+			continue
+		}
+
+		if other.GetDeletionTimestamp() != nil || !createdBefore(other, membership) {
+			continue
+		}
+
+		if managing == nil || createdBefore(other, managing) {
+			managing = other
+		}
+	}
+
+	return managing, nil
+}
+
+// createdBefore determines if a cluster group membership was created before another.  Memberships
+// which were created at the same time are ordered by their namespace and name.
+func createdBefore(membership, other *ocmv1alpha1.ClusterGroupMembership) bool {
+	if !membership.CreationTimestamp.Equal(&other.CreationTimestamp) {
+		return membership.CreationTimestamp.Before(&other.CreationTimestamp)
+	}
+
+	return membership.Namespace+"/"+membership.Name < other.Namespace+"/"+other.Name
+}
@@ -0,0 +1,81 @@
+package clustergroupmembership
+
+import (
+	"reflect"
+	"testing"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+)
+
+func TestClusterGroupMembershipRequest_changes(t *testing.T) {
+	t.Parallel()
+
+	newUsers := func(usernames ...string) []*clustersmgmtv1.User {
+		users := make([]*clustersmgmtv1.User, len(usernames))
+
+		for i, username := range usernames {
+			user, err := clustersmgmtv1.NewUser().ID(username).Build()
+			if err != nil {
+				t.Fatalf("unable to build user - %v", err)
+			}
+
+			users[i] = user
+		}
+
+		return users
+	}
+
+	tests := []struct {
+		name    string
+		desired []string
+		current []*clustersmgmtv1.User
+		want    *membershipChanges
+	}{
+		{
+			name:    "ensure users in desired state produce no changes",
+			desired: []string{"admin", "developer"},
+			current: newUsers("developer", "admin"),
+			want: &membershipChanges{
+				add:    []string{},
+				remove: []string{},
+			},
+		},
+		{
+			name:    "ensure missing and undesired users produce changes",
+			desired: []string{"developer", "admin"},
+			current: newUsers("admin", "guest", "contractor"),
+			want: &membershipChanges{
+				add:    []string{"developer"},
+				remove: []string{"contractor", "guest"},
+			},
+		},
+		{
+			name:    "ensure all users are removed when no users are desired",
+			desired: []string{},
+			current: newUsers("admin"),
+			want: &membershipChanges{
+				add:    []string{},
+				remove: []string{"admin"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			request := &ClusterGroupMembershipRequest{
+				Desired: &ocmv1alpha1.ClusterGroupMembership{
+					Spec: ocmv1alpha1.ClusterGroupMembershipSpec{
+						Users: tt.desired,
+					},
+				},
+			}
+			if got := request.changes(tt.current); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ClusterGroupMembershipRequest.changes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
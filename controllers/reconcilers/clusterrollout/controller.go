@@ -0,0 +1,177 @@
+/*
+Copyright 2023.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterrollout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers"
+	"github.com/rh-mobb/ocm-operator/controllers/phases"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/controllers/workload"
+)
+
+const (
+	defaultClusterRolloutRequeue = 60 * time.Second
+)
+
+// Controller reconciles a ClusterRollout object.
+type Controller struct {
+	client.Client
+
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
+	Interval time.Duration
+	Logger   logr.Logger
+}
+
+//+kubebuilder:rbac:groups=ocm.mobb.redhat.com,resources=clusterrollouts,verbs=get;list;watch;create;update;patch;delete
+//+kubebuilder:rbac:groups=ocm.mobb.redhat.com,resources=clusterrollouts/status,verbs=get;update;patch
+//+kubebuilder:rbac:groups=ocm.mobb.redhat.com,resources=clusterrollouts/finalizers,verbs=update
+//+kubebuilder:rbac:groups=ocm.mobb.redhat.com,resources=rosaclusters,verbs=get;list;watch;patch
+
+// Reconcile is part of the main kubernetes reconciliation loop which aims to
+// move the current state of the cluster closer to the desired state.
+func (r *Controller) Reconcile(ctx context.Context, ctrlReq ctrl.Request) (ctrl.Result, error) {
+	return controllers.Reconcile(ctx, r, ctrlReq)
+}
+
+// ReconcileCreate performs the reconciliation logic when a create event triggered
+// the reconciliation.
+func (r *Controller) ReconcileCreate(reconcileRequest request.Request) (ctrl.Result, error) {
+	// type cast the request to a cluster rollout request
+	req, ok := reconcileRequest.(*ClusterRolloutRequest)
+	if !ok {
+		return requeue.OnError(req, request.TypeConvertError(&ClusterRolloutRequest{}))
+	}
+
+	// execute the phases
+	return phases.NewHandler(req,
+		phases.NewPhase("GetMembers", func() (ctrl.Result, error) { return r.GetMembers(req) }),
+		phases.NewPhase("Progress", func() (ctrl.Result, error) { return r.Progress(req) }),
+		phases.NewPhase("Complete", func() (ctrl.Result, error) { return r.Complete(req) }),
+	).Execute()
+}
+
+// ReconcileUpdate performs the reconciliation logic when an update event triggered
+// the reconciliation.  In this instance, create and update share identical logic
+// so we are simply calling the ReconcileCreate method.
+func (r *Controller) ReconcileUpdate(reconcileRequest request.Request) (ctrl.Result, error) {
+	return r.ReconcileCreate(reconcileRequest)
+}
+
+// ReconcileDelete performs the reconciliation logic when a delete event triggered
+// the reconciliation.  A rollout does not own any external objects, so there is
+// nothing to destroy.
+func (r *Controller) ReconcileDelete(reconcileRequest request.Request) (ctrl.Result, error) {
+	// type cast the request to a cluster rollout request
+	req, ok := reconcileRequest.(*ClusterRolloutRequest)
+	if !ok {
+		return requeue.OnError(req, request.TypeConvertError(&ClusterRolloutRequest{}))
+	}
+
+	// execute the phases
+	return phases.NewHandler(req,
+		phases.NewPhase("CompleteDestroy", func() (ctrl.Result, error) { return phases.CompleteDestroy(req, r) }),
+	).Execute()
+}
+
+// ReconcileInterval returns the requeue interval for the controller.  It is used to
+// satisfy the Controller interface.
+func (r *Controller) ReconcileInterval() time.Duration {
+	return r.Interval
+}
+
+// Log returns the controller logger.  It is used to satisfy the Controller interface.
+func (r *Controller) Log() logr.Logger {
+	return r.Logger
+}
+
+// SetupWithManager sets up the controller with the Manager.  Changes to the status of the member
+// clusters trigger a reconciliation of their rollouts, so that the progress of a rollout does not
+// depend on polling alone.
+func (r *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&ocmv1alpha1.ClusterRollout{}, builder.WithPredicates(workload.Predicates())).
+		Watches(
+			&ocmv1alpha1.ROSACluster{},
+			handler.EnqueueRequestsFromMapFunc(r.rolloutsForCluster),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Complete(r)
+}
+
+// rolloutsForCluster returns the requests for the rollouts which a cluster is a member of, either
+// because it matches their selector or because it was a part of them.
+func (r *Controller) rolloutsForCluster(ctx context.Context, cluster client.Object) []reconcile.Request {
+	rollouts := &ocmv1alpha1.ClusterRolloutList{}
+	if err := r.List(ctx, rollouts, client.InNamespace(cluster.GetNamespace())); err != nil {
+		r.Logger.Error(err, fmt.Sprintf("unable to find rollouts of cluster [%s]", cluster.GetName()))
+
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for i := range rollouts.Items {
+		if !isMember(&rollouts.Items[i], cluster) {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: rollouts.Items[i].Namespace,
+				Name:      rollouts.Items[i].Name,
+			},
+		})
+	}
+
+	return requests
+}
+
+// isMember determines if a cluster is a member of a rollout.
+func isMember(rollout *ocmv1alpha1.ClusterRollout, cluster client.Object) bool {
+	for _, member := range rollout.Status.Clusters {
+		if member.Name == cluster.GetName() {
+			return true
+		}
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&rollout.Spec.Selector)
+	if err != nil {
+		return false
+	}
+
+	return selector.Matches(labels.Set(cluster.GetLabels()))
+}
@@ -0,0 +1,234 @@
+package clusterrollout
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/phases"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// GetMembers retrieves the ROSACluster objects which are selected by the rollout.
+func (r *Controller) GetMembers(req *ClusterRolloutRequest) (ctrl.Result, error) {
+	selector, err := metav1.LabelSelectorAsSelector(&req.Desired.Spec.Selector)
+	if err != nil {
+		return requeue.OnError(req, fmt.Errorf("invalid selector - %w", err))
+	}
+
+	clusters := &ocmv1alpha1.ROSAClusterList{}
+	if err := r.List(
+		req.Context,
+		clusters,
+		client.InNamespace(req.Original.Namespace),
+		client.MatchingLabelsSelector{Selector: selector},
+	); err != nil {
+		return requeue.OnError(req, fmt.Errorf("unable to retrieve rosa clusters - %w", err))
+	}
+
+	req.Members = map[string]*ocmv1alpha1.ROSACluster{}
+
+	for i := range clusters.Items {
+		req.Members[clusters.Items[i].Name] = &clusters.Items[i]
+	}
+
+	return phases.Next()
+}
+
+// Progress progresses the rollout by upgrading the clusters of the current wave and moving to the
+// next wave once each cluster of the current wave has either succeeded or failed.  The rollout is
+// stopped once more clusters have failed than the failure threshold allows.
+func (r *Controller) Progress(req *ClusterRolloutRequest) (ctrl.Result, error) {
+	original := req.Original.DeepCopy()
+
+	req.reset()
+
+	// add new members to the rollout in order of their name
+	names := make([]string, 0, len(req.Members))
+	for name := range req.Members {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	req.syncMembers(names)
+
+	// resume a stopped rollout if the failure threshold has been raised
+	if req.Original.Status.State == ocmv1alpha1.ClusterRolloutStateFailed &&
+		req.failures() <= req.Desired.Spec.Strategy.FailureThreshold {
+		req.Original.Status.State = ocmv1alpha1.ClusterRolloutStateProgressing
+	}
+
+	if err := r.progress(req, time.Now()); err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	if !reflect.DeepEqual(original.Status, req.Original.Status) {
+		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+			return requeue.OnError(req, fmt.Errorf("unable to update status - %w", err))
+		}
+	}
+
+	return phases.Next()
+}
+
+// Complete will perform all actions required to successfully complete a create or update reconciliation
+// request.  The reconciliation is requeued sooner than the reconciliation interval of the controller
+// while the rollout is in progress.
+func (r *Controller) Complete(req *ClusterRolloutRequest) (ctrl.Result, error) {
+	result, err := phases.Complete(req, triggers.Create, r)
+	if err != nil || req.RequeueAfter == 0 || req.RequeueAfter >= result.RequeueAfter {
+		return result, err
+	}
+
+	r.Logger.Info(fmt.Sprintf("checking rollout progress again in %s", req.RequeueAfter), request.LogValues(req)...)
+
+	return requeue.After(req.RequeueAfter, nil)
+}
+
+// progress updates the state of the clusters of the current wave and moves to the next wave once the
+// current wave has finished.
+//
+//nolint:cyclop
+func (r *Controller) progress(req *ClusterRolloutRequest, now time.Time) error {
+	status := &req.Original.Status
+
+	for status.State == ocmv1alpha1.ClusterRolloutStateProgressing {
+		finished := true
+
+		for i := range status.Clusters {
+			cluster := &status.Clusters[i]
+
+			// clusters which were added after their wave has passed are upgraded with the current wave
+			if cluster.Wave > status.CurrentWave ||
+				cluster.State == ocmv1alpha1.ClusterRolloutClusterStateSucceeded ||
+				cluster.State == ocmv1alpha1.ClusterRolloutClusterStateFailed {
+				continue
+			}
+
+			member := req.Members[cluster.Name]
+
+			state, message, err := observe(member, status.Version)
+			if err != nil {
+				return fmt.Errorf("unable to determine upgrade state of cluster [%s] - %w", cluster.Name, err)
+			}
+
+			// start the upgrade of pending clusters which are not already running the version
+			if cluster.State == ocmv1alpha1.ClusterRolloutClusterStatePending && state != ocmv1alpha1.ClusterRolloutClusterStateSucceeded {
+				if err := r.upgrade(req, member); err != nil {
+					return err
+				}
+
+				state, message = ocmv1alpha1.ClusterRolloutClusterStateUpgrading, "upgrade requested"
+			}
+
+			// fail clusters which have not completed their upgrade in time, such as clusters which
+			// are hibernating and therefore are never upgraded
+			if state == ocmv1alpha1.ClusterRolloutClusterStateUpgrading {
+				if cluster.UpgradeStartTime == nil {
+					cluster.UpgradeStartTime = &metav1.Time{Time: now}
+				}
+
+				if timeout := req.Desired.GetUpgradeTimeout(); now.Sub(cluster.UpgradeStartTime.Time) > timeout {
+					state = ocmv1alpha1.ClusterRolloutClusterStateFailed
+					message = fmt.Sprintf("upgrade did not complete within %s: %s", timeout, message)
+				}
+			}
+
+			cluster.State = state
+			cluster.Message = message
+
+			if member != nil {
+				cluster.Version = member.Status.CurrentOpenShiftVersion
+			}
+
+			if state == ocmv1alpha1.ClusterRolloutClusterStateUpgrading {
+				finished = false
+			}
+		}
+
+		// stop the rollout if too many clusters have failed
+		if req.failures() > req.Desired.Spec.Strategy.FailureThreshold {
+			r.Logger.Info("stopping rollout as the failure threshold has been exceeded", request.LogValues(req)...)
+			status.State = ocmv1alpha1.ClusterRolloutStateFailed
+
+			return nil
+		}
+
+		// check again later if the current wave is still in progress
+		if !finished {
+			req.RequeueAfter = defaultClusterRolloutRequeue
+
+			return nil
+		}
+
+		// complete the rollout if this was the last wave
+		if status.CurrentWave >= req.lastWave() {
+			r.Logger.Info("rollout has completed", request.LogValues(req)...)
+			status.State = ocmv1alpha1.ClusterRolloutStateCompleted
+			status.WaveCompletionTime = nil
+
+			return nil
+		}
+
+		// pause between waves
+		if status.WaveCompletionTime == nil {
+			status.WaveCompletionTime = &metav1.Time{Time: now}
+		}
+
+		pause := time.Duration(req.Desired.Spec.Strategy.PauseMinutes) * time.Minute
+		if remaining := status.WaveCompletionTime.Add(pause).Sub(now); remaining > 0 {
+			req.RequeueAfter = remaining
+
+			return nil
+		}
+
+		r.Logger.Info(fmt.Sprintf("starting wave [%d]", status.CurrentWave+1), request.LogValues(req)...)
+		status.CurrentWave++
+		status.WaveCompletionTime = nil
+	}
+
+	return nil
+}
+
+// upgrade requests the upgrade of a member cluster to the version of the rollout by patching the
+// ROSACluster object.  Clusters which already request the version, or a newer version, are not patched.
+// The requested version is compared as resolved in the status of the member, as the spec may contain
+// a version constraint.
+func (r *Controller) upgrade(req *ClusterRolloutRequest, member *ocmv1alpha1.ROSACluster) error {
+	version := req.Original.Status.Version
+
+	if member.Status.OpenShiftVersion != "" {
+		comparison, err := ocm.CompareVersions(member.Status.OpenShiftVersion, version)
+		if err != nil {
+			return fmt.Errorf("unable to compare version of cluster [%s] - %w", member.Name, err)
+		}
+
+		if comparison >= 0 {
+			return nil
+		}
+	}
+
+	r.Logger.Info(
+		fmt.Sprintf("requesting upgrade of cluster [%s]", member.Name),
+		append(request.LogValues(req), "version", version)...,
+	)
+
+	patched := member.DeepCopy()
+	patched.Spec.OpenShiftVersion = version
+
+	if err := r.Patch(req.Context, patched, client.MergeFrom(member)); err != nil {
+		return fmt.Errorf("unable to request upgrade of cluster [%s] to version [%s] - %w", member.Name, version, err)
+	}
+
+	return nil
+}
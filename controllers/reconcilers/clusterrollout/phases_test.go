@@ -0,0 +1,150 @@
+package clusterrollout
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+)
+
+func TestController_progress(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+
+	tests := []struct {
+		name        string
+		startedAgo  time.Duration
+		wantState   string
+		wantCluster string
+	}{
+		{
+			name:        "ensure cluster without an upgrade start time is given one",
+			wantState:   ocmv1alpha1.ClusterRolloutStateProgressing,
+			wantCluster: ocmv1alpha1.ClusterRolloutClusterStateUpgrading,
+		},
+		{
+			name:        "ensure cluster within the upgrade timeout is upgrading",
+			startedAgo:  time.Hour,
+			wantState:   ocmv1alpha1.ClusterRolloutStateProgressing,
+			wantCluster: ocmv1alpha1.ClusterRolloutClusterStateUpgrading,
+		},
+		{
+			name:        "ensure cluster past the upgrade timeout has failed and stops the rollout",
+			startedAgo:  7 * time.Hour,
+			wantState:   ocmv1alpha1.ClusterRolloutStateFailed,
+			wantCluster: ocmv1alpha1.ClusterRolloutClusterStateFailed,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cluster := ocmv1alpha1.ClusterRolloutClusterStatus{
+				Name:  "hibernating",
+				State: ocmv1alpha1.ClusterRolloutClusterStateUpgrading,
+			}
+
+			if tt.startedAgo != 0 {
+				cluster.UpgradeStartTime = &metav1.Time{Time: now.Add(-tt.startedAgo)}
+			}
+
+			rollout := &ocmv1alpha1.ClusterRollout{
+				Spec: ocmv1alpha1.ClusterRolloutSpec{Version: "4.14.5"},
+				Status: ocmv1alpha1.ClusterRolloutStatus{
+					Version:  "4.14.5",
+					State:    ocmv1alpha1.ClusterRolloutStateProgressing,
+					Clusters: []ocmv1alpha1.ClusterRolloutClusterStatus{cluster},
+				},
+			}
+
+			// a hibernating cluster is never upgraded
+			req := &ClusterRolloutRequest{
+				Original: rollout,
+				Desired:  rollout.DeepCopy(),
+				Members: map[string]*ocmv1alpha1.ROSACluster{
+					"hibernating": {
+						Status: ocmv1alpha1.ROSAClusterStatus{
+							CurrentOpenShiftVersion: "4.14.1",
+							OpenShiftVersion:        "4.14.5",
+							PowerState:              ocmv1alpha1.ROSAClusterPowerStateHibernating,
+						},
+					},
+				},
+			}
+
+			controller := &Controller{Logger: logr.Discard()}
+			if err := controller.progress(req, now); err != nil {
+				t.Fatalf("Controller.progress() error = %v", err)
+			}
+
+			if rollout.Status.State != tt.wantState {
+				t.Errorf("Controller.progress() state = %v, want %v", rollout.Status.State, tt.wantState)
+			}
+
+			got := rollout.Status.Clusters[0]
+			if got.State != tt.wantCluster {
+				t.Errorf("Controller.progress() cluster state = %v (%s), want %v", got.State, got.Message, tt.wantCluster)
+			}
+
+			if got.UpgradeStartTime == nil || !got.UpgradeStartTime.Time.Equal(now.Add(-tt.startedAgo)) {
+				t.Errorf("Controller.progress() cluster upgradeStartTime = %v, want %v", got.UpgradeStartTime, now.Add(-tt.startedAgo))
+			}
+		})
+	}
+}
+
+func TestController_rolloutsForCluster(t *testing.T) {
+	t.Parallel()
+
+	newRollout := func(namespace, name string, selector map[string]string, members ...string) client.Object {
+		rollout := &ocmv1alpha1.ClusterRollout{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: ocmv1alpha1.ClusterRolloutSpec{
+				Selector: metav1.LabelSelector{MatchLabels: selector},
+			},
+		}
+
+		for _, member := range members {
+			rollout.Status.Clusters = append(rollout.Status.Clusters, ocmv1alpha1.ClusterRolloutClusterStatus{Name: member})
+		}
+
+		return rollout
+	}
+
+	sandbox := map[string]string{"environment": "sandbox"}
+	production := map[string]string{"environment": "production"}
+
+	scheme := controllertest.NewScheme(t)
+	controller := &Controller{
+		Client: controllertest.NewClient(scheme,
+			newRollout("test", "selected", sandbox),
+			newRollout("test", "previously-selected", production, "a"),
+			newRollout("test", "unselected", production, "b"),
+			newRollout("other", "other-namespace", sandbox, "a"),
+		),
+		Logger: logr.Discard(),
+	}
+
+	cluster := &ocmv1alpha1.ROSACluster{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "a", Labels: sandbox},
+	}
+
+	got := []string{}
+	for _, request := range controller.rolloutsForCluster(context.Background(), cluster) {
+		got = append(got, request.Name)
+	}
+
+	if want := []string{"previously-selected", "selected"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Controller.rolloutsForCluster() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,202 @@
+package clusterrollout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+	"github.com/rh-mobb/ocm-operator/controllers/workload"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// ClusterRolloutRequest is an object that is unique to each reconciliation
+// request.
+type ClusterRolloutRequest struct {
+	Context           context.Context
+	ControllerRequest ctrl.Request
+	Original          *ocmv1alpha1.ClusterRollout
+	Desired           *ocmv1alpha1.ClusterRollout
+	Trigger           triggers.Trigger
+	Reconciler        *Controller
+
+	// data obtained during request reconciliation
+	Members map[string]*ocmv1alpha1.ROSACluster
+
+	// RequeueAfter is the time after which the rollout should next be checked.  It is
+	// zero if the rollout has finished.
+	RequeueAfter time.Duration
+}
+
+func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
+	original := &ocmv1alpha1.ClusterRollout{}
+
+	// get the object (desired state) from the cluster
+	if err := r.Get(ctx, ctrlReq.NamespacedName, original); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return &ClusterRolloutRequest{}, fmt.Errorf("unable to fetch cluster rollout object - %w", err)
+		}
+
+		return &ClusterRolloutRequest{}, err
+	}
+
+	return &ClusterRolloutRequest{
+		Original:          original,
+		Desired:           original.DeepCopy(),
+		ControllerRequest: ctrlReq,
+		Context:           ctx,
+		Trigger:           triggers.GetTrigger(original),
+		Reconciler:        r,
+	}, nil
+}
+
+// DefaultRequeue returns the default requeue time for a request.
+func (req *ClusterRolloutRequest) DefaultRequeue() time.Duration {
+	return defaultClusterRolloutRequeue
+}
+
+// GetObject returns the original object to satisfy the controllers.Request interface.
+func (req *ClusterRolloutRequest) GetObject() workload.Workload {
+	return req.Original
+}
+
+// GetName returns the name of the rollout.
+func (req *ClusterRolloutRequest) GetName() string {
+	return req.Original.GetName()
+}
+
+// GetContext returns the context of the request.
+func (req *ClusterRolloutRequest) GetContext() context.Context {
+	return req.Context
+}
+
+// GetReconciler returns the context of the request.
+func (req *ClusterRolloutRequest) GetReconciler() kubernetes.Client {
+	return req.Reconciler
+}
+
+// reset starts a new rollout of the desired version if the status refers to a previous version.
+func (req *ClusterRolloutRequest) reset() {
+	if req.Original.Status.Version == req.Desired.Spec.Version {
+		return
+	}
+
+	req.Original.Status.Version = req.Desired.Spec.Version
+	req.Original.Status.State = ocmv1alpha1.ClusterRolloutStateProgressing
+	req.Original.Status.CurrentWave = 0
+	req.Original.Status.WaveCompletionTime = nil
+	req.Original.Status.Clusters = []ocmv1alpha1.ClusterRolloutClusterStatus{}
+}
+
+// syncMembers adds the members which are not yet a part of the rollout, in order of their name, and
+// removes the pending clusters which are no longer members.  New members are assigned to waves
+// after the existing clusters of the rollout.
+func (req *ClusterRolloutRequest) syncMembers(names []string) {
+	status := &req.Original.Status
+	known := map[string]bool{}
+	clusters := []ocmv1alpha1.ClusterRolloutClusterStatus{}
+
+	for _, cluster := range status.Clusters {
+		if cluster.State == ocmv1alpha1.ClusterRolloutClusterStatePending && req.Members[cluster.Name] == nil {
+			continue
+		}
+
+		known[cluster.Name] = true
+		clusters = append(clusters, cluster)
+	}
+
+	for _, name := range names {
+		if known[name] {
+			continue
+		}
+
+		clusters = append(clusters, ocmv1alpha1.ClusterRolloutClusterStatus{
+			Name:  name,
+			Wave:  len(clusters) / req.Desired.GetBatchSize(),
+			State: ocmv1alpha1.ClusterRolloutClusterStatePending,
+		})
+	}
+
+	// a completed rollout continues if new members have been added
+	if len(clusters) > len(status.Clusters) && status.State == ocmv1alpha1.ClusterRolloutStateCompleted {
+		status.State = ocmv1alpha1.ClusterRolloutStateProgressing
+	}
+
+	status.Clusters = clusters
+}
+
+// lastWave returns the last wave of the rollout.
+func (req *ClusterRolloutRequest) lastWave() int {
+	last := 0
+
+	for _, cluster := range req.Original.Status.Clusters {
+		if cluster.Wave > last {
+			last = cluster.Wave
+		}
+	}
+
+	return last
+}
+
+// failures returns the number of clusters which have failed to upgrade.
+func (req *ClusterRolloutRequest) failures() int {
+	count := 0
+
+	for _, cluster := range req.Original.Status.Clusters {
+		if cluster.State == ocmv1alpha1.ClusterRolloutClusterStateFailed {
+			count++
+		}
+	}
+
+	return count
+}
+
+// observe determines the state of the upgrade of a member cluster based on the status of
+// the ROSACluster object.  It returns the state and an accompanying message.  A cluster which
+// failed to reconcile since its upgrade was requested, for example because OCM rejected the
+// upgrade, has failed.  A cluster has only succeeded once it is ready.
+func observe(member *ocmv1alpha1.ROSACluster, version string) (state, message string, err error) {
+	if member == nil {
+		return ocmv1alpha1.ClusterRolloutClusterStateFailed, "cluster no longer exists or no longer matches the selector", nil
+	}
+
+	if failure := conditions.CurrentFailure(member); failure != nil {
+		return ocmv1alpha1.ClusterRolloutClusterStateFailed, fmt.Sprintf("cluster failed: %s", failure.Message), nil
+	}
+
+	upgrade := member.Status.Upgrade
+
+	switch upgrade.State {
+	case string(clustersmgmtv1.UpgradePolicyStateValueFailed), string(clustersmgmtv1.UpgradePolicyStateValueCancelled):
+		return ocmv1alpha1.ClusterRolloutClusterStateFailed, fmt.Sprintf("upgrade %s: %s", upgrade.State, upgrade.Description), nil
+	case "":
+		if member.Status.CurrentOpenShiftVersion == "" {
+			return ocmv1alpha1.ClusterRolloutClusterStateUpgrading, "waiting for cluster to report its version", nil
+		}
+
+		comparison, err := ocm.CompareVersions(member.Status.CurrentOpenShiftVersion, version)
+		if err != nil {
+			return "", "", err
+		}
+
+		if comparison >= 0 {
+			if member.Status.PowerState != ocmv1alpha1.ROSAClusterPowerStateRunning {
+				return ocmv1alpha1.ClusterRolloutClusterStateUpgrading, "waiting for cluster to be ready", nil
+			}
+
+			return ocmv1alpha1.ClusterRolloutClusterStateSucceeded, "", nil
+		}
+
+		return ocmv1alpha1.ClusterRolloutClusterStateUpgrading, "waiting for upgrade to be scheduled", nil
+	default:
+		return ocmv1alpha1.ClusterRolloutClusterStateUpgrading, fmt.Sprintf("upgrade %s: %s", upgrade.State, upgrade.Description), nil
+	}
+}
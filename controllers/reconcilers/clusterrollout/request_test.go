@@ -0,0 +1,205 @@
+package clusterrollout
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+)
+
+func Test_observe(t *testing.T) {
+	t.Parallel()
+
+	newCluster := func(version, upgradeState string) *ocmv1alpha1.ROSACluster {
+		return &ocmv1alpha1.ROSACluster{
+			ObjectMeta: metav1.ObjectMeta{Generation: 2},
+			Status: ocmv1alpha1.ROSAClusterStatus{
+				CurrentOpenShiftVersion: version,
+				PowerState:              ocmv1alpha1.ROSAClusterPowerStateRunning,
+				Upgrade: ocmv1alpha1.ROSAClusterUpgradeStatus{
+					State: upgradeState,
+				},
+			},
+		}
+	}
+
+	hibernating := newCluster("4.14.5", "")
+	hibernating.Status.PowerState = ocmv1alpha1.ROSAClusterPowerStateHibernating
+
+	failed := func(generation int64) *ocmv1alpha1.ROSACluster {
+		cluster := newCluster("4.14.1", "")
+		cluster.Status.Conditions = []metav1.Condition{
+			*conditions.Failed(request.ReasonOCMRequestRejected, "no upgrade path", generation),
+		}
+
+		return cluster
+	}
+
+	tests := []struct {
+		name    string
+		member  *ocmv1alpha1.ROSACluster
+		want    string
+		wantErr bool
+	}{
+		{
+			name:   "ensure missing cluster has failed",
+			member: nil,
+			want:   ocmv1alpha1.ClusterRolloutClusterStateFailed,
+		},
+		{
+			name:   "ensure cluster running the version has succeeded",
+			member: newCluster("4.14.5", ""),
+			want:   ocmv1alpha1.ClusterRolloutClusterStateSucceeded,
+		},
+		{
+			name:   "ensure cluster running a newer version has succeeded",
+			member: newCluster("4.14.10", ""),
+			want:   ocmv1alpha1.ClusterRolloutClusterStateSucceeded,
+		},
+		{
+			name:   "ensure cluster running an older version is upgrading",
+			member: newCluster("4.14.1", ""),
+			want:   ocmv1alpha1.ClusterRolloutClusterStateUpgrading,
+		},
+		{
+			name:   "ensure cluster with unknown version is upgrading",
+			member: newCluster("", ""),
+			want:   ocmv1alpha1.ClusterRolloutClusterStateUpgrading,
+		},
+		{
+			name:   "ensure cluster with started upgrade is upgrading",
+			member: newCluster("4.14.1", "started"),
+			want:   ocmv1alpha1.ClusterRolloutClusterStateUpgrading,
+		},
+		{
+			name:   "ensure cluster with failed upgrade has failed",
+			member: newCluster("4.14.1", "failed"),
+			want:   ocmv1alpha1.ClusterRolloutClusterStateFailed,
+		},
+		{
+			name:   "ensure cluster running the version which is not ready is upgrading",
+			member: hibernating,
+			want:   ocmv1alpha1.ClusterRolloutClusterStateUpgrading,
+		},
+		{
+			name:   "ensure cluster which failed after its upgrade was requested has failed",
+			member: failed(2),
+			want:   ocmv1alpha1.ClusterRolloutClusterStateFailed,
+		},
+		{
+			name:   "ensure cluster which failed before its upgrade was requested is upgrading",
+			member: failed(1),
+			want:   ocmv1alpha1.ClusterRolloutClusterStateUpgrading,
+		},
+		{
+			name:    "ensure invalid version returns an error",
+			member:  newCluster("invalid", ""),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, _, err := observe(tt.member, "4.14.5")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("observe() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("observe() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClusterRolloutRequest_syncMembers(t *testing.T) {
+	t.Parallel()
+
+	pending := func(name string, wave int) ocmv1alpha1.ClusterRolloutClusterStatus {
+		return ocmv1alpha1.ClusterRolloutClusterStatus{Name: name, Wave: wave, State: ocmv1alpha1.ClusterRolloutClusterStatePending}
+	}
+
+	succeeded := func(name string, wave int) ocmv1alpha1.ClusterRolloutClusterStatus {
+		return ocmv1alpha1.ClusterRolloutClusterStatus{Name: name, Wave: wave, State: ocmv1alpha1.ClusterRolloutClusterStateSucceeded}
+	}
+
+	tests := []struct {
+		name      string
+		batchSize int
+		state     string
+		existing  []ocmv1alpha1.ClusterRolloutClusterStatus
+		members   []string
+		want      []ocmv1alpha1.ClusterRolloutClusterStatus
+		wantState string
+	}{
+		{
+			name:      "ensure members are assigned to waves by batch size",
+			batchSize: 2,
+			state:     ocmv1alpha1.ClusterRolloutStateProgressing,
+			existing:  []ocmv1alpha1.ClusterRolloutClusterStatus{},
+			members:   []string{"a", "b", "c"},
+			want:      []ocmv1alpha1.ClusterRolloutClusterStatus{pending("a", 0), pending("b", 0), pending("c", 1)},
+			wantState: ocmv1alpha1.ClusterRolloutStateProgressing,
+		},
+		{
+			name:      "ensure pending clusters which are no longer members are removed",
+			batchSize: 1,
+			state:     ocmv1alpha1.ClusterRolloutStateProgressing,
+			existing:  []ocmv1alpha1.ClusterRolloutClusterStatus{succeeded("a", 0), pending("b", 1)},
+			members:   []string{},
+			want:      []ocmv1alpha1.ClusterRolloutClusterStatus{succeeded("a", 0)},
+			wantState: ocmv1alpha1.ClusterRolloutStateProgressing,
+		},
+		{
+			name:      "ensure new members continue a completed rollout",
+			batchSize: 1,
+			state:     ocmv1alpha1.ClusterRolloutStateCompleted,
+			existing:  []ocmv1alpha1.ClusterRolloutClusterStatus{succeeded("a", 0)},
+			members:   []string{"a", "b"},
+			want:      []ocmv1alpha1.ClusterRolloutClusterStatus{succeeded("a", 0), pending("b", 1)},
+			wantState: ocmv1alpha1.ClusterRolloutStateProgressing,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			rollout := &ocmv1alpha1.ClusterRollout{
+				Spec: ocmv1alpha1.ClusterRolloutSpec{
+					Strategy: ocmv1alpha1.ClusterRolloutStrategy{BatchSize: tt.batchSize},
+				},
+				Status: ocmv1alpha1.ClusterRolloutStatus{
+					State:    tt.state,
+					Clusters: tt.existing,
+				},
+			}
+
+			request := &ClusterRolloutRequest{
+				Original: rollout,
+				Desired:  rollout.DeepCopy(),
+				Members:  map[string]*ocmv1alpha1.ROSACluster{},
+			}
+
+			for _, name := range tt.members {
+				request.Members[name] = &ocmv1alpha1.ROSACluster{}
+			}
+
+			request.syncMembers(tt.members)
+
+			if !reflect.DeepEqual(rollout.Status.Clusters, tt.want) {
+				t.Errorf("ClusterRolloutRequest.syncMembers() clusters = %v, want %v", rollout.Status.Clusters, tt.want)
+			}
+
+			if rollout.Status.State != tt.wantState {
+				t.Errorf("ClusterRolloutRequest.syncMembers() state = %v, want %v", rollout.Status.State, tt.wantState)
+			}
+		})
+	}
+}
@@ -22,10 +22,13 @@ import (
 
 	"github.com/go-logr/logr"
 	sdk "github.com/openshift-online/ocm-sdk-go"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
 	"github.com/rh-mobb/ocm-operator/controllers"
@@ -41,6 +44,13 @@ const (
 	defaultGitLabIdentityProviderRequeue = 30 * time.Second
 )
 
+// fields which are indexed to lookup the objects which reference a particular config map or secret.
+const (
+	clientSecretField      = ".spec.clientSecret.name"
+	accessTokenSecretField = ".spec.accessTokenSecret.name"
+	caField                = ".spec.ca.name"
+)
+
 // Controller reconciles a GitLabIdentityProvider object.
 type Controller struct {
 	client.Client
@@ -77,8 +87,6 @@ func (r *Controller) ReconcileCreate(reconcileRequest request.Request) (ctrl.Res
 	}
 
 	// execute the phases
-	// TODO: see TODO in api/v1alpha1/gitlabidentityprovider_types.go file for explanation of commented out
-	//       ApplyGitLab phase.
 	return phases.NewHandler(req,
 		phases.NewPhase("HandleUpstreamCluster", func() (ctrl.Result, error) {
 			return phases.HandleClusterPhase(
@@ -89,7 +97,7 @@ func (r *Controller) ReconcileCreate(reconcileRequest request.Request) (ctrl.Res
 			)
 		}),
 		phases.NewPhase("GetCurrentState", func() (ctrl.Result, error) { return r.GetCurrentState(req) }),
-		// phases.NewPhase("ApplyGitLab", func() (ctrl.Result, error) { return r.ApplyGitLab(req) }),
+		phases.NewPhase("ApplyGitLab", func() (ctrl.Result, error) { return r.ApplyGitLab(req) }),
 		phases.NewPhase("ApplyIdentityProvider", func() (ctrl.Result, error) { return r.ApplyIdentityProvider(req) }),
 		phases.NewPhase("Complete", func() (ctrl.Result, error) { return phases.Complete(req, triggers.Create, r) }),
 	).Execute()
@@ -114,6 +122,7 @@ func (r *Controller) ReconcileDelete(reconcileRequest request.Request) (ctrl.Res
 	// execute the phases
 	return phases.NewHandler(req,
 		phases.NewPhase("Destroy", func() (ctrl.Result, error) { return r.Destroy(req) }),
+		phases.NewPhase("DestroyGitLab", func() (ctrl.Result, error) { return r.DestroyGitLab(req) }),
 		phases.NewPhase("CompleteDestroy", func() (ctrl.Result, error) { return phases.CompleteDestroy(req, r) }),
 	).Execute()
 }
@@ -131,8 +140,45 @@ func (r *Controller) Log() logr.Logger {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	// index the referenced objects so that changes to them may trigger a reconciliation
+	// of the objects which reference them.
+	if err := controllers.IndexReferences(context.Background(), mgr.GetFieldIndexer(), &ocmv1alpha1.GitLabIdentityProvider{},
+		controllers.ReferenceIndex{
+			Field: clientSecretField,
+			Extract: func(object client.Object) []string {
+				return []string{object.(*ocmv1alpha1.GitLabIdentityProvider).Spec.ClientSecret.Name}
+			},
+		},
+		controllers.ReferenceIndex{
+			Field: accessTokenSecretField,
+			Extract: func(object client.Object) []string {
+				return []string{object.(*ocmv1alpha1.GitLabIdentityProvider).Spec.AccessTokenSecret.Name}
+			},
+		},
+		controllers.ReferenceIndex{
+			Field: caField,
+			Extract: func(object client.Object) []string {
+				return []string{object.(*ocmv1alpha1.GitLabIdentityProvider).Spec.CA.Name}
+			},
+		},
+	); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		WithEventFilter(workload.Predicates()).
-		For(&ocmv1alpha1.GitLabIdentityProvider{}).
+		For(&ocmv1alpha1.GitLabIdentityProvider{}, builder.WithPredicates(workload.Predicates())).
+		Watches(
+			&corev1.Secret{},
+			controllers.EnqueueReferencing(mgr.GetClient(), &ocmv1alpha1.GitLabIdentityProviderList{},
+				clientSecretField,
+				accessTokenSecretField,
+			),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Watches(
+			&corev1.ConfigMap{},
+			controllers.EnqueueReferencing(mgr.GetClient(), &ocmv1alpha1.GitLabIdentityProviderList{}, caField),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
 		Complete(r)
 }
@@ -1,7 +1,6 @@
 package gitlabidentityprovider
 
 import (
-	"errors"
 	"fmt"
 
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -9,12 +8,6 @@ import (
 	"github.com/rh-mobb/ocm-operator/controllers/requeue"
 )
 
-var (
-	// TODO: this is unused for now because GitLab applications cannot be managed by non-admin users via the API.  The
-	//       intent is that this will eventually be used if that ever becomes a possibility.
-	ErrGitLabApplicationDrift = errors.New("gitlab application is immutable but differs from the desired state configuration")
-)
-
 // errUnableToUpdateStatusProviderID produces an error indicating the GitLab IDP was unable
 // to be updated.
 func errUnableToUpdateStatusProviderID(request *GitLabIdentityProviderRequest, id string, err error) (ctrl.Result, error) {
@@ -25,3 +18,13 @@ func errUnableToUpdateStatusProviderID(request *GitLabIdentityProviderRequest, i
 		err,
 	))
 }
+
+// errUnableToUpdateStatusReferenceHash produces an error indicating the GitLab IDP status was unable
+// to be updated with the hash of the referenced data.
+func errUnableToUpdateStatusReferenceHash(request *GitLabIdentityProviderRequest, err error) (ctrl.Result, error) {
+	return requeue.OnError(request, fmt.Errorf(
+		"unable to update gitlab identity provider [%s] status [referenceHash] - %w",
+		request.GetName(),
+		err,
+	))
+}
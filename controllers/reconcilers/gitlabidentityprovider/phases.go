@@ -1,6 +1,9 @@
 package gitlabidentityprovider
 
 import (
+	"errors"
+	"fmt"
+
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
@@ -10,6 +13,7 @@ import (
 	"github.com/rh-mobb/ocm-operator/controllers/phases"
 	"github.com/rh-mobb/ocm-operator/controllers/request"
 	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/pkg/identityprovider"
 	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
 	"github.com/rh-mobb/ocm-operator/pkg/ocm"
 )
@@ -25,7 +29,7 @@ func (r *Controller) GetCurrentState(req *GitLabIdentityProviderRequest) (ctrl.R
 		req.Original.Status.ClusterID,
 	)
 
-	idp, err := req.OCMClient.Get()
+	idp, err := req.OCMClient.Get(req.Context)
 	if err != nil {
 		return requeue.OnError(req, ocm.GetError(req, err))
 	}
@@ -36,9 +40,8 @@ func (r *Controller) GetCurrentState(req *GitLabIdentityProviderRequest) (ctrl.R
 	}
 
 	// store the current state
-	// TODO: see TODO in api/v1alpha1/gitlabidentityprovider_types.go file for explanation.
-	// req.Current.Spec.AccessTokenSecret = req.Desired.Spec.AccessTokenSecret
 	req.Current = &ocmv1alpha1.GitLabIdentityProvider{}
+	req.Current.Spec.AccessTokenSecret.Name = req.Desired.Spec.AccessTokenSecret.Name
 	req.Current.Spec.ClusterName = req.Desired.Spec.ClusterName
 	req.Current.Spec.DisplayName = req.Desired.Spec.DisplayName
 	req.Current.Spec.ClientSecret.Name = req.Desired.Spec.ClientSecret.Name
@@ -49,50 +52,91 @@ func (r *Controller) GetCurrentState(req *GitLabIdentityProviderRequest) (ctrl.R
 	return phases.Next()
 }
 
-// TODO: see TODO in api/v1alpha1/gitlabidentityprovider_types.go file for explanation.
-// // ApplyGitLab applies the state to a GitLab instance.  This includes creating and/or updating an application
-// // with the appropriate oauth URL from OpenShift.
-// func (r *Controller) ApplyGitLab(req *GitLabIdentityProviderRequest) (ctrl.Result, error) {
-// 	// get the gitlab application from gitlab, using the display name as the name of the
-// 	// application to search for
-// 	application, err := req.GitLabClient.GetApplication(req.Desired.Spec.DisplayName)
-// 	if err != nil {
-// 		return controllers.RequeueAfter(defaultGitLabIdentityProviderRequeue), fmt.Errorf(
-// 			"unable to retrieve application from gitlab - %w",
-// 			err,
-// 		)
-// 	}
-
-// 	// create the application if it does not exist
-// 	req.Log.Info(fmt.Sprintf("creating oauth application in gitlab [%s]", req.Desired.Spec.DisplayName))
-// 	if application == nil {
-// 		application, err = req.GitLabClient.CreateApplication(req.Desired.Spec.DisplayName, req.Original.Status.CallbackURL)
-// 		if err != nil {
-// 			return controllers.RequeueAfter(defaultGitLabIdentityProviderRequeue), fmt.Errorf(
-// 				"unable to create oauth application in gitlab - %w",
-// 				err,
-// 			)
-// 		}
-
-// 		// set the client id and secret on the request
-// 		req.ClientID = application.ApplicationID
-// 		req.ClientSecret = application.Secret
-// 	}
-
-// 	// return if the application is already in the desired state
-// 	if identityprovider.EqualGitLab(*application, *identityprovider.DesiredGitLab(
-// 		req.Desired.Spec.DisplayName,
-// 		application.ApplicationID,
-// 		application.Secret,
-// 		req.Original.Status.CallbackURL,
-// 		true,
-// 	)) {
-// 		return controllers.NoRequeue(), nil
-// 	}
-
-// 	// return an error as we will not allow updates to the gitlab application
-// 	return controllers.RequeueAfter(defaultGitLabIdentityProviderRequeue), ErrGitLabApplicationDrift
-// }
+// ApplyGitLab applies the state to a GitLab instance.  This includes creating and/or updating an application
+// with the appropriate oauth URL from OpenShift.  The resulting client data is stored in a secret which is
+// managed by the operator.  This phase is skipped unless the application is managed by the operator.
+func (r *Controller) ApplyGitLab(req *GitLabIdentityProviderRequest) (ctrl.Result, error) {
+	if !req.Desired.ManagesApplication() {
+		return phases.Next()
+	}
+
+	// get the gitlab application from gitlab, using the application id from the status.  the
+	// name is only used to find an application which was created before its id was stored.
+	application, err := req.GitLabClient.GetApplication(req.Original.Status.ApplicationID, req.applicationName())
+	if err != nil && !errors.Is(err, identityprovider.ErrMissingApplication) {
+		return requeue.OnError(req, fmt.Errorf("unable to retrieve application from gitlab - %w", err))
+	}
+
+	// get the client data from the managed secret
+	clientID, clientSecret, err := req.getManagedClientData()
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	// return if the application is already in the desired state.  the secret is not
+	// reliably returned from the gitlab api, so we compare against the application without
+	// a secret and ensure that we have the secret stored in the managed secret.
+	if application != nil && clientSecret != "" {
+		current := *application
+		current.Secret = ""
+
+		if identityprovider.EqualGitLab(current, *identityprovider.DesiredGitLab(
+			req.applicationName(),
+			clientID,
+			"",
+			req.callbackURL(),
+			true,
+		)) {
+			if req.Original.Status.ApplicationID != application.ID {
+				if err := req.setApplicationID(application.ID); err != nil {
+					return requeue.OnError(req, err)
+				}
+			}
+
+			req.Desired.Spec.ClientID = clientID
+			req.ClientSecret = clientSecret
+
+			return phases.Next()
+		}
+	}
+
+	// create the application if it does not exist, otherwise update the application.  an
+	// update in gitlab results in a new client id and secret.
+	if application == nil {
+		r.Logger.Info("creating oauth application in gitlab", request.LogValues(req)...)
+		application, err = req.GitLabClient.CreateApplication(req.applicationName(), req.callbackURL())
+	} else {
+		r.Logger.Info("updating oauth application in gitlab", request.LogValues(req)...)
+		application, err = req.GitLabClient.UpdateApplication(application.ID, req.applicationName(), req.callbackURL())
+	}
+
+	if err != nil {
+		// the application is deleted before it is recreated on update, so ensure that the
+		// status no longer references the deleted application if it was not recreated
+		if errors.Is(err, identityprovider.ErrApplicationDeleted) {
+			if statusErr := req.setApplicationID(0); statusErr != nil {
+				return requeue.OnError(req, statusErr)
+			}
+		}
+
+		return requeue.OnError(req, fmt.Errorf("unable to apply oauth application in gitlab - %w", err))
+	}
+
+	// store the client data in the managed secret
+	if err := req.applyManagedClientData(application.ApplicationID, application.Secret); err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	// store the application id in the status
+	if err := req.setApplicationID(application.ID); err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	req.Desired.Spec.ClientID = application.ApplicationID
+	req.ClientSecret = application.Secret
+
+	return phases.Next()
+}
 
 // ApplyIdentityProvider applies the GitLab identity provider state to OCM.  This includes creating and/or updating
 // the identity provider based on the provided attributes from the custom resource.
@@ -112,7 +156,7 @@ func (r *Controller) ApplyIdentityProvider(req *GitLabIdentityProviderRequest) (
 	// create the identity provider if it does not exist
 	if req.Current == nil {
 		r.Logger.Info("creating gitlab identity provider", request.LogValues(req)...)
-		idp, err := req.OCMClient.Create(builder)
+		idp, err := req.OCMClient.Create(req.Context, builder)
 		if err != nil {
 			return requeue.OnError(req, ocm.CreateError(req, err))
 		}
@@ -120,6 +164,7 @@ func (r *Controller) ApplyIdentityProvider(req *GitLabIdentityProviderRequest) (
 		// store the required provider data in the status
 		original := req.Original.DeepCopy()
 		req.Original.Status.ProviderID = idp.ID()
+		req.Original.Status.ReferenceHash = req.referenceHash()
 
 		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
 			return errUnableToUpdateStatusProviderID(req, idp.ID(), err)
@@ -133,17 +178,51 @@ func (r *Controller) ApplyIdentityProvider(req *GitLabIdentityProviderRequest) (
 
 	// update the identity provider if it does exist
 	r.Logger.Info("updating gitlab identity provider", request.LogValues(req)...)
-	_, err := req.OCMClient.Update(builder)
+	_, err := req.OCMClient.Update(req.Context, builder)
 	if err != nil {
 		return requeue.OnError(req, ocm.UpdateError(req, err))
 	}
 
+	// store the hash of the applied referenced data in the status
+	original := req.Original.DeepCopy()
+	req.Original.Status.ReferenceHash = req.referenceHash()
+
+	if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+		return errUnableToUpdateStatusReferenceHash(req, err)
+	}
+
 	// create an event indicating that the gitlab identity provider has been updated
 	events.RegisterAction(events.Updated, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
 
 	return phases.Next()
 }
 
+// DestroyGitLab will destroy the application in GitLab, if it is managed by the operator.  The managed
+// secret containing the client data is owned by the parent resource and is garbage collected.
+func (r *Controller) DestroyGitLab(req *GitLabIdentityProviderRequest) (ctrl.Result, error) {
+	if !req.Desired.ManagesApplication() || req.Original.Status.ApplicationID == 0 {
+		return phases.Next()
+	}
+
+	// the client is unavailable if the access token has been removed, in which case the
+	// application must be deleted from gitlab manually
+	if req.GitLabClient == nil {
+		r.Logger.Info(
+			"unable to delete oauth application in gitlab without an access token",
+			append(request.LogValues(req), "applicationID", req.Original.Status.ApplicationID)...,
+		)
+
+		return phases.Next()
+	}
+
+	r.Logger.Info("deleting oauth application in gitlab", request.LogValues(req)...)
+	if err := req.GitLabClient.DeleteApplication(req.Original.Status.ApplicationID); err != nil {
+		return requeue.OnError(req, fmt.Errorf("unable to delete oauth application from gitlab - %w", err))
+	}
+
+	return phases.Next()
+}
+
 // Destroy will destroy an OpenShift Cluster Manager GitLab Identity Provider.
 func (r *Controller) Destroy(req *GitLabIdentityProviderRequest) (ctrl.Result, error) {
 	// return immediately if we have already deleted the gitlab identity provider
@@ -152,7 +231,7 @@ func (r *Controller) Destroy(req *GitLabIdentityProviderRequest) (ctrl.Result, e
 	}
 
 	// return if the cluster does not exist (has been deleted)
-	_, exists, err := ocm.ClusterExists(req.Desired.Spec.ClusterName, req.Reconciler.Connection)
+	_, exists, err := ocm.ClusterExists(req.Context, req.Desired.Spec.ClusterName, req.Reconciler.Connection)
 	if err != nil {
 		return requeue.OnError(req, err)
 	}
@@ -168,7 +247,7 @@ func (r *Controller) Destroy(req *GitLabIdentityProviderRequest) (ctrl.Result, e
 	)
 
 	// delete the object
-	if err := ocmClient.Delete(req.Original.Status.ProviderID); err != nil {
+	if err := ocmClient.Delete(req.Context, req.Original.Status.ProviderID); err != nil {
 		return requeue.OnError(req, ocm.DeleteError(req, err))
 	}
 
@@ -0,0 +1,354 @@
+package gitlabidentityprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	"github.com/xanzy/go-gitlab"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+	"github.com/rh-mobb/ocm-operator/pkg/identityprovider"
+)
+
+const (
+	testCallbackURL     = "oauth.example.com/oauth2callback/gitlab"
+	testApplicationName = "gitlab-abc"
+	testClientSecret    = "gitlab-gitlab-oauth"
+)
+
+// fakeGitLab is a fake GitLab applications API which stores the applications created through it
+// and records the requests which change them.
+type fakeGitLab struct {
+	mutex        sync.Mutex
+	applications []*gitlab.Application
+	requests     []string
+}
+
+// newFakeGitLab starts a fake GitLab instance which stores the given applications.  The server is
+// closed when the test completes.
+func newFakeGitLab(t *testing.T, applications []*gitlab.Application) (*fakeGitLab, *httptest.Server) {
+	t.Helper()
+
+	fake := &fakeGitLab{applications: applications}
+	server := httptest.NewServer(http.HandlerFunc(fake.serve))
+	t.Cleanup(server.Close)
+
+	return fake, server
+}
+
+func (fake *fakeGitLab) serve(w http.ResponseWriter, r *http.Request) {
+	fake.mutex.Lock()
+	defer fake.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v4/applications":
+		_ = json.NewEncoder(w).Encode(fake.applications)
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v4/applications":
+		options := gitlab.CreateApplicationOptions{}
+		if err := json.NewDecoder(r.Body).Decode(&options); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+
+			return
+		}
+
+		id := len(fake.applications) + 100
+		application := &gitlab.Application{
+			ID:              id,
+			ApplicationID:   fmt.Sprintf("client-%d", id),
+			ApplicationName: *options.Name,
+			Secret:          fmt.Sprintf("secret-%d", id),
+			CallbackURL:     *options.RedirectURI,
+			Confidential:    *options.Confidential,
+		}
+
+		fake.applications = append(fake.applications, application)
+		fake.requests = append(fake.requests, fmt.Sprintf("POST %s", application.ApplicationName))
+
+		w.WriteHeader(http.StatusCreated)
+		_ = json.NewEncoder(w).Encode(application)
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v4/applications/"):
+		id, _ := strconv.Atoi(strings.TrimPrefix(r.URL.Path, "/api/v4/applications/"))
+		fake.requests = append(fake.requests, fmt.Sprintf("DELETE %d", id))
+
+		for i := range fake.applications {
+			if fake.applications[i].ID == id {
+				fake.applications = append(fake.applications[:i], fake.applications[i+1:]...)
+				w.WriteHeader(http.StatusNoContent)
+
+				return
+			}
+		}
+
+		w.WriteHeader(http.StatusNotFound)
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+// testGitLab returns a gitlab identity provider, with an application managed by the operator,
+// which has been associated with the cluster with an id of abc.
+func testGitLab(applicationID int) *ocmv1alpha1.GitLabIdentityProvider {
+	return &ocmv1alpha1.GitLabIdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "gitlab", UID: "gitlab-uid"},
+		Spec: ocmv1alpha1.GitLabIdentityProviderSpec{
+			ClusterName:       "test",
+			DisplayName:       "gitlab",
+			ClientSecret:      configv1.SecretNameReference{Name: testClientSecret},
+			AccessTokenSecret: configv1.SecretNameReference{Name: "gitlab-access-token"},
+		},
+		Status: ocmv1alpha1.GitLabIdentityProviderStatus{
+			ClusterID:     "abc",
+			CallbackURL:   testCallbackURL,
+			ApplicationID: applicationID,
+		},
+	}
+}
+
+// testApplication returns an application in gitlab with the given redirect uri.
+func testApplication(id int, name, callbackURL string) *gitlab.Application {
+	return &gitlab.Application{
+		ID:              id,
+		ApplicationID:   fmt.Sprintf("client-%d", id),
+		ApplicationName: name,
+		CallbackURL:     callbackURL,
+		Confidential:    true,
+	}
+}
+
+// testSecret returns the secret which stores the client data of an application, owned by the
+// given object if one is given.
+func testSecret(clientID string, owner *ocmv1alpha1.GitLabIdentityProvider) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: testClientSecret},
+		Data: map[string][]byte{
+			ocmv1alpha1.GitLabClientIDKey:     []byte(clientID),
+			ocmv1alpha1.GitLabClientSecretKey: []byte("existing-secret"),
+		},
+	}
+
+	if owner != nil {
+		secret.OwnerReferences = []metav1.OwnerReference{
+			*metav1.NewControllerRef(owner, ocmv1alpha1.GroupVersion.WithKind("GitLabIdentityProvider")),
+		}
+	}
+
+	return secret
+}
+
+// newTestRequest returns a request for a gitlab identity provider, with a fake cluster which stores
+// the given objects and a client for the given fake gitlab instance.
+func newTestRequest(
+	t *testing.T,
+	gitlab *ocmv1alpha1.GitLabIdentityProvider,
+	server *httptest.Server,
+	objects ...client.Object,
+) *GitLabIdentityProviderRequest {
+	t.Helper()
+
+	dependencies := controllertest.New(t, nil, append(objects, gitlab)...)
+
+	gitlabClient, err := identityprovider.NewGitLab(server.URL, "token")
+	if err != nil {
+		t.Fatalf("unable to create gitlab client - %v", err)
+	}
+
+	return &GitLabIdentityProviderRequest{
+		Context:  context.Background(),
+		Original: gitlab,
+		Desired:  gitlab.DeepCopy(),
+		Reconciler: &Controller{
+			Client:   dependencies.Client,
+			Scheme:   dependencies.Scheme,
+			Recorder: dependencies.Recorder,
+			Logger:   dependencies.Logger,
+		},
+		GitLabClient: gitlabClient,
+	}
+}
+
+func TestController_ApplyGitLab(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name              string
+		applicationID     int
+		applications      []*gitlab.Application
+		objects           []client.Object
+		wantRequests      []string
+		wantApplicationID int
+		wantClientID      string
+		wantTerminal      bool
+	}{
+		{
+			name:              "ensure missing application is created with a name unique to the cluster",
+			wantRequests:      []string{"POST " + testApplicationName},
+			wantApplicationID: 100,
+			wantClientID:      "client-100",
+		},
+		{
+			name: "ensure application of another cluster with the same display name is not used",
+			applications: []*gitlab.Application{
+				testApplication(1, "gitlab", "https://oauth.other.com/oauth2callback/gitlab"),
+				testApplication(2, "gitlab-other", "https://oauth.other.com/oauth2callback/gitlab"),
+			},
+			wantRequests:      []string{"POST " + testApplicationName},
+			wantApplicationID: 102,
+			wantClientID:      "client-102",
+		},
+		{
+			name:              "ensure application which has drifted is recreated",
+			applicationID:     5,
+			applications:      []*gitlab.Application{testApplication(5, testApplicationName, "https://old.example.com")},
+			objects:           []client.Object{testSecret("client-5", testGitLab(5))},
+			wantRequests:      []string{"DELETE 5", "POST " + testApplicationName},
+			wantApplicationID: 100,
+			wantClientID:      "client-100",
+		},
+		{
+			name:              "ensure application in desired state is unchanged",
+			applicationID:     5,
+			applications:      []*gitlab.Application{testApplication(5, testApplicationName, "https://"+testCallbackURL)},
+			objects:           []client.Object{testSecret("client-5", testGitLab(5))},
+			wantRequests:      []string{},
+			wantApplicationID: 5,
+			wantClientID:      "client-5",
+		},
+		{
+			name:              "ensure application created before its id was stored is found by name",
+			applications:      []*gitlab.Application{testApplication(5, testApplicationName, "https://"+testCallbackURL)},
+			objects:           []client.Object{testSecret("client-5", testGitLab(0))},
+			wantRequests:      []string{},
+			wantApplicationID: 5,
+			wantClientID:      "client-5",
+		},
+		{
+			name:         "ensure existing secret which was not created by the operator is not adopted",
+			objects:      []client.Object{testSecret("user-client", nil)},
+			wantRequests: []string{},
+			wantTerminal: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake, server := newFakeGitLab(t, tt.applications)
+
+			req := newTestRequest(t, testGitLab(tt.applicationID), server, tt.objects...)
+
+			_, err := req.Reconciler.ApplyGitLab(req)
+
+			if _, terminal := request.AsTerminal(err); terminal != tt.wantTerminal {
+				t.Fatalf("Controller.ApplyGitLab() error = %v, want terminal %v", err, tt.wantTerminal)
+			}
+
+			if !tt.wantTerminal && err != nil {
+				t.Fatalf("Controller.ApplyGitLab() error = %v", err)
+			}
+
+			if got := append([]string{}, fake.requests...); !reflect.DeepEqual(got, tt.wantRequests) {
+				t.Errorf("Controller.ApplyGitLab() requests = %v, want %v", got, tt.wantRequests)
+			}
+
+			if tt.wantTerminal {
+				return
+			}
+
+			if req.Desired.Spec.ClientID != tt.wantClientID {
+				t.Errorf("Controller.ApplyGitLab() client id = %v, want %v", req.Desired.Spec.ClientID, tt.wantClientID)
+			}
+
+			stored := &ocmv1alpha1.GitLabIdentityProvider{}
+			if err := req.Reconciler.Get(req.Context, types.NamespacedName{Namespace: "test", Name: "gitlab"}, stored); err != nil {
+				t.Fatalf("unable to get gitlab identity provider - %v", err)
+			}
+
+			if stored.Status.ApplicationID != tt.wantApplicationID {
+				t.Errorf("Controller.ApplyGitLab() status.applicationID = %v, want %v",
+					stored.Status.ApplicationID, tt.wantApplicationID)
+			}
+
+			secret := &corev1.Secret{}
+			if err := req.Reconciler.Get(req.Context, types.NamespacedName{Namespace: "test", Name: testClientSecret}, secret); err != nil {
+				t.Fatalf("unable to get managed client secret - %v", err)
+			}
+
+			if got := string(secret.Data[ocmv1alpha1.GitLabClientIDKey]); got != tt.wantClientID {
+				t.Errorf("Controller.ApplyGitLab() secret client id = %v, want %v", got, tt.wantClientID)
+			}
+
+			if !metav1.IsControlledBy(secret, stored) {
+				t.Errorf("Controller.ApplyGitLab() secret owners = %v, want controlled by %s", secret.OwnerReferences, stored.Name)
+			}
+		})
+	}
+}
+
+func TestController_DestroyGitLab(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		applicationID int
+		applications  []*gitlab.Application
+		wantRequests  []string
+	}{
+		{
+			name:          "ensure application is deleted by its id",
+			applicationID: 5,
+			applications: []*gitlab.Application{
+				testApplication(4, testApplicationName, "https://"+testCallbackURL),
+				testApplication(5, testApplicationName, "https://"+testCallbackURL),
+			},
+			wantRequests: []string{"DELETE 5"},
+		},
+		{
+			name:          "ensure application which was already deleted does not block deletion",
+			applicationID: 5,
+			wantRequests:  []string{"DELETE 5"},
+		},
+		{
+			name:         "ensure nothing is deleted when no application was created",
+			applications: []*gitlab.Application{testApplication(5, testApplicationName, "https://"+testCallbackURL)},
+			wantRequests: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			fake, server := newFakeGitLab(t, tt.applications)
+
+			req := newTestRequest(t, testGitLab(tt.applicationID), server)
+
+			if _, err := req.Reconciler.DestroyGitLab(req); err != nil {
+				t.Fatalf("Controller.DestroyGitLab() error = %v", err)
+			}
+
+			if got := append([]string{}, fake.requests...); !reflect.DeepEqual(got, tt.wantRequests) {
+				t.Errorf("Controller.DestroyGitLab() requests = %v, want %v", got, tt.wantRequests)
+			}
+		})
+	}
+}
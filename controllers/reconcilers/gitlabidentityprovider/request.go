@@ -8,8 +8,12 @@ import (
 	"time"
 
 	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	corev1 "k8s.io/api/core/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
@@ -21,10 +25,18 @@ import (
 	"github.com/rh-mobb/ocm-operator/pkg/ocm"
 )
 
+const (
+	managedClientSecretSuffix = "-gitlab-oauth"
+)
+
 var (
 	ErrMissingAccessToken  = errors.New("unable to locate gitlab api access token data")
 	ErrMissingClientSecret = errors.New("unable to locate client secret data")
 	ErrMissingCA           = errors.New("ca specified but unable to locate ca data")
+
+	ErrUnmanagedClientSecret = request.NewValidationError(
+		"clientSecret refers to an existing secret which was not created by the operator",
+	)
 )
 
 // GitLabIdentityProviderRequest is an object that is unique to each reconciliation
@@ -40,14 +52,18 @@ type GitLabIdentityProviderRequest struct {
 	GitLabClient      *identityprovider.GitLab
 	OCMClient         *ocm.IdentityProviderClient
 
-	// TODO: see TODO in api/v1alpha1/gitlabidentityprovider_types.go file for explanation.
 	// data obtained during request reconciliation
-	// AccessToken  string
-	// ClientID     string
 	ClientSecret string
 	CA           string
 }
 
+// This controller must have the ability to pull secrets and configmaps which store the
+// client secret, access token and CA certificate data.  It must also be able to manage
+// the secret which stores the client data for applications that it creates in GitLab.
+
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch;create;update;patch
+//+kubebuilder:rbac:groups="",resources=configmaps,verbs=get;list;watch
+
 func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
 	original := &ocmv1alpha1.GitLabIdentityProvider{}
 
@@ -60,55 +76,54 @@ func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (requ
 		return &GitLabIdentityProviderRequest{}, err
 	}
 
-	// TODO: see TODO in api/v1alpha1/gitlabidentityprovider_types.go file for explanation.
-	// get the client secret data from the cluster
-	clientSecret, err := kubernetes.GetSecretData(
-		ctx,
-		r,
-		original.Spec.ClientSecret.Name,
-		ctrlReq.Namespace,
-		ocmv1alpha1.GitLabClientSecretKey,
-	)
-	if clientSecret == "" {
-		if err != nil {
-			log.Log.Error(err, "error retrieving client secret")
-		}
+	// create the desired state of the request based on the inputs
+	desired := original.DeepCopy()
+	if desired.Spec.DisplayName == "" {
+		desired.Spec.DisplayName = desired.Name
+	}
 
-		return &GitLabIdentityProviderRequest{}, fmt.Errorf("unable to obtain client secret from cluster - %w", err)
+	if desired.ManagesApplication() && desired.Spec.ClientSecret.Name == "" {
+		desired.Spec.ClientSecret.Name = desired.Name + managedClientSecretSuffix
 	}
 
-	// get the client secret data from the cluster
-	ca, err := kubernetes.GetConfigMapData(ctx, r, original.Spec.CA.Name, ctrlReq.Namespace, ocmv1alpha1.GitLabCAKey)
-	if clientSecret == "" {
-		if err != nil {
-			log.Log.Error(err, "error retrieving client secret")
+	// get the client secret and ca data from the cluster.  these are not needed when deleting
+	// the object, and skipping them allows the object to be deleted if they have already been
+	// removed.
+	var clientSecret, ca string
+
+	deleting := original.GetDeletionTimestamp() != nil
+
+	if !deleting {
+		var err error
+
+		if clientSecret, err = getClientSecret(ctx, r, desired); err != nil {
+			return &GitLabIdentityProviderRequest{}, err
 		}
 
-		return &GitLabIdentityProviderRequest{}, fmt.Errorf("unable to obtain client secret from cluster - %w", err)
+		if ca, err = getCA(ctx, r, desired); err != nil {
+			return &GitLabIdentityProviderRequest{}, err
+		}
 	}
 
-	// TODO: see TODO in api/v1alpha1/gitlabidentityprovider_types.go file for explanation.
-	// // get the secret access token data from the cluster
-	// accessToken, err := kubernetes.GetSecretData(ctx, r, original.Spec.AccessTokenSecret, req.Namespace, ocmv1alpha1.GitLabAccessTokenKey)
-	// if accessToken == "" {
-	// 	if err == nil {
-	// 		return &GitLabIdentityProviderRequest{}, accessTokenError(original, ErrMissingAccessToken)
-	// 	}
+	// create the api client used to interact with gitlab if the application is managed
+	// by the operator.  when deleting the object, a missing access token only prevents
+	// the application from being deleted in gitlab, so that it does not block the deletion
+	// of the object.
+	var gitlabClient *identityprovider.GitLab
 
-	// 	return &GitLabIdentityProviderRequest{}, accessTokenError(original, err)
-	// }
+	if desired.ManagesApplication() {
+		var err error
 
-	// TODO: see TODO in api/v1alpha1/gitlabidentityprovider_types.go file for explanation.
-	// // create the api client used to interact with gitlab
-	// gitlabClient, err := gitlab.NewClient(accessToken, gitlab.WithBaseURL(original.Spec.URL+"/api/v4"))
-	// if err != nil {
-	// 	return &GitLabIdentityProviderRequest{}, fmt.Errorf("error creating gitlab api client - %w", err)
-	// }
+		gitlabClient, err = getGitLabClient(ctx, r, desired)
+		if err != nil {
+			if !deleting {
+				return &GitLabIdentityProviderRequest{}, err
+			}
 
-	// create the desired state of the request based on the inputs
-	desired := original.DeepCopy()
-	if desired.Spec.DisplayName == "" {
-		desired.Spec.DisplayName = desired.Name
+			r.Logger.Error(err, "unable to create gitlab client, skipping deletion of gitlab application",
+				"resource", ctrlReq.NamespacedName.String(),
+			)
+		}
 	}
 
 	return &GitLabIdentityProviderRequest{
@@ -118,11 +133,9 @@ func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (requ
 		Context:           ctx,
 		Trigger:           triggers.GetTrigger(original),
 		Reconciler:        r,
-		// GitLabClient:      &identityprovider.GitLab{Client: gitlabClient},
+		GitLabClient:      gitlabClient,
 
 		// data obtained from cluster
-		// TODO: see TODO in api/v1alpha1/gitlabidentityprovider_types.go file for explanation.
-		// AccessToken: accessToken,
 		ClientSecret: clientSecret,
 		CA:           ca,
 	}, nil
@@ -175,19 +188,200 @@ func (req *GitLabIdentityProviderRequest) desired() bool {
 		return false
 	}
 
+	// ensure the referenced data has not changed since it was last applied.  we cannot
+	// get the current secret data from the api, so we compare against the hash of the
+	// data we last applied.
+	if req.Original.Status.ReferenceHash != req.referenceHash() {
+		return false
+	}
+
 	return reflect.DeepEqual(
 		req.Desired.Spec,
 		req.Current.Spec,
 	)
 }
 
-// TODO: see TODO in api/v1alpha1/gitlabidentityprovider_types.go file for explanation.
-// func accessTokenError(from *ocmv1alpha1.GitLabIdentityProvider, err error) error {
-// 	return fmt.Errorf(
-// 		"unable to retrieve access token from [%s/%s] at key [%s] - %w",
-// 		from.Namespace,
-// 		from.Spec.AccessTokenSecret,
-// 		ocmv1alpha1.GitLabAccessTokenKey,
-// 		err,
-// 	)
-// }
+// referenceHash returns the hash of the data from the secrets and config maps which are
+// referenced by the object.
+func (req *GitLabIdentityProviderRequest) referenceHash() string {
+	return kubernetes.Hash(req.ClientSecret, req.CA)
+}
+
+// getManagedClientData retrieves the client id and client secret from the secret which is managed
+// by the operator.  Empty values are returned if the secret does not yet exist.  A secret which was
+// not created by the operator is never adopted, as it would then be garbage collected along with
+// the parent resource.
+func (req *GitLabIdentityProviderRequest) getManagedClientData() (clientID, clientSecret string, err error) {
+	secret := &corev1.Secret{}
+
+	if err := req.Reconciler.Get(req.Context, types.NamespacedName{
+		Namespace: req.Original.Namespace,
+		Name:      req.Desired.Spec.ClientSecret.Name,
+	}, secret); err != nil {
+		if apierrs.IsNotFound(err) {
+			return "", "", nil
+		}
+
+		return "", "", fmt.Errorf(
+			"unable to retrieve managed client secret [%s/%s] - %w",
+			req.Original.Namespace,
+			req.Desired.Spec.ClientSecret.Name,
+			err,
+		)
+	}
+
+	if !metav1.IsControlledBy(secret, req.Original) {
+		return "", "", req.unmanagedClientSecretError()
+	}
+
+	return string(secret.Data[ocmv1alpha1.GitLabClientIDKey]), string(secret.Data[ocmv1alpha1.GitLabClientSecretKey]), nil
+}
+
+// applyManagedClientData creates or updates the secret which is managed by the operator with the
+// client id and client secret of the application in GitLab.  The secret is owned by the parent
+// resource so that it is garbage collected upon deletion.
+func (req *GitLabIdentityProviderRequest) applyManagedClientData(clientID, clientSecret string) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: req.Original.Namespace,
+			Name:      req.Desired.Spec.ClientSecret.Name,
+		},
+	}
+
+	if _, err := controllerutil.CreateOrUpdate(req.Context, req.Reconciler.Client, secret, func() error {
+		// the secret exists if it has been retrieved from the cluster
+		if secret.ResourceVersion != "" && !metav1.IsControlledBy(secret, req.Original) {
+			return req.unmanagedClientSecretError()
+		}
+
+		secret.Data = map[string][]byte{
+			ocmv1alpha1.GitLabClientIDKey:     []byte(clientID),
+			ocmv1alpha1.GitLabClientSecretKey: []byte(clientSecret),
+		}
+
+		return controllerutil.SetControllerReference(req.Original, secret, req.Reconciler.Scheme)
+	}); err != nil {
+		return fmt.Errorf(
+			"unable to apply managed client secret [%s/%s] - %w",
+			req.Original.Namespace,
+			req.Desired.Spec.ClientSecret.Name,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// unmanagedClientSecretError returns the error for a managed secret which was not created by the
+// operator.
+func (req *GitLabIdentityProviderRequest) unmanagedClientSecretError() error {
+	return fmt.Errorf(
+		"unable to manage client secret [%s/%s] - %w",
+		req.Original.Namespace,
+		req.Desired.Spec.ClientSecret.Name,
+		ErrUnmanagedClientSecret,
+	)
+}
+
+// applicationName returns the name of the application in GitLab.  A GitLab instance may serve many
+// clusters, so the cluster id is included to keep the name unique per cluster.
+func (req *GitLabIdentityProviderRequest) applicationName() string {
+	return req.Desired.Spec.DisplayName + "-" + req.Original.Status.ClusterID
+}
+
+// setApplicationID stores the id of the application in GitLab in the status.
+func (req *GitLabIdentityProviderRequest) setApplicationID(id int) error {
+	original := req.Original.DeepCopy()
+	req.Original.Status.ApplicationID = id
+
+	if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+		return fmt.Errorf(
+			"unable to update gitlab identity provider [%s] status [applicationID=%d] - %w",
+			req.GetName(),
+			id,
+			err,
+		)
+	}
+
+	return nil
+}
+
+// callbackURL returns the callback URL, including the scheme, which is used as the redirect URI of
+// the application in GitLab.
+func (req *GitLabIdentityProviderRequest) callbackURL() string {
+	return "https://" + req.Original.Status.CallbackURL
+}
+
+// getClientSecret retrieves the client secret from the cluster.  When the application is managed by
+// the operator, the client secret is retrieved from gitlab during reconciliation instead.
+func getClientSecret(ctx context.Context, r *Controller, gitlab *ocmv1alpha1.GitLabIdentityProvider) (string, error) {
+	if gitlab.ManagesApplication() {
+		return "", nil
+	}
+
+	clientSecret, err := kubernetes.GetSecretData(
+		ctx,
+		r,
+		gitlab.Spec.ClientSecret.Name,
+		gitlab.Namespace,
+		ocmv1alpha1.GitLabClientSecretKey,
+	)
+	if clientSecret == "" {
+		if err != nil {
+			log.Log.Error(err, "error retrieving client secret")
+		}
+
+		return "", fmt.Errorf("unable to obtain client secret from cluster - %w", err)
+	}
+
+	return clientSecret, nil
+}
+
+// getCA retrieves the ca data from the cluster, if a ca is specified.
+func getCA(ctx context.Context, r *Controller, gitlab *ocmv1alpha1.GitLabIdentityProvider) (string, error) {
+	if gitlab.Spec.CA.Name == "" {
+		return "", nil
+	}
+
+	ca, err := kubernetes.GetConfigMapData(ctx, r, gitlab.Spec.CA.Name, gitlab.Namespace, ocmv1alpha1.GitLabCAKey)
+	if ca == "" {
+		if err != nil {
+			log.Log.Error(err, "error retrieving ca")
+		}
+
+		return "", fmt.Errorf("unable to obtain ca from cluster - %w", ErrMissingCA)
+	}
+
+	return ca, nil
+}
+
+// getGitLabClient creates the api client used to interact with gitlab using the access token which
+// is stored in the cluster.
+func getGitLabClient(ctx context.Context, r *Controller, gitlab *ocmv1alpha1.GitLabIdentityProvider) (*identityprovider.GitLab, error) {
+	accessToken, err := kubernetes.GetSecretData(
+		ctx,
+		r,
+		gitlab.Spec.AccessTokenSecret.Name,
+		gitlab.Namespace,
+		ocmv1alpha1.GitLabAccessTokenKey,
+	)
+	if accessToken == "" {
+		if err == nil {
+			return nil, accessTokenError(gitlab, ErrMissingAccessToken)
+		}
+
+		return nil, accessTokenError(gitlab, err)
+	}
+
+	return identityprovider.NewGitLab(gitlab.Spec.URL, accessToken)
+}
+
+func accessTokenError(from *ocmv1alpha1.GitLabIdentityProvider, err error) error {
+	return fmt.Errorf(
+		"unable to retrieve access token from [%s/%s] at key [%s] - %w",
+		from.Namespace,
+		from.Spec.AccessTokenSecret.Name,
+		ocmv1alpha1.GitLabAccessTokenKey,
+		err,
+	)
+}
@@ -0,0 +1,43 @@
+package googleidentityprovider
+
+import (
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+)
+
+// errUnableToUpdateStatusProviderID produces an error indicating the Google IDP was unable
+// to be updated.
+func errUnableToUpdateStatusProviderID(request *GoogleIdentityProviderRequest, id string, err error) (ctrl.Result, error) {
+	return requeue.OnError(request, fmt.Errorf(
+		"unable to update google identity provider [%s] status [providerID=%s] - %w",
+		request.GetName(),
+		id,
+		err,
+	))
+}
+
+// errGetClientSecret produces an error indicating that the client secret was unable
+// to be retrieved for setting up the request.
+func errGetClientSecret(from *ocmv1alpha1.GoogleIdentityProvider) error {
+	return fmt.Errorf(
+		"unable to retrieve client secret from secret [%s/%s] at key [%s] - %w",
+		from.Namespace,
+		from.Spec.ClientSecret.Name,
+		ocmv1alpha1.GoogleClientSecretKey,
+		ErrMissingClientSecret,
+	)
+}
+
+// errUnableToUpdateStatusReferenceHash produces an error indicating the Google IDP status was unable
+// to be updated with the hash of the referenced data.
+func errUnableToUpdateStatusReferenceHash(request *GoogleIdentityProviderRequest, err error) (ctrl.Result, error) {
+	return requeue.OnError(request, fmt.Errorf(
+		"unable to update google identity provider [%s] status [referenceHash] - %w",
+		request.GetName(),
+		err,
+	))
+}
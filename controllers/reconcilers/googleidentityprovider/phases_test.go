@@ -0,0 +1,199 @@
+package googleidentityprovider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm/ocmtest"
+)
+
+const (
+	identityProvidersPath = "/api/clusters_mgmt/v1/clusters/abc/identity_providers"
+
+	identityProviderResponse = `{"kind":"IdentityProvider","id":"idp-1","name":"google","type":"GoogleIdentityProvider",` +
+		`"mapping_method":"claim","google":{"client_id":"client-id","hosted_domain":"example.com"}}`
+)
+
+func newTestController(t *testing.T, server *ocmtest.Server, google *ocmv1alpha1.GoogleIdentityProvider) *Controller {
+	t.Helper()
+
+	dependencies := controllertest.New(t, server, google)
+
+	return &Controller{
+		Client:     dependencies.Client,
+		Scheme:     dependencies.Scheme,
+		Connection: dependencies.Connection,
+		Recorder:   dependencies.Recorder,
+		Logger:     dependencies.Logger,
+	}
+}
+
+func newTestRequest(controller *Controller, google *ocmv1alpha1.GoogleIdentityProvider) *GoogleIdentityProviderRequest {
+	desired := google.DeepCopy()
+	desired.Spec.DisplayName = google.Name
+
+	return &GoogleIdentityProviderRequest{
+		Context:      context.Background(),
+		Original:     google,
+		Desired:      desired,
+		Reconciler:   controller,
+		ClientSecret: "secret",
+	}
+}
+
+func TestController_ApplyIdentityProvider(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		existing       bool
+		referenceHash  string
+		wantRequest    string
+		wantProviderID string
+	}{
+		{
+			name:           "ensure missing identity provider is created",
+			wantRequest:    http.MethodPost + " " + identityProvidersPath,
+			wantProviderID: "idp-1",
+		},
+		{
+			name:          "ensure identity provider in desired state is unchanged",
+			existing:      true,
+			referenceHash: kubernetes.Hash("secret"),
+		},
+		{
+			name:          "ensure identity provider is updated when the client secret changes",
+			existing:      true,
+			referenceHash: kubernetes.Hash("old"),
+			wantRequest:   http.MethodPatch + " " + identityProvidersPath + "/idp-1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			list := `{"kind":"IdentityProviderList","page":1,"size":0,"total":0,"items":[]}`
+			if tt.existing {
+				list = `{"kind":"IdentityProviderList","page":1,"size":1,"total":1,"items":[` + identityProviderResponse + `]}`
+			}
+
+			server := ocmtest.NewServer(t, map[string]ocmtest.Response{
+				http.MethodGet + " " + identityProvidersPath:              {Body: list},
+				http.MethodPost + " " + identityProvidersPath:             {Status: http.StatusCreated, Body: identityProviderResponse},
+				http.MethodPatch + " " + identityProvidersPath + "/idp-1": {Body: identityProviderResponse},
+			})
+
+			google := testGoogle()
+			google.Spec.ClientSecret.Name = "google-client-secret"
+			google.Status.ReferenceHash = tt.referenceHash
+
+			if tt.existing {
+				google.Status.ProviderID = "idp-1"
+			}
+
+			controller := newTestController(t, server, google)
+			req := newTestRequest(controller, google)
+
+			if _, err := controller.GetCurrentState(req); err != nil {
+				t.Fatalf("Controller.GetCurrentState() error = %v", err)
+			}
+
+			if _, err := controller.ApplyIdentityProvider(req); err != nil {
+				t.Fatalf("Controller.ApplyIdentityProvider() error = %v", err)
+			}
+
+			requests := server.Requests()
+			if tt.wantRequest == "" && len(requests) != 0 {
+				t.Errorf("Controller.ApplyIdentityProvider() requests = %v, want none", requests)
+			}
+
+			if tt.wantRequest != "" && (len(requests) != 1 || requests[0].Method+" "+requests[0].Path != tt.wantRequest) {
+				t.Errorf("Controller.ApplyIdentityProvider() requests = %v, want %s", requests, tt.wantRequest)
+			}
+
+			// the status must be stored in the cluster rather than only on the request
+			stored := &ocmv1alpha1.GoogleIdentityProvider{}
+			if err := controller.Get(context.Background(), client.ObjectKeyFromObject(google), stored); err != nil {
+				t.Fatalf("unable to get google identity provider - %v", err)
+			}
+
+			if tt.wantProviderID != "" && stored.Status.ProviderID != tt.wantProviderID {
+				t.Errorf("Controller.ApplyIdentityProvider() status.providerID = %v, want %v", stored.Status.ProviderID, tt.wantProviderID)
+			}
+
+			if want := kubernetes.Hash("secret"); stored.Status.ReferenceHash != want {
+				t.Errorf("Controller.ApplyIdentityProvider() status.referenceHash = %v, want %v", stored.Status.ReferenceHash, want)
+			}
+		})
+	}
+}
+
+func TestController_Destroy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		clusterName string
+		clusters    ocmtest.Response
+		wantRequest string
+	}{
+		{
+			name:        "ensure identity provider is deleted",
+			clusterName: "google-destroy",
+			clusters:    ocmtest.ClusterList("abc", "google-destroy"),
+			wantRequest: http.MethodDelete + " " + identityProvidersPath + "/idp-1",
+		},
+		{
+			name:        "ensure identity provider of a deleted cluster is not deleted",
+			clusterName: "google-destroy-missing",
+			clusters:    ocmtest.Response{Body: `{"kind":"ClusterList","page":1,"size":0,"total":0,"items":[]}`},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := ocmtest.NewServer(t, map[string]ocmtest.Response{
+				http.MethodGet + " /api/clusters_mgmt/v1/clusters":         tt.clusters,
+				http.MethodDelete + " " + identityProvidersPath + "/idp-1": {Status: http.StatusNoContent},
+			})
+
+			google := testGoogle()
+			google.Spec.ClusterName = tt.clusterName
+			google.Status.ProviderID = "idp-1"
+
+			// the client secret is not retrieved when deleting the object
+			controller := newTestController(t, server, google)
+			req := newTestRequest(controller, google)
+			req.ClientSecret = ""
+
+			if _, err := controller.Destroy(req); err != nil {
+				t.Fatalf("Controller.Destroy() error = %v", err)
+			}
+
+			requests := server.Requests()
+			if tt.wantRequest == "" && len(requests) != 0 {
+				t.Errorf("Controller.Destroy() requests = %v, want none", requests)
+			}
+
+			if tt.wantRequest != "" && (len(requests) != 1 || requests[0].Method+" "+requests[0].Path != tt.wantRequest) {
+				t.Errorf("Controller.Destroy() requests = %v, want %s", requests, tt.wantRequest)
+			}
+
+			if tt.wantRequest != "" && !conditions.IsSet(conditions.IdentityProviderDeleted(), req.Original) {
+				t.Errorf("Controller.Destroy() did not set the deleted condition")
+			}
+		})
+	}
+}
@@ -0,0 +1,166 @@
+package googleidentityprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+	"github.com/rh-mobb/ocm-operator/controllers/workload"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+var (
+	ErrMissingClientSecret = errors.New("unable to locate client secret data")
+)
+
+// GoogleIdentityProviderRequest is an object that is unique to each reconciliation
+// req.
+type GoogleIdentityProviderRequest struct {
+	Context           context.Context
+	ControllerRequest ctrl.Request
+	Current           *ocmv1alpha1.GoogleIdentityProvider
+	Original          *ocmv1alpha1.GoogleIdentityProvider
+	Desired           *ocmv1alpha1.GoogleIdentityProvider
+	Trigger           triggers.Trigger
+	Reconciler        *Controller
+	OCMClient         *ocm.IdentityProviderClient
+
+	// data obtained during request reconciliation
+	ClientSecret string
+}
+
+// This controller must have the ability to pull secrets which store the
+// client secret data.
+
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
+	original := &ocmv1alpha1.GoogleIdentityProvider{}
+
+	// get the object (desired state) from the cluster
+	if err := r.Get(ctx, ctrlReq.NamespacedName, original); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return &GoogleIdentityProviderRequest{}, fmt.Errorf("unable to fetch cluster object - %w", err)
+		}
+
+		return &GoogleIdentityProviderRequest{}, err
+	}
+
+	// get the client secret data from the cluster.  this is not needed when deleting the object,
+	// and skipping it allows the object to be deleted if the secret has already been removed.
+	var clientSecret string
+
+	if original.GetDeletionTimestamp() == nil {
+		var err error
+
+		clientSecret, err = kubernetes.GetSecretData(
+			ctx,
+			r,
+			original.Spec.ClientSecret.Name,
+			ctrlReq.Namespace,
+			ocmv1alpha1.GoogleClientSecretKey,
+		)
+		if clientSecret == "" {
+			if err != nil {
+				log.Log.Error(err, "error retrieving client secret")
+			}
+
+			return &GoogleIdentityProviderRequest{}, errGetClientSecret(original)
+		}
+	}
+
+	// create the desired state of the request based on the inputs
+	desired := original.DeepCopy()
+	if desired.Spec.DisplayName == "" {
+		desired.Spec.DisplayName = desired.Name
+	}
+
+	return &GoogleIdentityProviderRequest{
+		Original:          original,
+		Desired:           desired,
+		ControllerRequest: ctrlReq,
+		Context:           ctx,
+		Trigger:           triggers.GetTrigger(original),
+		Reconciler:        r,
+
+		// data obtained from cluster
+		ClientSecret: clientSecret,
+	}, nil
+}
+
+// DefaultRequeue returns the default requeue time for a request.
+func (req *GoogleIdentityProviderRequest) DefaultRequeue() time.Duration {
+	return defaultGoogleIdentityProviderRequeue
+}
+
+// GetObject returns the original object to satisfy the controllers.Request interface.
+func (req *GoogleIdentityProviderRequest) GetObject() workload.Workload {
+	return req.Original
+}
+
+// GetName returns the name as it should appear in OCM.
+func (req *GoogleIdentityProviderRequest) GetName() string {
+	return req.Desired.Spec.DisplayName
+}
+
+// GetClusterName returns the cluster name that this object belongs to.
+func (req *GoogleIdentityProviderRequest) GetClusterName() string {
+	return req.Desired.Spec.ClusterName
+}
+
+// GetContext returns the context of the request.
+func (req *GoogleIdentityProviderRequest) GetContext() context.Context {
+	return req.Context
+}
+
+// GetReconciler returns the context of the request.
+func (req *GoogleIdentityProviderRequest) GetReconciler() kubernetes.Client {
+	return req.Reconciler
+}
+
+// SetClusterStatus sets the relevant cluster fields in the status.  It is used
+// to satisfy the request.Request interface.
+func (req *GoogleIdentityProviderRequest) SetClusterStatus(cluster *clustersmgmtv1.Cluster) {
+	if req.Original.Status.ClusterID == "" {
+		req.Original.Status.ClusterID = cluster.ID()
+	}
+
+	if req.Original.Status.CallbackURL == "" {
+		req.Original.Status.CallbackURL = ocm.GetCallbackURL(cluster, req.Desired.Spec.DisplayName)
+	}
+}
+
+func (req *GoogleIdentityProviderRequest) desired() bool {
+	if req.Desired == nil || req.Current == nil {
+		return false
+	}
+
+	// ensure the referenced data has not changed since it was last applied.  we cannot
+	// get the current secret data from the api, so we compare against the hash of the
+	// data we last applied.
+	if req.Original.Status.ReferenceHash != req.referenceHash() {
+		return false
+	}
+
+	return reflect.DeepEqual(
+		req.Desired.Spec,
+		req.Current.Spec,
+	)
+}
+
+// referenceHash returns the hash of the data from the secrets and config maps which are
+// referenced by the object.
+func (req *GoogleIdentityProviderRequest) referenceHash() string {
+	return kubernetes.Hash(req.ClientSecret)
+}
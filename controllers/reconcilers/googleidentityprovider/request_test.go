@@ -0,0 +1,115 @@
+package googleidentityprovider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+)
+
+// testGoogle returns a google identity provider which has been associated with the cluster
+// with an id of abc.
+func testGoogle() *ocmv1alpha1.GoogleIdentityProvider {
+	return &ocmv1alpha1.GoogleIdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "google"},
+		Spec: ocmv1alpha1.GoogleIdentityProviderSpec{
+			ClusterName:   "test",
+			ClientID:      "client-id",
+			MappingMethod: "claim",
+			HostedDomain:  "example.com",
+		},
+		Status: ocmv1alpha1.GoogleIdentityProviderStatus{ClusterID: "abc"},
+	}
+}
+
+// testClientSecret returns the secret which stores the client secret of a google identity provider.
+func testClientSecret(name, clientSecret string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+		Data:       map[string][]byte{ocmv1alpha1.GoogleClientSecretKey: []byte(clientSecret)},
+	}
+}
+
+func TestController_NewRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name             string
+		deleting         bool
+		secret           client.Object
+		wantClientSecret string
+		wantErr          error
+	}{
+		{
+			name:             "ensure client secret is retrieved",
+			secret:           testClientSecret("google-client-secret", "secret"),
+			wantClientSecret: "secret",
+		},
+		{
+			name:    "ensure missing client secret returns an error",
+			wantErr: ErrMissingClientSecret,
+		},
+		{
+			name:    "ensure client secret without the client secret key returns an error",
+			secret:  testClientSecret("google-client-secret", ""),
+			wantErr: ErrMissingClientSecret,
+		},
+		{
+			name:     "ensure missing client secret does not prevent deletion",
+			deleting: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			google := testGoogle()
+			google.Spec.ClientSecret.Name = "google-client-secret"
+
+			// the fake client only stores objects which are being deleted if they have a finalizer
+			if tt.deleting {
+				google.Finalizers = []string{"test"}
+				google.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+			}
+
+			objects := []client.Object{google}
+			if tt.secret != nil {
+				objects = append(objects, tt.secret)
+			}
+
+			dependencies := controllertest.New(t, nil, objects...)
+			controller := &Controller{Client: dependencies.Client, Logger: dependencies.Logger}
+
+			got, err := controller.NewRequest(context.Background(), ctrl.Request{NamespacedName: client.ObjectKeyFromObject(google)})
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Controller.NewRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr != nil {
+				return
+			}
+
+			req, ok := got.(*GoogleIdentityProviderRequest)
+			if !ok {
+				t.Fatalf("Controller.NewRequest() = %T, want %T", got, &GoogleIdentityProviderRequest{})
+			}
+
+			if req.ClientSecret != tt.wantClientSecret {
+				t.Errorf("Controller.NewRequest() clientSecret = %v, want %v", req.ClientSecret, tt.wantClientSecret)
+			}
+
+			if req.Desired.Spec.DisplayName != google.Name {
+				t.Errorf("Controller.NewRequest() displayName = %v, want %v", req.Desired.Spec.DisplayName, google.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,42 @@
+package htpasswdidentityprovider
+
+import (
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+)
+
+// errUnableToUpdateStatus produces an error indicating the HTPasswd IDP status was unable
+// to be updated.
+func errUnableToUpdateStatus(request *HTPasswdIdentityProviderRequest, id string, err error) (ctrl.Result, error) {
+	return requeue.OnError(request, fmt.Errorf(
+		"unable to update htpasswd identity provider [%s] status [providerID=%s] - %w",
+		request.GetName(),
+		id,
+		err,
+	))
+}
+
+// errUnableToListUsers produces an error indicating the users of the HTPasswd IDP were unable
+// to be retrieved from OCM.
+func errUnableToListUsers(request *HTPasswdIdentityProviderRequest, err error) error {
+	return fmt.Errorf(
+		"unable to list users for htpasswd identity provider [%s] from ocm - %w",
+		request.GetName(),
+		err,
+	)
+}
+
+// errUnableToApplyUser produces an error indicating an action against a user of the HTPasswd IDP
+// was unable to be performed in OCM.
+func errUnableToApplyUser(request *HTPasswdIdentityProviderRequest, action, username string, err error) error {
+	return fmt.Errorf(
+		"unable to %s user [%s] for htpasswd identity provider [%s] in ocm - %w",
+		action,
+		username,
+		request.GetName(),
+		err,
+	)
+}
@@ -0,0 +1,208 @@
+package htpasswdidentityprovider
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/events"
+	"github.com/rh-mobb/ocm-operator/controllers/phases"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// GetCurrentState gets the current state of the HTPasswdIdentityProvider resource.  The current state of the HTPasswdIdentityProvider resource
+// is stored in OpenShift Cluster Manager.  It will be compared against the desired state which exists
+// within the OpenShift cluster in which this controller is reconciling against.
+func (r *Controller) GetCurrentState(req *HTPasswdIdentityProviderRequest) (ctrl.Result, error) {
+	// get the generic identity provider object from ocm
+	req.OCMClient = ocm.NewIdentityProviderClient(
+		req.Reconciler.Connection,
+		req.Desired.Spec.DisplayName,
+		req.Original.Status.ClusterID,
+	)
+
+	idp, err := req.OCMClient.Get(req.Context)
+	if err != nil {
+		return requeue.OnError(req, ocm.GetError(req, err))
+	}
+
+	// return if there is no identity provider found
+	if idp == nil {
+		return phases.Next()
+	}
+
+	// store the provider id in the status if it was not stored when the identity provider was
+	// created, as the users of the identity provider are managed using its id
+	if req.Original.Status.ProviderID != idp.ID() {
+		original := req.Original.DeepCopy()
+		req.Original.Status.ProviderID = idp.ID()
+
+		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+			return errUnableToUpdateStatus(req, idp.ID(), err)
+		}
+	}
+
+	// store the current state
+	req.Current = &ocmv1alpha1.HTPasswdIdentityProvider{}
+	req.Current.Spec.ClusterName = req.Desired.Spec.ClusterName
+	req.Current.Spec.DisplayName = req.Desired.Spec.DisplayName
+	req.Current.Spec.UserSecrets = req.Desired.Spec.UserSecrets
+	req.Current.CopyFrom(idp)
+
+	return phases.Next()
+}
+
+// ApplyIdentityProvider applies the HTPasswd identity provider state to OCM.  This includes creating and/or updating
+// the identity provider based on the provided attributes from the custom resource.  Users are created along with
+// the identity provider, but are otherwise managed in the ApplyUsers phase.
+func (r *Controller) ApplyIdentityProvider(req *HTPasswdIdentityProviderRequest) (ctrl.Result, error) {
+	// return if it is already in its desired state
+	if req.desired() {
+		r.Logger.V(controllers.LogLevelDebug).Info(
+			"htpasswd identity provider already in desired state",
+			request.LogValues(req)...,
+		)
+
+		return phases.Next()
+	}
+
+	// create the identity provider if it does not exist
+	if req.Current == nil {
+		r.Logger.Info("creating htpasswd identity provider", request.LogValues(req)...)
+		idp, err := req.OCMClient.Create(req.Context, req.Desired.Builder(req.Users))
+		if err != nil {
+			return requeue.OnError(req, ocm.CreateError(req, err))
+		}
+
+		// store the required provider data in the status
+		original := req.Original.DeepCopy()
+		req.Original.Status.ProviderID = idp.ID()
+		req.Original.Status.UserChecksums = req.checksums(idp.ID())
+
+		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+			return errUnableToUpdateStatus(req, idp.ID(), err)
+		}
+
+		// create an event indicating that the htpasswd identity provider has been created
+		events.RegisterAction(events.Created, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+
+		return phases.Next()
+	}
+
+	// update the identity provider if it does exist
+	r.Logger.Info("updating htpasswd identity provider", request.LogValues(req)...)
+	_, err := req.OCMClient.Update(req.Context, req.Desired.Builder(nil))
+	if err != nil {
+		return requeue.OnError(req, ocm.UpdateError(req, err))
+	}
+
+	// create an event indicating that the htpasswd identity provider has been updated
+	events.RegisterAction(events.Updated, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+
+	return phases.Next()
+}
+
+// ApplyUsers applies the desired users to the HTPasswd identity provider in OCM.  This includes creating missing
+// users, updating users with a changed password and removing users which are no longer desired.
+func (r *Controller) ApplyUsers(req *HTPasswdIdentityProviderRequest) (ctrl.Result, error) {
+	usersClient := ocm.NewHTPasswdUsersClient(
+		req.Reconciler.Connection,
+		req.Original.Status.ClusterID,
+		req.Original.Status.ProviderID,
+	)
+
+	current, err := usersClient.List(req.Context)
+	if err != nil {
+		return requeue.OnError(req, errUnableToListUsers(req, err))
+	}
+
+	// return if the users are already in their desired state
+	changes := req.changes(current)
+	if changes.empty() {
+		r.Logger.V(controllers.LogLevelDebug).Info(
+			"htpasswd identity provider users already in desired state",
+			request.LogValues(req)...,
+		)
+
+		return phases.Next()
+	}
+
+	// create users which are missing first to avoid leaving the identity provider
+	// without any users
+	for _, username := range changes.create {
+		r.Logger.Info("creating htpasswd user", append(request.LogValues(req), "user", username)...)
+		if _, err := usersClient.Create(req.Context, ocm.NewHTPasswdUserBuilder(username, req.Users[username])); err != nil {
+			return requeue.OnError(req, errUnableToApplyUser(req, "create", username, err))
+		}
+	}
+
+	for username, id := range changes.update {
+		r.Logger.Info("updating htpasswd user password", append(request.LogValues(req), "user", username)...)
+		if _, err := usersClient.Update(req.Context, id, ocm.NewHTPasswdUserBuilder(username, req.Users[username])); err != nil {
+			return requeue.OnError(req, errUnableToApplyUser(req, "update", username, err))
+		}
+	}
+
+	for username, id := range changes.delete {
+		r.Logger.Info("deleting htpasswd user", append(request.LogValues(req), "user", username)...)
+		if err := usersClient.Delete(req.Context, id); err != nil {
+			return requeue.OnError(req, errUnableToApplyUser(req, "delete", username, err))
+		}
+	}
+
+	// store the checksums of the synchronized users in the status
+	original := req.Original.DeepCopy()
+	req.Original.Status.UserChecksums = req.checksums(req.Original.Status.ProviderID)
+
+	if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+		return errUnableToUpdateStatus(req, req.Original.Status.ProviderID, err)
+	}
+
+	// create an event indicating that the htpasswd identity provider has been updated
+	events.RegisterAction(events.Updated, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+
+	return phases.Next()
+}
+
+// Destroy will destroy an OpenShift Cluster Manager HTPasswd Identity Provider.
+func (r *Controller) Destroy(req *HTPasswdIdentityProviderRequest) (ctrl.Result, error) {
+	// return immediately if we have already deleted the htpasswd identity provider
+	if conditions.IsSet(conditions.IdentityProviderDeleted(), req.Original) {
+		return phases.Next()
+	}
+
+	// return if the cluster does not exist (has been deleted)
+	_, exists, err := ocm.ClusterExists(req.Context, req.Desired.Spec.ClusterName, req.Reconciler.Connection)
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	if !exists {
+		return phases.Next()
+	}
+
+	ocmClient := ocm.NewIdentityProviderClient(
+		req.Reconciler.Connection,
+		req.Desired.Spec.DisplayName,
+		req.Original.Status.ClusterID,
+	)
+
+	// delete the object
+	if err := ocmClient.Delete(req.Context, req.Original.Status.ProviderID); err != nil {
+		return requeue.OnError(req, ocm.DeleteError(req, err))
+	}
+
+	// create an event indicating that the htpasswd identity provider has been deleted
+	events.RegisterAction(events.Deleted, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+
+	// set the deleted condition
+	if err := conditions.Update(req, conditions.IdentityProviderDeleted()); err != nil {
+		return requeue.OnError(req, conditions.UpdateDeletedConditionError(err))
+	}
+
+	return phases.Next()
+}
@@ -0,0 +1,115 @@
+package htpasswdidentityprovider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm/ocmtest"
+)
+
+const identityProvidersPath = "/api/clusters_mgmt/v1/clusters/abc/identity_providers"
+
+func TestController_GetCurrentState(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		providerID     string
+		wantProviderID string
+		wantRequest    string
+	}{
+		{
+			name:           "ensure provider id which was not stored after creation is stored",
+			wantProviderID: "idp-1",
+			wantRequest:    http.MethodPatch + " " + identityProvidersPath + "/idp-1/htpasswd_users/user-1",
+		},
+		{
+			name:           "ensure provider id of a recreated identity provider is stored",
+			providerID:     "idp-0",
+			wantProviderID: "idp-1",
+			wantRequest:    http.MethodPatch + " " + identityProvidersPath + "/idp-1/htpasswd_users/user-1",
+		},
+		{
+			name:           "ensure stored provider id is unchanged",
+			providerID:     "idp-1",
+			wantProviderID: "idp-1",
+			wantRequest:    http.MethodPatch + " " + identityProvidersPath + "/idp-1/htpasswd_users/user-1",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := ocmtest.NewServer(t, map[string]ocmtest.Response{
+				http.MethodGet + " " + identityProvidersPath: {
+					Body: `{"kind":"IdentityProviderList","page":1,"size":1,"total":1,"items":[` +
+						`{"kind":"IdentityProvider","id":"idp-1","name":"test","type":"HTPasswdIdentityProvider"}]}`,
+				},
+				http.MethodGet + " " + identityProvidersPath + "/idp-1/htpasswd_users": {
+					Body: `{"kind":"HTPasswdUserList","page":1,"size":1,"total":1,"items":[` +
+						`{"kind":"HTPasswdUser","id":"user-1","username":"admin"}]}`,
+				},
+				http.MethodPatch + " " + identityProvidersPath + "/idp-1/htpasswd_users/user-1": {
+					Body: `{"kind":"HTPasswdUser","id":"user-1","username":"admin"}`,
+				},
+			})
+
+			htpasswd := &ocmv1alpha1.HTPasswdIdentityProvider{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "test"},
+				Spec:       ocmv1alpha1.HTPasswdIdentityProviderSpec{ClusterName: "test", DisplayName: "test"},
+				Status: ocmv1alpha1.HTPasswdIdentityProviderStatus{
+					ClusterID:  "abc",
+					ProviderID: tt.providerID,
+				},
+			}
+
+			dependencies := controllertest.New(t, server, htpasswd)
+			controller := &Controller{
+				Client:     dependencies.Client,
+				Scheme:     dependencies.Scheme,
+				Connection: dependencies.Connection,
+				Recorder:   dependencies.Recorder,
+				Logger:     dependencies.Logger,
+			}
+
+			req := &HTPasswdIdentityProviderRequest{
+				Context:    context.Background(),
+				Original:   htpasswd,
+				Desired:    htpasswd.DeepCopy(),
+				Reconciler: controller,
+				Users:      map[string]string{"admin": "password"},
+			}
+
+			if _, err := controller.GetCurrentState(req); err != nil {
+				t.Fatalf("Controller.GetCurrentState() error = %v", err)
+			}
+
+			stored := &ocmv1alpha1.HTPasswdIdentityProvider{}
+			if err := controller.Get(context.Background(), client.ObjectKeyFromObject(htpasswd), stored); err != nil {
+				t.Fatalf("unable to get htpasswd identity provider - %v", err)
+			}
+
+			if stored.Status.ProviderID != tt.wantProviderID {
+				t.Errorf("Controller.GetCurrentState() status.providerID = %v, want %v", stored.Status.ProviderID, tt.wantProviderID)
+			}
+
+			// the users must be managed through the stored provider id
+			if _, err := controller.ApplyUsers(req); err != nil {
+				t.Fatalf("Controller.ApplyUsers() error = %v", err)
+			}
+
+			requests := server.Requests()
+			if len(requests) != 1 || requests[0].Method+" "+requests[0].Path != tt.wantRequest {
+				t.Errorf("Controller.ApplyUsers() requests = %v, want %s", requests, tt.wantRequest)
+			}
+		})
+	}
+}
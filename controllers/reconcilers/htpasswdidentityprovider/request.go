@@ -0,0 +1,241 @@
+package htpasswdidentityprovider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+	"github.com/rh-mobb/ocm-operator/controllers/workload"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+var (
+	ErrMissingUsers    = errors.New("unable to locate any users in user secrets")
+	ErrDuplicateUser   = errors.New("user is defined in multiple user secrets")
+	ErrMissingPassword = errors.New("user is missing password data")
+)
+
+// HTPasswdIdentityProviderRequest is an object that is unique to each reconciliation
+// req.
+type HTPasswdIdentityProviderRequest struct {
+	Context           context.Context
+	ControllerRequest ctrl.Request
+	Current           *ocmv1alpha1.HTPasswdIdentityProvider
+	Original          *ocmv1alpha1.HTPasswdIdentityProvider
+	Desired           *ocmv1alpha1.HTPasswdIdentityProvider
+	Trigger           triggers.Trigger
+	Reconciler        *Controller
+	OCMClient         *ocm.IdentityProviderClient
+
+	// data obtained during request reconciliation
+	Users map[string]string
+}
+
+// This controller must have the ability to pull secrets which store the
+// user data.
+
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
+	original := &ocmv1alpha1.HTPasswdIdentityProvider{}
+
+	// get the object (desired state) from the cluster
+	if err := r.Get(ctx, ctrlReq.NamespacedName, original); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return &HTPasswdIdentityProviderRequest{}, fmt.Errorf("unable to fetch cluster object - %w", err)
+		}
+
+		return &HTPasswdIdentityProviderRequest{}, err
+	}
+
+	// get the user data from the cluster.  this is not needed when deleting the object, and
+	// skipping it allows the object to be deleted if the secrets have already been removed.
+	var users map[string]string
+
+	if original.GetDeletionTimestamp() == nil {
+		secrets := make([]*corev1.Secret, len(original.Spec.UserSecrets))
+
+		for i := range original.Spec.UserSecrets {
+			secret, err := kubernetes.GetSecret(ctx, r, original.Spec.UserSecrets[i].Name, ctrlReq.Namespace)
+			if err != nil {
+				return &HTPasswdIdentityProviderRequest{}, fmt.Errorf("unable to obtain user secret from cluster - %w", err)
+			}
+
+			secrets[i] = secret
+		}
+
+		var err error
+
+		if users, err = usersFromSecrets(secrets); err != nil {
+			return &HTPasswdIdentityProviderRequest{}, fmt.Errorf("unable to obtain users from user secrets - %w", err)
+		}
+	}
+
+	// create the desired state of the request based on the inputs
+	desired := original.DeepCopy()
+	if desired.Spec.DisplayName == "" {
+		desired.Spec.DisplayName = desired.Name
+	}
+
+	return &HTPasswdIdentityProviderRequest{
+		Original:          original,
+		Desired:           desired,
+		ControllerRequest: ctrlReq,
+		Context:           ctx,
+		Trigger:           triggers.GetTrigger(original),
+		Reconciler:        r,
+
+		// data obtained from cluster
+		Users: users,
+	}, nil
+}
+
+// DefaultRequeue returns the default requeue time for a request.
+func (req *HTPasswdIdentityProviderRequest) DefaultRequeue() time.Duration {
+	return defaultHTPasswdIdentityProviderRequeue
+}
+
+// GetObject returns the original object to satisfy the controllers.Request interface.
+func (req *HTPasswdIdentityProviderRequest) GetObject() workload.Workload {
+	return req.Original
+}
+
+// GetName returns the name as it should appear in OCM.
+func (req *HTPasswdIdentityProviderRequest) GetName() string {
+	return req.Desired.Spec.DisplayName
+}
+
+// GetClusterName returns the cluster name that this object belongs to.
+func (req *HTPasswdIdentityProviderRequest) GetClusterName() string {
+	return req.Desired.Spec.ClusterName
+}
+
+// GetContext returns the context of the request.
+func (req *HTPasswdIdentityProviderRequest) GetContext() context.Context {
+	return req.Context
+}
+
+// GetReconciler returns the context of the request.
+func (req *HTPasswdIdentityProviderRequest) GetReconciler() kubernetes.Client {
+	return req.Reconciler
+}
+
+// SetClusterStatus sets the relevant cluster fields in the status.  It is used
+// to satisfy the request.Request interface.
+func (req *HTPasswdIdentityProviderRequest) SetClusterStatus(cluster *clustersmgmtv1.Cluster) {
+	if req.Original.Status.ClusterID == "" {
+		req.Original.Status.ClusterID = cluster.ID()
+	}
+}
+
+func (req *HTPasswdIdentityProviderRequest) desired() bool {
+	if req.Desired == nil || req.Current == nil {
+		return false
+	}
+
+	return reflect.DeepEqual(
+		req.Desired.Spec,
+		req.Current.Spec,
+	)
+}
+
+// checksums returns the checksums of the desired users, keyed by username.
+func (req *HTPasswdIdentityProviderRequest) checksums(providerID string) map[string]string {
+	checksums := make(map[string]string, len(req.Users))
+
+	for username, password := range req.Users {
+		checksums[username] = ocm.HTPasswdUserChecksum(req.Reconciler.ChecksumKey, providerID, username, password)
+	}
+
+	return checksums
+}
+
+// userChanges represents the changes that are needed to bring the users in OCM
+// into their desired state.
+type userChanges struct {
+	create []string
+	update map[string]string
+	delete map[string]string
+}
+
+// changes returns the changes needed to bring the current users in OCM into the desired state.
+// Because OCM does not return password data, the checksums stored in the status from the previous
+// synchronization are used to determine if a password has changed.
+func (req *HTPasswdIdentityProviderRequest) changes(current []*clustersmgmtv1.HTPasswdUser) *userChanges {
+	changes := &userChanges{
+		create: []string{},
+		update: map[string]string{},
+		delete: map[string]string{},
+	}
+
+	desired := req.checksums(req.Original.Status.ProviderID)
+	found := map[string]bool{}
+
+	for _, user := range current {
+		found[user.Username()] = true
+
+		checksum, ok := desired[user.Username()]
+		if !ok {
+			changes.delete[user.Username()] = user.ID()
+
+			continue
+		}
+
+		if req.Original.Status.UserChecksums[user.Username()] != checksum {
+			changes.update[user.Username()] = user.ID()
+		}
+	}
+
+	for username := range desired {
+		if !found[username] {
+			changes.create = append(changes.create, username)
+		}
+	}
+
+	sort.Strings(changes.create)
+
+	return changes
+}
+
+// empty determines if there are no changes to be made.
+func (changes *userChanges) empty() bool {
+	return len(changes.create) == 0 && len(changes.update) == 0 && len(changes.delete) == 0
+}
+
+// usersFromSecrets collects the users from a set of secrets, keyed by username with the
+// password as the value.
+func usersFromSecrets(secrets []*corev1.Secret) (map[string]string, error) {
+	users := map[string]string{}
+
+	for _, secret := range secrets {
+		for username, password := range secret.Data {
+			if _, exists := users[username]; exists {
+				return nil, fmt.Errorf("user [%s] in secret [%s/%s] - %w", username, secret.Namespace, secret.Name, ErrDuplicateUser)
+			}
+
+			if len(password) == 0 {
+				return nil, fmt.Errorf("user [%s] in secret [%s/%s] - %w", username, secret.Namespace, secret.Name, ErrMissingPassword)
+			}
+
+			users[username] = string(password)
+		}
+	}
+
+	if len(users) == 0 {
+		return nil, ErrMissingUsers
+	}
+
+	return users, nil
+}
@@ -0,0 +1,164 @@
+package htpasswdidentityprovider
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+func Test_usersFromSecrets(t *testing.T) {
+	t.Parallel()
+
+	newSecret := func(name string, data map[string]string) *corev1.Secret {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "test"},
+			Data:       map[string][]byte{},
+		}
+
+		for key, value := range data {
+			secret.Data[key] = []byte(value)
+		}
+
+		return secret
+	}
+
+	tests := []struct {
+		name    string
+		secrets []*corev1.Secret
+		want    map[string]string
+		wantErr error
+	}{
+		{
+			name: "ensure users are merged from multiple secrets",
+			secrets: []*corev1.Secret{
+				newSecret("admins", map[string]string{"admin": "password1"}),
+				newSecret("developers", map[string]string{"developer": "password2"}),
+			},
+			want: map[string]string{
+				"admin":     "password1",
+				"developer": "password2",
+			},
+		},
+		{
+			name: "ensure duplicate users return an error",
+			secrets: []*corev1.Secret{
+				newSecret("admins", map[string]string{"admin": "password1"}),
+				newSecret("developers", map[string]string{"admin": "password2"}),
+			},
+			wantErr: ErrDuplicateUser,
+		},
+		{
+			name: "ensure users with empty passwords return an error",
+			secrets: []*corev1.Secret{
+				newSecret("admins", map[string]string{"admin": ""}),
+			},
+			wantErr: ErrMissingPassword,
+		},
+		{
+			name: "ensure secrets with no users return an error",
+			secrets: []*corev1.Secret{
+				newSecret("admins", map[string]string{}),
+			},
+			wantErr: ErrMissingUsers,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := usersFromSecrets(tt.secrets)
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("usersFromSecrets() error = %v, wantErr %v", err, tt.wantErr)
+
+				return
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("usersFromSecrets() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestHTPasswdIdentityProviderRequest_changes(t *testing.T) {
+	t.Parallel()
+
+	const providerID = "test"
+
+	checksumKey := []byte("key")
+
+	newUser := func(id, username string) *clustersmgmtv1.HTPasswdUser {
+		user, err := clustersmgmtv1.NewHTPasswdUser().ID(id).Username(username).Build()
+		if err != nil {
+			t.Fatalf("unable to build user - %v", err)
+		}
+
+		return user
+	}
+
+	users := map[string]string{
+		"admin":     "password1",
+		"developer": "password2",
+	}
+
+	tests := []struct {
+		name      string
+		checksums map[string]string
+		current   []*clustersmgmtv1.HTPasswdUser
+		want      *userChanges
+	}{
+		{
+			name: "ensure users in desired state produce no changes",
+			checksums: map[string]string{
+				"admin":     ocm.HTPasswdUserChecksum(checksumKey, providerID, "admin", "password1"),
+				"developer": ocm.HTPasswdUserChecksum(checksumKey, providerID, "developer", "password2"),
+			},
+			current: []*clustersmgmtv1.HTPasswdUser{newUser("1", "admin"), newUser("2", "developer")},
+			want: &userChanges{
+				create: []string{},
+				update: map[string]string{},
+				delete: map[string]string{},
+			},
+		},
+		{
+			name: "ensure missing, changed and undesired users produce changes",
+			checksums: map[string]string{
+				"admin": ocm.HTPasswdUserChecksum(checksumKey, providerID, "admin", "oldPassword"),
+				"guest": ocm.HTPasswdUserChecksum(checksumKey, providerID, "guest", "password3"),
+			},
+			current: []*clustersmgmtv1.HTPasswdUser{newUser("1", "admin"), newUser("3", "guest")},
+			want: &userChanges{
+				create: []string{"developer"},
+				update: map[string]string{"admin": "1"},
+				delete: map[string]string{"guest": "3"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			request := &HTPasswdIdentityProviderRequest{
+				Original: &ocmv1alpha1.HTPasswdIdentityProvider{
+					Status: ocmv1alpha1.HTPasswdIdentityProviderStatus{
+						ProviderID:    providerID,
+						UserChecksums: tt.checksums,
+					},
+				},
+				Reconciler: &Controller{ChecksumKey: checksumKey},
+				Users:      users,
+			}
+			if got := request.changes(tt.current); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("HTPasswdIdentityProviderRequest.changes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
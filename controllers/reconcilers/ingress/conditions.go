@@ -0,0 +1,24 @@
+package ingress
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+)
+
+const (
+	ingressConditionTypeDeleted = "IngressDeleted"
+	ingressMessageDeleted       = "ingress has been deleted from openshift cluster manager"
+)
+
+// IngressDeleted return a condition indicating that the ingress has
+// been deleted from OpenShift Cluster Manager.
+func IngressDeleted() *metav1.Condition {
+	return &metav1.Condition{
+		Type:               ingressConditionTypeDeleted,
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             triggers.Delete.String(),
+		Message:            ingressMessageDeleted,
+	}
+}
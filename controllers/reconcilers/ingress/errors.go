@@ -0,0 +1,49 @@
+package ingress
+
+import (
+	"errors"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+)
+
+var (
+	ErrIngressHosted         = request.NewValidationError("additional ingresses are not valid for clusters using a hosted control plane")
+	ErrDefaultIngressMissing = errors.New("default ingress does not exist")
+)
+
+// errUnableToUpdateStatus produces an error indicating the ingress status was unable
+// to be updated.
+func errUnableToUpdateStatus(request *IngressRequest, id, dnsName string, err error) (ctrl.Result, error) {
+	return requeue.OnError(request, fmt.Errorf(
+		"unable to update ingress [%s] status [ingressID=%s, dnsName=%s] - %w",
+		request.GetName(),
+		id,
+		dnsName,
+		err,
+	))
+}
+
+// errIngressHosted produces an error indicating the ingress is unable to be created because the
+// cluster is using a hosted control plane.
+func errIngressHosted(request *IngressRequest) error {
+	return fmt.Errorf(
+		"unable to apply ingress [%s] to cluster [%s] - %w",
+		request.GetName(),
+		request.GetClusterName(),
+		ErrIngressHosted,
+	)
+}
+
+// errDefaultIngressMissing produces an error indicating the default ingress of the cluster was
+// unable to be found.
+func errDefaultIngressMissing(request *IngressRequest) error {
+	return fmt.Errorf(
+		"unable to find default ingress for cluster [%s] - %w",
+		request.GetClusterName(),
+		ErrDefaultIngressMissing,
+	)
+}
@@ -0,0 +1,175 @@
+package ingress
+
+import (
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/events"
+	"github.com/rh-mobb/ocm-operator/controllers/phases"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// GetCurrentState gets the current state of the Ingress resource.  The current state of the Ingress resource
+// is stored in OpenShift Cluster Manager.  It will be compared against the desired state which exists
+// within the OpenShift cluster in which this controller is reconciling against.
+func (r *Controller) GetCurrentState(req *IngressRequest) (ctrl.Result, error) {
+	// additional ingresses are only valid for clusters which are not using a hosted control plane
+	if !req.Desired.Spec.Default && req.Original.Status.Hosted {
+		return requeue.OnError(req, errIngressHosted(req))
+	}
+
+	req.OCMClient = ocm.NewIngressClient(
+		req.Reconciler.Connection,
+		req.Original.Status.IngressID,
+		req.Original.Status.ClusterID,
+		req.Desired.Spec.Default,
+	)
+
+	ingress, err := req.OCMClient.Get(req.Context)
+	if err != nil {
+		return requeue.OnError(req, ocm.GetError(req, err))
+	}
+
+	// find an additional ingress which was created but whose id was not stored, as additional ingresses
+	// are only otherwise found by their id and a duplicate ingress would be created.
+	if ingress == nil && !req.Desired.Spec.Default && req.Original.Status.IngressID == "" {
+		if ingress, err = req.OCMClient.FindByRouteSelectors(req.Context, req.Desired.Spec.RouteSelectors); err != nil {
+			return requeue.OnError(req, ocm.GetError(req, err))
+		}
+	}
+
+	// return if there is no ingress found.  the default ingress is created along with the
+	// cluster so it should always be found.
+	if ingress == nil {
+		if req.Desired.Spec.Default {
+			return requeue.OnError(req, errDefaultIngressMissing(req))
+		}
+
+		return phases.Next()
+	}
+
+	// store the current state
+	req.Current = &ocmv1alpha1.Ingress{}
+	req.Current.Spec.ClusterName = req.Desired.Spec.ClusterName
+	req.Current.CopyFrom(ingress)
+
+	// store the id of the ingress so that it may be updated
+	req.Desired.Status.IngressID = ingress.ID()
+
+	return r.updateStatus(req, ingress)
+}
+
+// ApplyIngress applies the ingress state to OCM.  This includes creating and/or updating
+// the ingress based on the provided attributes from the custom resource.
+func (r *Controller) ApplyIngress(req *IngressRequest) (ctrl.Result, error) {
+	// return if it is already in its desired state
+	if req.desired() {
+		r.Logger.V(controllers.LogLevelDebug).Info(
+			"ingress already in desired state",
+			request.LogValues(req)...,
+		)
+
+		return phases.Next()
+	}
+
+	// create the ingress if it does not exist
+	if req.Current == nil {
+		r.Logger.Info("creating ingress", request.LogValues(req)...)
+		ingress, err := req.OCMClient.Create(req.Context, req.Desired.Builder())
+		if err != nil {
+			return requeue.OnError(req, ocm.CreateError(req, err))
+		}
+
+		// store the required ingress data in the status
+		if result, err := r.updateStatus(req, ingress); err != nil {
+			return result, err
+		}
+
+		// create an event indicating that the ingress has been created
+		events.RegisterAction(events.Created, req.Original, r.Recorder, req.GetName(), req.Original.Status.ClusterID)
+
+		return phases.Next()
+	}
+
+	// update the ingress if it does exist
+	r.Logger.Info("updating ingress", request.LogValues(req)...)
+	ingress, err := req.OCMClient.Update(req.Context, req.Desired.Builder())
+	if err != nil {
+		return requeue.OnError(req, ocm.UpdateError(req, err))
+	}
+
+	// store the required ingress data in the status
+	if result, err := r.updateStatus(req, ingress); err != nil {
+		return result, err
+	}
+
+	// create an event indicating that the ingress has been updated
+	events.RegisterAction(events.Updated, req.Original, r.Recorder, req.GetName(), req.Original.Status.ClusterID)
+
+	return phases.Next()
+}
+
+// Destroy will destroy an OpenShift Cluster Manager ingress.  The default ingress is never deleted as it
+// is owned by the cluster.
+func (r *Controller) Destroy(req *IngressRequest) (ctrl.Result, error) {
+	// return immediately if we have already deleted the ingress
+	if conditions.IsSet(IngressDeleted(), req.Original) {
+		return phases.Next()
+	}
+
+	// return if the cluster does not exist (has been deleted)
+	_, exists, err := ocm.ClusterExists(req.Context, req.Desired.Spec.ClusterName, req.Reconciler.Connection)
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	if !exists {
+		return phases.Next()
+	}
+
+	// delete the object if it was created by this controller
+	if !req.Desired.Spec.Default && req.Original.Status.IngressID != "" {
+		r.Logger.Info("deleting ingress", request.LogValues(req)...)
+		if err := ocm.NewIngressClient(
+			req.Reconciler.Connection,
+			req.Original.Status.IngressID,
+			req.Original.Status.ClusterID,
+			false,
+		).Delete(req.Context, req.Original.Status.IngressID); err != nil {
+			return requeue.OnError(req, ocm.DeleteError(req, err))
+		}
+
+		// create an event indicating that the ingress has been deleted
+		events.RegisterAction(events.Deleted, req.Original, r.Recorder, req.GetName(), req.Original.Status.ClusterID)
+	}
+
+	// set the deleted condition
+	if err := conditions.Update(req, IngressDeleted()); err != nil {
+		return requeue.OnError(req, conditions.UpdateDeletedConditionError(err))
+	}
+
+	return phases.Next()
+}
+
+// updateStatus stores the id and dns name of the ingress in the status if they have changed.
+func (r *Controller) updateStatus(req *IngressRequest, ingress *clustersmgmtv1.Ingress) (ctrl.Result, error) {
+	if req.Original.Status.IngressID == ingress.ID() && req.Original.Status.DNSName == ingress.DNSName() {
+		return phases.Next()
+	}
+
+	original := req.Original.DeepCopy()
+	req.Original.Status.IngressID = ingress.ID()
+	req.Original.Status.DNSName = ingress.DNSName()
+
+	if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+		return errUnableToUpdateStatus(req, ingress.ID(), ingress.DNSName(), err)
+	}
+
+	return phases.Next()
+}
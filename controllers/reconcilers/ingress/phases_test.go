@@ -0,0 +1,316 @@
+package ingress
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm/ocmtest"
+)
+
+const ingressesPath = "/api/clusters_mgmt/v1/clusters/abc/ingresses"
+
+// testIngress returns an ingress which has been associated with the classic cluster with an
+// id of abc.
+func testIngress(clusterName string, isDefault bool) *ocmv1alpha1.Ingress {
+	return &ocmv1alpha1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "test", Generation: 1},
+		Spec: ocmv1alpha1.IngressSpec{
+			ClusterName:        clusterName,
+			Default:            isDefault,
+			Listening:          "external",
+			ExcludedNamespaces: []string{"a", "b"},
+		},
+		Status: ocmv1alpha1.IngressStatus{ClusterID: "abc"},
+	}
+}
+
+// ingressResponse returns the response for an ingress as it is reported by ocm.
+func ingressResponse(t *testing.T, id, dnsName string, isDefault bool, listening clustersmgmtv1.ListeningMethod) string {
+	t.Helper()
+
+	return additionalIngressResponse(t, id, dnsName, isDefault, listening, nil)
+}
+
+// additionalIngressResponse returns the response for an ingress which selects the routes with the given
+// labels, as it is reported by ocm.
+func additionalIngressResponse(
+	t *testing.T,
+	id, dnsName string,
+	isDefault bool,
+	listening clustersmgmtv1.ListeningMethod,
+	selectors map[string]string,
+) string {
+	t.Helper()
+
+	object, err := clustersmgmtv1.NewIngress().
+		ID(id).
+		DNSName(dnsName).
+		Default(isDefault).
+		Listening(listening).
+		LoadBalancerType(clustersmgmtv1.LoadBalancerFlavorNlb).
+		RouteSelectors(selectors).
+		ExcludedNamespaces("b", "a").
+		Build()
+	if err != nil {
+		t.Fatalf("unable to build ingress - %v", err)
+	}
+
+	return ocmtest.Marshal(t, object, clustersmgmtv1.MarshalIngress)
+}
+
+func newTestController(t *testing.T, server *ocmtest.Server, ingress *ocmv1alpha1.Ingress) *Controller {
+	t.Helper()
+
+	dependencies := controllertest.New(t, server, ingress)
+
+	return &Controller{
+		Client:     dependencies.Client,
+		Scheme:     dependencies.Scheme,
+		Connection: dependencies.Connection,
+		Recorder:   dependencies.Recorder,
+		Logger:     dependencies.Logger,
+	}
+}
+
+func TestController_ApplyIngress(t *testing.T) {
+	t.Parallel()
+
+	defaultIngress := func(t *testing.T, listening clustersmgmtv1.ListeningMethod) ocmtest.Response {
+		return ocmtest.Response{Body: fmt.Sprintf(
+			`{"kind":"IngressList","page":1,"size":1,"total":1,"items":[%s]}`,
+			ingressResponse(t, "default-id", "apps.test.example.com", true, listening),
+		)}
+	}
+
+	tests := []struct {
+		name        string
+		isDefault   bool
+		hosted      bool
+		routes      func(t *testing.T) map[string]ocmtest.Response
+		wantRequest string
+		wantID      string
+		wantDNSName string
+		wantErr     error
+	}{
+		{
+			name:      "ensure default ingress in desired state is unchanged and reported in status",
+			isDefault: true,
+			routes: func(t *testing.T) map[string]ocmtest.Response {
+				return map[string]ocmtest.Response{
+					http.MethodGet + " " + ingressesPath: defaultIngress(t, clustersmgmtv1.ListeningMethodExternal),
+				}
+			},
+			wantID:      "default-id",
+			wantDNSName: "apps.test.example.com",
+		},
+		{
+			name:      "ensure default ingress with drifted listening method is updated",
+			isDefault: true,
+			hosted:    true,
+			routes: func(t *testing.T) map[string]ocmtest.Response {
+				return map[string]ocmtest.Response{
+					http.MethodGet + " " + ingressesPath: defaultIngress(t, clustersmgmtv1.ListeningMethodInternal),
+					http.MethodPatch + " " + ingressesPath + "/default-id": {
+						Body: ingressResponse(t, "default-id", "apps.test.example.com", true, clustersmgmtv1.ListeningMethodExternal),
+					},
+				}
+			},
+			wantRequest: http.MethodPatch + " " + ingressesPath + "/default-id",
+			wantID:      "default-id",
+			wantDNSName: "apps.test.example.com",
+		},
+		{
+			name: "ensure missing additional ingress is created and reported in status",
+			routes: func(t *testing.T) map[string]ocmtest.Response {
+				return map[string]ocmtest.Response{
+					http.MethodGet + " " + ingressesPath: defaultIngress(t, clustersmgmtv1.ListeningMethodExternal),
+					http.MethodPost + " " + ingressesPath: {
+						Status: http.StatusCreated,
+						Body:   ingressResponse(t, "new-id", "apps2.test.example.com", false, clustersmgmtv1.ListeningMethodExternal),
+					},
+				}
+			},
+			wantRequest: http.MethodPost + " " + ingressesPath,
+			wantID:      "new-id",
+			wantDNSName: "apps2.test.example.com",
+		},
+		{
+			name: "ensure additional ingress with other route selectors is not found and ingress is created",
+			routes: func(t *testing.T) map[string]ocmtest.Response {
+				return map[string]ocmtest.Response{
+					http.MethodGet + " " + ingressesPath: {Body: fmt.Sprintf(
+						`{"kind":"IngressList","page":1,"size":2,"total":2,"items":[%s,%s]}`,
+						ingressResponse(t, "default-id", "apps.test.example.com", true, clustersmgmtv1.ListeningMethodExternal),
+						additionalIngressResponse(t, "other-id", "apps3.test.example.com", false, clustersmgmtv1.ListeningMethodExternal,
+							map[string]string{"route": "internal"},
+						),
+					)},
+					http.MethodPost + " " + ingressesPath: {
+						Status: http.StatusCreated,
+						Body:   ingressResponse(t, "new-id", "apps2.test.example.com", false, clustersmgmtv1.ListeningMethodExternal),
+					},
+				}
+			},
+			wantRequest: http.MethodPost + " " + ingressesPath,
+			wantID:      "new-id",
+			wantDNSName: "apps2.test.example.com",
+		},
+		{
+			name: "ensure created additional ingress whose id was not stored is found rather than created again",
+			routes: func(t *testing.T) map[string]ocmtest.Response {
+				return map[string]ocmtest.Response{
+					http.MethodGet + " " + ingressesPath: {Body: fmt.Sprintf(
+						`{"kind":"IngressList","page":1,"size":2,"total":2,"items":[%s,%s]}`,
+						ingressResponse(t, "default-id", "apps.test.example.com", true, clustersmgmtv1.ListeningMethodExternal),
+						ingressResponse(t, "new-id", "apps2.test.example.com", false, clustersmgmtv1.ListeningMethodExternal),
+					)},
+				}
+			},
+			wantID:      "new-id",
+			wantDNSName: "apps2.test.example.com",
+		},
+		{
+			name:   "ensure additional ingress is rejected for hosted clusters",
+			hosted: true,
+			routes: func(t *testing.T) map[string]ocmtest.Response {
+				return map[string]ocmtest.Response{}
+			},
+			wantErr: ErrIngressHosted,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := ocmtest.NewServer(t, tt.routes(t))
+			ingress := testIngress("test", tt.isDefault)
+			ingress.Status.Hosted = tt.hosted
+			controller := newTestController(t, server, ingress)
+			req := &IngressRequest{
+				Context:    context.Background(),
+				Original:   ingress,
+				Desired:    ingress.DeepCopy(),
+				Reconciler: controller,
+			}
+
+			_, err := controller.GetCurrentState(req)
+			if tt.wantErr != nil {
+				if !errors.Is(err, tt.wantErr) {
+					t.Fatalf("Controller.GetCurrentState() error = %v, want %v", err, tt.wantErr)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Controller.GetCurrentState() error = %v", err)
+			}
+
+			if _, err := controller.ApplyIngress(req); err != nil {
+				t.Fatalf("Controller.ApplyIngress() error = %v", err)
+			}
+
+			requests := server.Requests()
+			if tt.wantRequest == "" && len(requests) != 0 {
+				t.Errorf("Controller.ApplyIngress() requests = %v, want none", requests)
+			}
+
+			if tt.wantRequest != "" && (len(requests) != 1 || requests[0].Method+" "+requests[0].Path != tt.wantRequest) {
+				t.Errorf("Controller.ApplyIngress() requests = %v, want %s", requests, tt.wantRequest)
+			}
+
+			// the status must be stored in the cluster rather than only on the request
+			stored := &ocmv1alpha1.Ingress{}
+			if err := controller.Get(context.Background(), client.ObjectKeyFromObject(ingress), stored); err != nil {
+				t.Fatalf("unable to get ingress - %v", err)
+			}
+
+			if stored.Status.IngressID != tt.wantID {
+				t.Errorf("Controller.ApplyIngress() status.ingressID = %v, want %v", stored.Status.IngressID, tt.wantID)
+			}
+
+			if stored.Status.DNSName != tt.wantDNSName {
+				t.Errorf("Controller.ApplyIngress() status.dnsName = %v, want %v", stored.Status.DNSName, tt.wantDNSName)
+			}
+		})
+	}
+}
+
+func TestController_Destroy(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		clusterName string
+		isDefault   bool
+		ingressID   string
+		wantRequest string
+	}{
+		{
+			name:        "ensure additional ingress is deleted",
+			clusterName: "destroy-additional",
+			ingressID:   "abc123",
+			wantRequest: http.MethodDelete + " " + ingressesPath + "/abc123",
+		},
+		{
+			name:        "ensure default ingress is not deleted",
+			clusterName: "destroy-default",
+			isDefault:   true,
+			ingressID:   "default-id",
+		},
+		{
+			name:        "ensure additional ingress which was never created is not deleted",
+			clusterName: "destroy-uncreated",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := ocmtest.NewServer(t, map[string]ocmtest.Response{
+				http.MethodGet + " /api/clusters_mgmt/v1/clusters":  ocmtest.ClusterList("abc", tt.clusterName),
+				http.MethodDelete + " " + ingressesPath + "/abc123": {Status: http.StatusNoContent},
+			})
+			ingress := testIngress(tt.clusterName, tt.isDefault)
+			ingress.Status.IngressID = tt.ingressID
+			controller := newTestController(t, server, ingress)
+			req := &IngressRequest{
+				Context:    context.Background(),
+				Original:   ingress,
+				Desired:    ingress.DeepCopy(),
+				Reconciler: controller,
+			}
+
+			if _, err := controller.Destroy(req); err != nil {
+				t.Fatalf("Controller.Destroy() error = %v", err)
+			}
+
+			requests := server.Requests()
+			if tt.wantRequest == "" && len(requests) != 0 {
+				t.Errorf("Controller.Destroy() requests = %v, want none", requests)
+			}
+
+			if tt.wantRequest != "" && (len(requests) != 1 || requests[0].Method+" "+requests[0].Path != tt.wantRequest) {
+				t.Errorf("Controller.Destroy() requests = %v, want %s", requests, tt.wantRequest)
+			}
+
+			if !conditions.IsSet(IngressDeleted(), req.Original) {
+				t.Errorf("Controller.Destroy() did not set the deleted condition")
+			}
+		})
+	}
+}
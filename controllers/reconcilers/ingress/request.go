@@ -0,0 +1,137 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+	"github.com/rh-mobb/ocm-operator/controllers/workload"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// IngressRequest is an object that is unique to each reconciliation
+// req.
+type IngressRequest struct {
+	Context           context.Context
+	ControllerRequest ctrl.Request
+	Current           *ocmv1alpha1.Ingress
+	Original          *ocmv1alpha1.Ingress
+	Desired           *ocmv1alpha1.Ingress
+	Trigger           triggers.Trigger
+	Reconciler        *Controller
+	OCMClient         *ocm.IngressClient
+}
+
+func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
+	original := &ocmv1alpha1.Ingress{}
+
+	// get the object (desired state) from the cluster
+	if err := r.Get(ctx, ctrlReq.NamespacedName, original); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return &IngressRequest{}, fmt.Errorf("unable to fetch cluster object - %w", err)
+		}
+
+		return &IngressRequest{}, err
+	}
+
+	return &IngressRequest{
+		Original:          original,
+		Desired:           original.DeepCopy(),
+		ControllerRequest: ctrlReq,
+		Context:           ctx,
+		Trigger:           triggers.GetTrigger(original),
+		Reconciler:        r,
+	}, nil
+}
+
+// DefaultRequeue returns the default requeue time for a request.
+func (req *IngressRequest) DefaultRequeue() time.Duration {
+	return defaultIngressRequeue
+}
+
+// GetObject returns the original object to satisfy the controllers.Request interface.
+func (req *IngressRequest) GetObject() workload.Workload {
+	return req.Original
+}
+
+// GetName returns the name of the object.  Ingresses have no name in OCM and are instead
+// identified by their ID.
+func (req *IngressRequest) GetName() string {
+	return req.Original.Name
+}
+
+// GetClusterName returns the cluster name that this object belongs to.
+func (req *IngressRequest) GetClusterName() string {
+	return req.Desired.Spec.ClusterName
+}
+
+// GetContext returns the context of the request.
+func (req *IngressRequest) GetContext() context.Context {
+	return req.Context
+}
+
+// GetReconciler returns the context of the request.
+func (req *IngressRequest) GetReconciler() kubernetes.Client {
+	return req.Reconciler
+}
+
+// SetClusterStatus sets the relevant cluster fields in the status.  It is used
+// to satisfy the request.Request interface.
+func (req *IngressRequest) SetClusterStatus(cluster *clustersmgmtv1.Cluster) {
+	if req.Original.Status.ClusterID == "" {
+		req.Original.Status.ClusterID = cluster.ID()
+	}
+
+	req.Original.Status.Hosted = cluster.Hypershift().Enabled()
+}
+
+func (req *IngressRequest) desired() bool {
+	if req.Desired == nil || req.Current == nil {
+		return false
+	}
+
+	desired, current := req.Desired.Spec.DeepCopy(), req.Current.Spec.DeepCopy()
+
+	// ignore the fields which were not requested and have been determined by ocm
+	if desired.Listening == "" {
+		desired.Listening = current.Listening
+	}
+
+	if desired.LoadBalancerType == "" {
+		desired.LoadBalancerType = current.LoadBalancerType
+	}
+
+	if desired.RouteWildcardPolicy == "" {
+		desired.RouteWildcardPolicy = current.RouteWildcardPolicy
+	}
+
+	if desired.RouteNamespaceOwnershipPolicy == "" {
+		desired.RouteNamespaceOwnershipPolicy = current.RouteNamespaceOwnershipPolicy
+	}
+
+	// the order of excluded namespaces is not significant and empty collections may be returned
+	// from ocm as missing
+	for _, spec := range []*ocmv1alpha1.IngressSpec{desired, current} {
+		sort.Strings(spec.ExcludedNamespaces)
+
+		if len(spec.ExcludedNamespaces) == 0 {
+			spec.ExcludedNamespaces = nil
+		}
+
+		if len(spec.RouteSelectors) == 0 {
+			spec.RouteSelectors = nil
+		}
+	}
+
+	return reflect.DeepEqual(desired, current)
+}
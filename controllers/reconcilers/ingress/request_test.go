@@ -0,0 +1,85 @@
+package ingress
+
+import (
+	"testing"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+)
+
+func TestIngressRequest_desired(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		current func(*ocmv1alpha1.Ingress)
+		desired func(*ocmv1alpha1.Ingress)
+		missing bool
+		want    bool
+	}{
+		{
+			name: "ensure values determined by ocm reflect desired state",
+			current: func(ingress *ocmv1alpha1.Ingress) {
+				ingress.Spec.LoadBalancerType = "nlb"
+				ingress.Spec.RouteWildcardPolicy = "WildcardsDisallowed"
+			},
+			desired: func(ingress *ocmv1alpha1.Ingress) {
+				ingress.Spec.Listening = ""
+			},
+			want: true,
+		},
+		{
+			name: "ensure differently ordered excluded namespaces reflect desired state",
+			current: func(ingress *ocmv1alpha1.Ingress) {
+				ingress.Spec.ExcludedNamespaces = []string{"b", "a"}
+			},
+			want: true,
+		},
+		{
+			name: "ensure changed listening method does not reflect desired state",
+			desired: func(ingress *ocmv1alpha1.Ingress) {
+				ingress.Spec.Listening = "internal"
+			},
+			want: false,
+		},
+		{
+			name: "ensure changed excluded namespaces do not reflect desired state",
+			desired: func(ingress *ocmv1alpha1.Ingress) {
+				ingress.Spec.ExcludedNamespaces = nil
+			},
+			want: false,
+		},
+		{
+			name:    "ensure missing current state does not reflect desired state",
+			missing: true,
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			request := &IngressRequest{
+				Current: testIngress("test", true),
+				Desired: testIngress("test", true),
+			}
+
+			if tt.current != nil {
+				tt.current(request.Current)
+			}
+
+			if tt.desired != nil {
+				tt.desired(request.Desired)
+			}
+
+			if tt.missing {
+				request.Current = nil
+			}
+
+			if got := request.desired(); got != tt.want {
+				t.Errorf("IngressRequest.desired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package kubeletconfig
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+)
+
+const (
+	kubeletConfigConditionTypeDeleted = "KubeletConfigDeleted"
+	kubeletConfigMessageDeleted       = "kubelet config has been deleted from openshift cluster manager"
+)
+
+// KubeletConfigDeleted return a condition indicating that the kubelet config has
+// been deleted from OpenShift Cluster Manager.
+func KubeletConfigDeleted() *metav1.Condition {
+	return &metav1.Condition{
+		Type:               kubeletConfigConditionTypeDeleted,
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             triggers.Delete.String(),
+		Message:            kubeletConfigMessageDeleted,
+	}
+}
@@ -0,0 +1,46 @@
+package kubeletconfig
+
+import (
+	"errors"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+)
+
+var (
+	ErrKubeletConfigInUse = errors.New("kubelet config is referenced by machine pools")
+)
+
+// errUnableToUpdateStatusConfigID produces an error indicating the kubelet config status was unable
+// to be updated.
+func errUnableToUpdateStatusConfigID(request *KubeletConfigRequest, id string, err error) (ctrl.Result, error) {
+	return requeue.OnError(request, fmt.Errorf(
+		"unable to update kubelet config [%s] status [configID=%s] - %w",
+		request.GetName(),
+		id,
+		err,
+	))
+}
+
+// errUnableToFindMachinePools produces an error indicating the machine pools which reference the
+// kubelet config were unable to be determined.
+func errUnableToFindMachinePools(request *KubeletConfigRequest, err error) error {
+	return fmt.Errorf(
+		"unable to find machine pools referencing kubelet config [%s] - %w",
+		request.GetName(),
+		err,
+	)
+}
+
+// errKubeletConfigInUse produces an error indicating the kubelet config is unable to be deleted
+// because it is still referenced by machine pools.
+func errKubeletConfigInUse(request *KubeletConfigRequest, machinePools []string) error {
+	return fmt.Errorf(
+		"unable to delete kubelet config [%s] referenced by machine pools %v - %w",
+		request.GetName(),
+		machinePools,
+		ErrKubeletConfigInUse,
+	)
+}
@@ -0,0 +1,142 @@
+package kubeletconfig
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/events"
+	"github.com/rh-mobb/ocm-operator/controllers/phases"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// GetCurrentState gets the current state of the KubeletConfig resource.  The current state of the KubeletConfig resource
+// is stored in OpenShift Cluster Manager.  It will be compared against the desired state which exists
+// within the OpenShift cluster in which this controller is reconciling against.
+func (r *Controller) GetCurrentState(req *KubeletConfigRequest) (ctrl.Result, error) {
+	req.OCMClient = req.newOCMClient()
+
+	kubeletConfig, err := req.OCMClient.Get(req.Context)
+	if err != nil {
+		return requeue.OnError(req, ocm.GetError(req, err))
+	}
+
+	// return if there is no kubelet config found
+	if kubeletConfig == nil {
+		return phases.Next()
+	}
+
+	// store the current state
+	req.Current = &ocmv1alpha1.KubeletConfig{}
+	req.Current.Spec.ClusterName = req.Desired.Spec.ClusterName
+	req.Current.Spec.DisplayName = req.Desired.Spec.DisplayName
+	req.Current.CopyFrom(kubeletConfig)
+
+	// store the id of the kubelet config so that it may be updated.  the id is only
+	// relevant for clusters using a hosted control plane.
+	if req.Original.Status.Hosted {
+		req.Desired.Status.ConfigID = kubeletConfig.ID()
+	}
+
+	return phases.Next()
+}
+
+// ApplyKubeletConfig applies the kubelet config state to OCM.  This includes creating and/or updating
+// the kubelet config based on the provided attributes from the custom resource.
+func (r *Controller) ApplyKubeletConfig(req *KubeletConfigRequest) (ctrl.Result, error) {
+	// return if it is already in its desired state
+	if req.desired() {
+		r.Logger.V(controllers.LogLevelDebug).Info(
+			"kubelet config already in desired state",
+			request.LogValues(req)...,
+		)
+
+		return phases.Next()
+	}
+
+	// create the kubelet config if it does not exist
+	if req.Current == nil {
+		r.Logger.Info("creating kubelet config", request.LogValues(req)...)
+		kubeletConfig, err := req.OCMClient.Create(req.Context, req.Desired.Builder())
+		if err != nil {
+			return requeue.OnError(req, ocm.CreateError(req, err))
+		}
+
+		// store the required kubelet config data in the status.  the id is only
+		// relevant for clusters using a hosted control plane as other clusters have a
+		// single kubelet config.
+		if req.Original.Status.Hosted {
+			original := req.Original.DeepCopy()
+			req.Original.Status.ConfigID = kubeletConfig.ID()
+
+			if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+				return errUnableToUpdateStatusConfigID(req, kubeletConfig.ID(), err)
+			}
+		}
+
+		// create an event indicating that the kubelet config has been created
+		events.RegisterAction(events.Created, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+
+		return phases.Next()
+	}
+
+	// update the kubelet config if it does exist
+	r.Logger.Info("updating kubelet config", request.LogValues(req)...)
+	if _, err := req.OCMClient.Update(req.Context, req.Desired.Builder()); err != nil {
+		return requeue.OnError(req, ocm.UpdateError(req, err))
+	}
+
+	// create an event indicating that the kubelet config has been updated
+	events.RegisterAction(events.Updated, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+
+	return phases.Next()
+}
+
+// Destroy will destroy an OpenShift Cluster Manager kubelet config.  Deletion is blocked while the
+// kubelet config is still referenced by a machine pool.
+func (r *Controller) Destroy(req *KubeletConfigRequest) (ctrl.Result, error) {
+	// return immediately if we have already deleted the kubelet config
+	if conditions.IsSet(KubeletConfigDeleted(), req.Original) {
+		return phases.Next()
+	}
+
+	// return if the cluster does not exist (has been deleted)
+	_, exists, err := ocm.ClusterExists(req.Context, req.Desired.Spec.ClusterName, req.Reconciler.Connection)
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	if !exists {
+		return phases.Next()
+	}
+
+	// ensure the kubelet config is no longer referenced by a machine pool
+	machinePools, err := req.referencingMachinePools()
+	if err != nil {
+		return requeue.OnError(req, errUnableToFindMachinePools(req, err))
+	}
+
+	if len(machinePools) > 0 {
+		return requeue.OnError(req, errKubeletConfigInUse(req, machinePools))
+	}
+
+	// delete the object
+	r.Logger.Info("deleting kubelet config", request.LogValues(req)...)
+	if err := req.deleteKubeletConfig(); err != nil {
+		return requeue.OnError(req, ocm.DeleteError(req, err))
+	}
+
+	// create an event indicating that the kubelet config has been deleted
+	events.RegisterAction(events.Deleted, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+
+	// set the deleted condition
+	if err := conditions.Update(req, KubeletConfigDeleted()); err != nil {
+		return requeue.OnError(req, conditions.UpdateDeletedConditionError(err))
+	}
+
+	return phases.Next()
+}
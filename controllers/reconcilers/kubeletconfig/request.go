@@ -0,0 +1,174 @@
+package kubeletconfig
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+	"github.com/rh-mobb/ocm-operator/controllers/workload"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// kubeletConfigClient is the client used to interact with a kubelet config in OCM.  Clusters which
+// are using a hosted control plane and clusters which are not use different APIs.
+type kubeletConfigClient interface {
+	Get(ctx context.Context) (*clustersmgmtv1.KubeletConfig, error)
+	Create(ctx context.Context, builder *clustersmgmtv1.KubeletConfigBuilder) (*clustersmgmtv1.KubeletConfig, error)
+	Update(ctx context.Context, builder *clustersmgmtv1.KubeletConfigBuilder) (*clustersmgmtv1.KubeletConfig, error)
+}
+
+// KubeletConfigRequest is an object that is unique to each reconciliation
+// req.
+type KubeletConfigRequest struct {
+	Context           context.Context
+	ControllerRequest ctrl.Request
+	Current           *ocmv1alpha1.KubeletConfig
+	Original          *ocmv1alpha1.KubeletConfig
+	Desired           *ocmv1alpha1.KubeletConfig
+	Trigger           triggers.Trigger
+	Reconciler        *Controller
+	OCMClient         kubeletConfigClient
+}
+
+func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
+	original := &ocmv1alpha1.KubeletConfig{}
+
+	// get the object (desired state) from the cluster
+	if err := r.Get(ctx, ctrlReq.NamespacedName, original); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return &KubeletConfigRequest{}, fmt.Errorf("unable to fetch cluster object - %w", err)
+		}
+
+		return &KubeletConfigRequest{}, err
+	}
+
+	// create the desired state of the request based on the inputs
+	desired := original.DeepCopy()
+	if desired.Spec.DisplayName == "" {
+		desired.Spec.DisplayName = desired.Name
+	}
+
+	return &KubeletConfigRequest{
+		Original:          original,
+		Desired:           desired,
+		ControllerRequest: ctrlReq,
+		Context:           ctx,
+		Trigger:           triggers.GetTrigger(original),
+		Reconciler:        r,
+	}, nil
+}
+
+// DefaultRequeue returns the default requeue time for a request.
+func (req *KubeletConfigRequest) DefaultRequeue() time.Duration {
+	return defaultKubeletConfigRequeue
+}
+
+// GetObject returns the original object to satisfy the controllers.Request interface.
+func (req *KubeletConfigRequest) GetObject() workload.Workload {
+	return req.Original
+}
+
+// GetName returns the name as it should appear in OCM.
+func (req *KubeletConfigRequest) GetName() string {
+	return req.Desired.Spec.DisplayName
+}
+
+// GetClusterName returns the cluster name that this object belongs to.
+func (req *KubeletConfigRequest) GetClusterName() string {
+	return req.Desired.Spec.ClusterName
+}
+
+// GetContext returns the context of the request.
+func (req *KubeletConfigRequest) GetContext() context.Context {
+	return req.Context
+}
+
+// GetReconciler returns the context of the request.
+func (req *KubeletConfigRequest) GetReconciler() kubernetes.Client {
+	return req.Reconciler
+}
+
+// SetClusterStatus sets the relevant cluster fields in the status.  It is used
+// to satisfy the request.Request interface.
+func (req *KubeletConfigRequest) SetClusterStatus(cluster *clustersmgmtv1.Cluster) {
+	if req.Original.Status.ClusterID == "" {
+		req.Original.Status.ClusterID = cluster.ID()
+	}
+
+	req.Original.Status.Hosted = cluster.Hypershift().Enabled()
+}
+
+// newOCMClient returns the client used to interact with the kubelet config in OCM based on the
+// type of the cluster.
+func (req *KubeletConfigRequest) newOCMClient() kubeletConfigClient {
+	if req.Original.Status.Hosted {
+		return ocm.NewKubeletConfigClient(
+			req.Reconciler.Connection,
+			req.Desired.Spec.DisplayName,
+			req.Original.Status.ClusterID,
+		)
+	}
+
+	return ocm.NewClusterKubeletConfigClient(req.Reconciler.Connection, req.Original.Status.ClusterID)
+}
+
+func (req *KubeletConfigRequest) desired() bool {
+	if req.Desired == nil || req.Current == nil {
+		return false
+	}
+
+	return reflect.DeepEqual(
+		req.Desired.Spec,
+		req.Current.Spec,
+	)
+}
+
+// referencingMachinePools returns the names of the machine pools which reference the kubelet
+// config.  A kubelet config may not be deleted while it is still referenced by a machine pool.
+func (req *KubeletConfigRequest) referencingMachinePools() ([]string, error) {
+	machinePools, err := (&ocmv1alpha1.MachinePool{}).FindAllByClusterID(
+		req.Context,
+		req.Reconciler,
+		req.Original.Status.ClusterID,
+	)
+	if err != nil {
+		return []string{}, err
+	}
+
+	names := []string{}
+
+	for i := range machinePools {
+		if machinePools[i].ReferencesKubeletConfig(req.Desired.Spec.DisplayName) {
+			names = append(names, fmt.Sprintf("%s/%s", machinePools[i].Namespace, machinePools[i].Name))
+		}
+	}
+
+	return names, nil
+}
+
+// deleteKubeletConfig deletes the kubelet config from OCM.
+func (req *KubeletConfigRequest) deleteKubeletConfig() error {
+	if !req.Original.Status.Hosted {
+		return ocm.NewClusterKubeletConfigClient(req.Reconciler.Connection, req.Original.Status.ClusterID).Delete(req.Context)
+	}
+
+	// return if the kubelet config was never created
+	if req.Original.Status.ConfigID == "" {
+		return nil
+	}
+
+	return ocm.NewKubeletConfigClient(
+		req.Reconciler.Connection,
+		req.Desired.Spec.DisplayName,
+		req.Original.Status.ClusterID,
+	).Delete(req.Context, req.Original.Status.ConfigID)
+}
@@ -0,0 +1,144 @@
+package kubeletconfig
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+)
+
+func TestKubeletConfigRequest_desired(t *testing.T) {
+	t.Parallel()
+
+	object := &ocmv1alpha1.KubeletConfig{
+		Spec: ocmv1alpha1.KubeletConfigSpec{
+			ClusterName:  "test",
+			DisplayName:  "test",
+			PodPidsLimit: 4096,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		current *ocmv1alpha1.KubeletConfig
+		desired *ocmv1alpha1.KubeletConfig
+		want    bool
+	}{
+		{
+			name:    "ensure equal objects reflect desired state",
+			current: object.DeepCopy(),
+			desired: object.DeepCopy(),
+			want:    true,
+		},
+		{
+			name:    "ensure changed pod pids limit does not reflect desired state",
+			current: object.DeepCopy(),
+			desired: func() *ocmv1alpha1.KubeletConfig {
+				desired := object.DeepCopy()
+				desired.Spec.PodPidsLimit = 8192
+
+				return desired
+			}(),
+			want: false,
+		},
+		{
+			name:    "ensure missing current state does not reflect desired state",
+			current: nil,
+			desired: object.DeepCopy(),
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			request := &KubeletConfigRequest{
+				Current: tt.current,
+				Desired: tt.desired,
+			}
+			if got := request.desired(); got != tt.want {
+				t.Errorf("KubeletConfigRequest.desired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKubeletConfigRequest_referencingMachinePools(t *testing.T) {
+	t.Parallel()
+
+	scheme := controllertest.NewScheme(t)
+
+	newMachinePool := func(name, clusterID string, kubeletConfigs ...string) client.Object {
+		return &ocmv1alpha1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: name},
+			Spec:       ocmv1alpha1.MachinePoolSpec{KubeletConfigs: kubeletConfigs},
+			Status:     ocmv1alpha1.MachinePoolStatus{ClusterID: clusterID},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		machinePools []client.Object
+		want         []string
+	}{
+		{
+			name:         "ensure unreferenced kubelet config may be deleted",
+			machinePools: []client.Object{newMachinePool("unreferenced", "cluster")},
+			want:         []string{},
+		},
+		{
+			name: "ensure references from other clusters do not block deletion",
+			machinePools: []client.Object{
+				newMachinePool("other", "other-cluster", "test"),
+			},
+			want: []string{},
+		},
+		{
+			name: "ensure referenced kubelet config is in use",
+			machinePools: []client.Object{
+				newMachinePool("referenced", "cluster", "test"),
+				newMachinePool("unreferenced", "cluster", "other"),
+			},
+			want: []string{"test/referenced"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			request := &KubeletConfigRequest{
+				Context: context.Background(),
+				Original: &ocmv1alpha1.KubeletConfig{
+					Status: ocmv1alpha1.KubeletConfigStatus{ClusterID: "cluster"},
+				},
+				Desired: &ocmv1alpha1.KubeletConfig{
+					Spec: ocmv1alpha1.KubeletConfigSpec{DisplayName: "test"},
+				},
+				Reconciler: &Controller{
+					Client: controllertest.NewClient(scheme, tt.machinePools...),
+				},
+			}
+
+			got, err := request.referencingMachinePools()
+			if err != nil {
+				t.Fatalf("KubeletConfigRequest.referencingMachinePools() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("KubeletConfigRequest.referencingMachinePools() = %v, want %v", got, tt.want)
+			}
+
+			if len(got) > 0 && !errors.Is(errKubeletConfigInUse(request, got), ErrKubeletConfigInUse) {
+				t.Errorf("errKubeletConfigInUse() does not wrap %v", ErrKubeletConfigInUse)
+			}
+		})
+	}
+}
@@ -22,10 +22,13 @@ import (
 
 	"github.com/go-logr/logr"
 	sdk "github.com/openshift-online/ocm-sdk-go"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
 	"github.com/rh-mobb/ocm-operator/controllers"
@@ -41,6 +44,12 @@ const (
 	defaultLDAPIdentityProviderRequeue = 30 * time.Second
 )
 
+// fields which are indexed to lookup the objects which reference a particular config map or secret.
+const (
+	bindPasswordField = ".spec.bindPassword.name"
+	caField           = ".spec.ca.name"
+)
+
 // Controller reconciles a LDAPIdentityProvider object.
 type Controller struct {
 	client.Client
@@ -128,8 +137,36 @@ func (r *Controller) Log() logr.Logger {
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	// index the referenced objects so that changes to them may trigger a reconciliation
+	// of the objects which reference them.
+	if err := controllers.IndexReferences(context.Background(), mgr.GetFieldIndexer(), &ocmv1alpha1.LDAPIdentityProvider{},
+		controllers.ReferenceIndex{
+			Field: bindPasswordField,
+			Extract: func(object client.Object) []string {
+				return []string{object.(*ocmv1alpha1.LDAPIdentityProvider).Spec.BindPassword.Name}
+			},
+		},
+		controllers.ReferenceIndex{
+			Field: caField,
+			Extract: func(object client.Object) []string {
+				return []string{object.(*ocmv1alpha1.LDAPIdentityProvider).Spec.CA.Name}
+			},
+		},
+	); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
-		WithEventFilter(workload.Predicates()).
-		For(&ocmv1alpha1.LDAPIdentityProvider{}).
+		For(&ocmv1alpha1.LDAPIdentityProvider{}, builder.WithPredicates(workload.Predicates())).
+		Watches(
+			&corev1.Secret{},
+			controllers.EnqueueReferencing(mgr.GetClient(), &ocmv1alpha1.LDAPIdentityProviderList{}, bindPasswordField),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
+		Watches(
+			&corev1.ConfigMap{},
+			controllers.EnqueueReferencing(mgr.GetClient(), &ocmv1alpha1.LDAPIdentityProviderList{}, caField),
+			builder.WithPredicates(predicate.ResourceVersionChangedPredicate{}),
+		).
 		Complete(r)
 }
@@ -43,3 +43,13 @@ func errGetCert(from *ocmv1alpha1.LDAPIdentityProvider) error {
 		ErrMissingCA,
 	)
 }
+
+// errUnableToUpdateStatusReferenceHash produces an error indicating the LDAP IDP status was unable
+// to be updated with the hash of the referenced data.
+func errUnableToUpdateStatusReferenceHash(request *LDAPIdentityProviderRequest, err error) (ctrl.Result, error) {
+	return requeue.OnError(request, fmt.Errorf(
+		"unable to update ldap identity provider [%s] status [referenceHash] - %w",
+		request.GetName(),
+		err,
+	))
+}
@@ -25,7 +25,7 @@ func (r *Controller) GetCurrentState(req *LDAPIdentityProviderRequest) (ctrl.Res
 		req.Original.Status.ClusterID,
 	)
 
-	idp, err := req.OCMClient.Get()
+	idp, err := req.OCMClient.Get(req.Context)
 	if err != nil {
 		return requeue.OnError(req, ocm.GetError(req, err))
 	}
@@ -65,7 +65,7 @@ func (r *Controller) ApplyIdentityProvider(req *LDAPIdentityProviderRequest) (ct
 	// create the identity provider if it does not exist
 	if req.Current == nil {
 		r.Logger.Info("creating ldap identity provider", request.LogValues(req)...)
-		idp, err := req.OCMClient.Create(builder)
+		idp, err := req.OCMClient.Create(req.Context, builder)
 		if err != nil {
 			return requeue.OnError(req, ocm.CreateError(req, err))
 		}
@@ -73,6 +73,7 @@ func (r *Controller) ApplyIdentityProvider(req *LDAPIdentityProviderRequest) (ct
 		// store the required provider data in the status
 		original := req.Original.DeepCopy()
 		req.Original.Status.ProviderID = idp.ID()
+		req.Original.Status.ReferenceHash = req.referenceHash()
 
 		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
 			return errUnableToUpdateStatusProviderID(req, idp.ID(), err)
@@ -86,11 +87,19 @@ func (r *Controller) ApplyIdentityProvider(req *LDAPIdentityProviderRequest) (ct
 
 	// update the identity provider if it does exist
 	r.Logger.Info("updating ldap identity provider", request.LogValues(req)...)
-	_, err := req.OCMClient.Update(builder)
+	_, err := req.OCMClient.Update(req.Context, builder)
 	if err != nil {
 		return requeue.OnError(req, ocm.UpdateError(req, err))
 	}
 
+	// store the hash of the applied referenced data in the status
+	original := req.Original.DeepCopy()
+	req.Original.Status.ReferenceHash = req.referenceHash()
+
+	if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+		return errUnableToUpdateStatusReferenceHash(req, err)
+	}
+
 	// create an event indicating that the ldap identity provider has been updated
 	events.RegisterAction(events.Updated, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
 
@@ -105,7 +114,7 @@ func (r *Controller) Destroy(req *LDAPIdentityProviderRequest) (ctrl.Result, err
 	}
 
 	// return if the cluster does not exist (has been deleted)
-	_, exists, err := ocm.ClusterExists(req.Desired.Spec.ClusterName, req.Reconciler.Connection)
+	_, exists, err := ocm.ClusterExists(req.Context, req.Desired.Spec.ClusterName, req.Reconciler.Connection)
 	if err != nil {
 		return requeue.OnError(req, err)
 	}
@@ -121,7 +130,7 @@ func (r *Controller) Destroy(req *LDAPIdentityProviderRequest) (ctrl.Result, err
 	)
 
 	// delete the object
-	if err := ocmClient.Delete(req.Original.Status.ProviderID); err != nil {
+	if err := ocmClient.Delete(req.Context, req.Original.Status.ProviderID); err != nil {
 		return requeue.OnError(req, ocm.DeleteError(req, err))
 	}
 
@@ -160,23 +160,21 @@ func (req *LDAPIdentityProviderRequest) desired() bool {
 		return false
 	}
 
-	// TODO: leave this in place as a reminder however we cannot get the current password
-	//        or CA data from the API likely due to security constraints, so we cannot
-	//        compare them.  this means that the fields can be updated at create time
-	//        but may not be updated (unless the object is changed by some other means)
-	//
-	// // ensure the passwords match
-	// if req.DesiredBindPassword != req.CurrentBindPassword {
-	// 	return false
-	// }
-	//
-	// // ensure the ca data matches
-	// if req.DesiredCA != req.CurrentCA {
-	// 	return false
-	// }
+	// ensure the referenced data has not changed since it was last applied.  we cannot
+	// get the current bind password or ca data from the api, likely due to security
+	// constraints, so we compare against the hash of the data we last applied.
+	if req.Original.Status.ReferenceHash != req.referenceHash() {
+		return false
+	}
 
 	return reflect.DeepEqual(
 		req.Desired.Spec,
 		req.Current.Spec,
 	)
 }
+
+// referenceHash returns the hash of the data from the secrets and config maps which are
+// referenced by the object.
+func (req *LDAPIdentityProviderRequest) referenceHash() string {
+	return kubernetes.Hash(req.DesiredBindPassword, req.DesiredCA)
+}
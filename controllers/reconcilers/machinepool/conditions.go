@@ -1,14 +1,26 @@
 package machinepool
 
 import (
+	"fmt"
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
 	"github.com/rh-mobb/ocm-operator/controllers/triggers"
 )
 
 const (
 	machinePoolConditionTypeDeleted = "MachinePoolDeleted"
 	machinePoolMessageDeleted       = "machine pool has been deleted from openshift cluster manager"
+
+	machinePoolConditionTypeNodesReady = "NodesReady"
+	machinePoolReasonNodesReady        = "Ready"
+	machinePoolReasonNodesWaiting      = "Waiting"
+	machinePoolReasonNodesTimedOut     = "TimedOut"
+	machinePoolMessageNodesReady       = "minimum number of nodes are ready"
+	machinePoolMessageNodesWaiting     = "waiting for minimum number of nodes to become ready"
+	machinePoolMessageNodesTimedOut    = "timed out after %s waiting for minimum number of nodes to become ready"
 )
 
 // MachinePoolDeleted return a condition indicating that the machine pool has
@@ -22,3 +34,55 @@ func MachinePoolDeleted() *metav1.Condition {
 		Message:            machinePoolMessageDeleted,
 	}
 }
+
+// NodesReady return a condition indicating that the minimum number of nodes of the machine
+// pool are ready.
+func NodesReady(generation int64) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               machinePoolConditionTypeNodesReady,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: generation,
+		Status:             metav1.ConditionTrue,
+		Reason:             machinePoolReasonNodesReady,
+		Message:            machinePoolMessageNodesReady,
+	}
+}
+
+// NodesWaiting return a condition indicating that the controller is waiting for the minimum
+// number of nodes of the machine pool to become ready.  The last transition time of this
+// condition represents when the wait began.
+func NodesWaiting(generation int64) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               machinePoolConditionTypeNodesReady,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: generation,
+		Status:             metav1.ConditionFalse,
+		Reason:             machinePoolReasonNodesWaiting,
+		Message:            machinePoolMessageNodesWaiting,
+	}
+}
+
+// NodesTimedOut return a condition indicating that the controller gave up waiting for the
+// minimum number of nodes of the machine pool to become ready.
+func NodesTimedOut(generation int64, timeout time.Duration) *metav1.Condition {
+	return &metav1.Condition{
+		Type:               machinePoolConditionTypeNodesReady,
+		LastTransitionTime: metav1.Now(),
+		ObservedGeneration: generation,
+		Status:             metav1.ConditionFalse,
+		Reason:             machinePoolReasonNodesTimedOut,
+		Message:            fmt.Sprintf(machinePoolMessageNodesTimedOut, timeout.String()),
+	}
+}
+
+// nodesCondition returns the existing condition which represents the readiness of the nodes of
+// the machine pool, or nil if it does not exist.
+func nodesCondition(machinePool *ocmv1alpha1.MachinePool) *metav1.Condition {
+	for i := range machinePool.Status.Conditions {
+		if machinePool.Status.Conditions[i].Type == machinePoolConditionTypeNodesReady {
+			return &machinePool.Status.Conditions[i]
+		}
+	}
+
+	return nil
+}
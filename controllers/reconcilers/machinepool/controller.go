@@ -34,11 +34,23 @@ import (
 	"github.com/rh-mobb/ocm-operator/controllers/requeue"
 	"github.com/rh-mobb/ocm-operator/controllers/triggers"
 	"github.com/rh-mobb/ocm-operator/controllers/workload"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
 	"github.com/rh-mobb/ocm-operator/pkg/ocm"
 )
 
 const (
-	defaultMachinePoolRequeue = 30 * time.Second
+	defaultMachinePoolRequeue     = 30 * time.Second
+	defaultMachinePoolWaitTimeout = 30 * time.Minute
+	minimumScheduleRequeue        = 5 * time.Second
+)
+
+// states of a machine pool which are reported in metrics.  machine pools do not have a state in
+// OpenShift Cluster Manager, so the state is derived from the machine pool and its nodes.
+const (
+	machinePoolStatePending  = "pending"
+	machinePoolStateScaling  = "scaling"
+	machinePoolStateReady    = "ready"
+	machinePoolStateTimedOut = "timedout"
 )
 
 // Controller reconciles a MachinePool object.
@@ -50,6 +62,10 @@ type Controller struct {
 	Recorder   record.EventRecorder
 	Interval   time.Duration
 	Logger     logr.Logger
+
+	// RemoteClients builds clients used to inspect the nodes of the machine pool when the
+	// controller is not running in the parent cluster of the machine pool.
+	RemoteClients *kubernetes.RemoteClientFactory
 }
 
 //+kubebuilder:rbac:groups=ocm.mobb.redhat.com,resources=machinepools,verbs=get;list;watch;create;update;patch;delete
@@ -87,9 +103,11 @@ func (r *Controller) ReconcileCreate(reconcileRequest request.Request) (ctrl.Res
 			)
 		}),
 		phases.NewPhase("GetCurrentState", func() (ctrl.Result, error) { return r.GetCurrentState(req) }),
+		phases.NewPhase("Schedule", func() (ctrl.Result, error) { return r.Schedule(req) }),
 		phases.NewPhase("Apply", func() (ctrl.Result, error) { return r.Apply(req) }),
+		phases.NewPhase("Upgrade", func() (ctrl.Result, error) { return r.Upgrade(req) }),
 		phases.NewPhase("WaitUntilReady", func() (ctrl.Result, error) { return r.WaitUntilReady(req) }),
-		phases.NewPhase("Complete", func() (ctrl.Result, error) { return phases.Complete(req, triggers.Create, r) }),
+		phases.NewPhase("Complete", func() (ctrl.Result, error) { return r.Complete(req) }),
 	).Execute()
 }
 
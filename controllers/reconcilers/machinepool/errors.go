@@ -1,14 +1,25 @@
 package machinepool
 
 import (
+	"errors"
 	"fmt"
+	"time"
 
+	"github.com/rh-mobb/ocm-operator/controllers/request"
 	"github.com/rh-mobb/ocm-operator/pkg/ocm"
 )
 
 var (
 	ErrMachinePoolNameLength    = fmt.Errorf("machine pool name exceeds maximum length of %d characters", maximumNameLength)
 	ErrMachinePoolReservedLabel = fmt.Errorf("problem with system reserved labels: %+v", ocm.ManagedLabels())
+
+	ErrMachinePoolInvalidSubnet           = request.NewValidationError("subnet does not belong to the cluster")
+	ErrMachinePoolInvalidAvailabilityZone = request.NewValidationError("availability zone does not belong to the cluster")
+	ErrMachinePoolHostedAvailabilityZones = request.NewValidationError("only a single availability zone is allowed for hosted control plane clusters")
+	ErrMachinePoolVersionExceedsCluster   = errors.New("machine pool version may not exceed the cluster version")
+	ErrMachinePoolVersionDowngrade        = request.NewValidationError("machine pool version may not be downgraded")
+	ErrMachinePoolWaitTimeout             = errors.New("timed out waiting for machine pool nodes to become ready")
+	ErrMachinePoolInvalidSchedule         = request.NewValidationError("invalid machine pool schedule")
 )
 
 // errMachinePoolCopy is an error indicating that the MachinePool object was unable to be
@@ -33,3 +44,52 @@ func errMachinePoolManagedLabels(request *MachinePoolRequest, err error) error {
 func errGetMachinePoolLabels(request *MachinePoolRequest, err error) error {
 	return fmt.Errorf("unable to get labeled nodes for machine pool [%s] - %w", request.GetName(), err)
 }
+
+// errListMachinePoolUpgrades is an error indicating that the upgrade policies of the MachinePool
+// were unable to be listed.
+func errListMachinePoolUpgrades(request *MachinePoolRequest, err error) error {
+	return fmt.Errorf("unable to list upgrade policies for machine pool [%s] - %w", request.GetName(), err)
+}
+
+// errUpgradeMachinePool is an error indicating that the MachinePool was unable to be upgraded.
+func errUpgradeMachinePool(request *MachinePoolRequest, version string, err error) error {
+	return fmt.Errorf("unable to upgrade machine pool [%s] to version [%s] - %w", request.GetName(), version, err)
+}
+
+// errUpdateMachinePoolUpgradeStatus is an error indicating that the upgrade status of the MachinePool
+// was unable to be updated.
+func errUpdateMachinePoolUpgradeStatus(request *MachinePoolRequest, err error) error {
+	return fmt.Errorf("unable to update upgrade status for machine pool [%s] - %w", request.GetName(), err)
+}
+
+// errUpdateMachinePoolNodesCondition is an error indicating that the condition representing the readiness
+// of the MachinePool nodes was unable to be updated.
+func errUpdateMachinePoolNodesCondition(request *MachinePoolRequest, err error) error {
+	return fmt.Errorf("unable to update nodes condition for machine pool [%s] - %w", request.GetName(), err)
+}
+
+// errMachinePoolWaitTimeout is an error indicating that the MachinePool nodes did not become ready
+// within the requested timeout.  It is terminal, as waiting again would restart the timeout.
+func errMachinePoolWaitTimeout(req *MachinePoolRequest, timeout time.Duration) error {
+	return &request.TerminalError{
+		Reason: machinePoolReasonNodesTimedOut,
+		Err: fmt.Errorf(
+			"machine pool [%s] not ready after [%s] - %w",
+			req.GetName(),
+			timeout.String(),
+			ErrMachinePoolWaitTimeout,
+		),
+	}
+}
+
+// errGetMachinePoolRemoteClient is an error indicating that the client used to access the parent
+// cluster of the MachinePool was unable to be retrieved.
+func errGetMachinePoolRemoteClient(request *MachinePoolRequest, err error) error {
+	return fmt.Errorf("unable to get remote client for parent cluster of machine pool [%s] - %w", request.GetName(), err)
+}
+
+// errUpdateMachinePoolScheduleStatus is an error indicating that the active schedule of the MachinePool
+// was unable to be updated.
+func errUpdateMachinePoolScheduleStatus(request *MachinePoolRequest, err error) error {
+	return fmt.Errorf("unable to update active schedule for machine pool [%s] - %w", request.GetName(), err)
+}
@@ -1,8 +1,10 @@
 package machinepool
 
 import (
+	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -11,9 +13,11 @@ import (
 	"github.com/rh-mobb/ocm-operator/controllers"
 	"github.com/rh-mobb/ocm-operator/controllers/conditions"
 	"github.com/rh-mobb/ocm-operator/controllers/events"
+	"github.com/rh-mobb/ocm-operator/controllers/metrics"
 	"github.com/rh-mobb/ocm-operator/controllers/phases"
 	"github.com/rh-mobb/ocm-operator/controllers/request"
 	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
 	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
 	"github.com/rh-mobb/ocm-operator/pkg/ocm"
 )
@@ -29,10 +33,10 @@ func (r *Controller) GetCurrentState(req *MachinePoolRequest) (ctrl.Result, erro
 
 	if req.Original.Status.Hosted {
 		poolClient := ocm.NewNodePoolClient(r.Connection, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
-		pool, err = poolClient.Get()
+		pool, err = poolClient.Get(req.Context)
 	} else {
 		poolClient := ocm.NewMachinePoolClient(r.Connection, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
-		pool, err = poolClient.Get()
+		pool, err = poolClient.Get(req.Context)
 	}
 
 	if err != nil {
@@ -42,6 +46,8 @@ func (r *Controller) GetCurrentState(req *MachinePoolRequest) (ctrl.Result, erro
 	// return if we did not find a machine pool.  this means that the machine pool does not
 	// exist and must be created in the CreateOrUpdate phase.
 	if reflect.ValueOf(pool).IsNil() {
+		metrics.MachinePoolStates.Set(req.Original, machinePoolStatePending)
+
 		return phases.Next()
 	}
 
@@ -55,12 +61,23 @@ func (r *Controller) GetCurrentState(req *MachinePoolRequest) (ctrl.Result, erro
 		}
 
 		err = req.Current.CopyFromNodePool(nodePool, req.Desired.Spec.ClusterName)
+		req.CurrentVersion = nodePool.Version().RawID()
+
+		// node pools report the number of nodes which they currently have
+		state := machinePoolStateReady
+		if nodePool.Status().CurrentReplicas() < req.Desired.MinimumReplicas() {
+			state = machinePoolStateScaling
+		}
+
+		metrics.MachinePoolStates.Set(req.Original, state)
 	} else {
 		machinePool, ok := pool.(*clustersmgmtv1.MachinePool)
 		if !ok {
 			return requeue.OnError(req, ocm.ErrConvertMachinePool)
 		}
 
+		// machine pools do not report the number of nodes which they currently have, so their
+		// state is only reported once their nodes are observed in the WaitUntilReady phase
 		err = req.Current.CopyFromMachinePool(machinePool, req.Desired.Spec.ClusterName)
 	}
 
@@ -78,6 +95,39 @@ func (r *Controller) GetCurrentState(req *MachinePoolRequest) (ctrl.Result, erro
 	return phases.Next()
 }
 
+// Schedule applies the number of nodes of the active schedule, if any, to the desired state and records
+// the active schedule in the status.  The number of nodes in the spec is used when no schedule is active.
+func (r *Controller) Schedule(req *MachinePoolRequest) (ctrl.Result, error) {
+	active, next, err := activeSchedule(req.Desired.Spec.Schedules, time.Now())
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	req.NextScheduleBoundary = next
+
+	original := req.Original.DeepCopy()
+	req.Original.Status.ActiveSchedule = ""
+
+	if active != nil {
+		req.Desired.Spec.MinimumNodesPerZone = active.MinimumNodesPerZone
+		req.Desired.Spec.MaximumNodesPerZone = active.MaximumNodesPerZone
+		req.Original.Status.ActiveSchedule = active.Name
+	}
+
+	if original.Status.ActiveSchedule != req.Original.Status.ActiveSchedule {
+		r.Logger.Info(
+			"active schedule changed",
+			append(request.LogValues(req), "schedule", req.Original.Status.ActiveSchedule)...,
+		)
+
+		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+			return requeue.OnError(req, errUpdateMachinePoolScheduleStatus(req, err))
+		}
+	}
+
+	return phases.Next()
+}
+
 // Apply will create an OpenShift Cluster Manager machine pool if it does not exist,
 // or update an OpenShift Cluster Manager machine pool if it does exist.
 //
@@ -117,6 +167,11 @@ func (r *Controller) Apply(req *MachinePoolRequest) (ctrl.Result, error) {
 	// if no machine pool exists, create it and return
 	//nolint:nestif
 	if req.Current == nil {
+		// ensure the requested placement is valid for the cluster
+		if err := req.validatePlacement(); err != nil {
+			return requeue.OnError(req, err)
+		}
+
 		var createErr error
 
 		r.Logger.Info("creating machine pool", request.LogValues(req)...)
@@ -166,17 +221,95 @@ func (r *Controller) Apply(req *MachinePoolRequest) (ctrl.Result, error) {
 	return phases.Next()
 }
 
+// Upgrade will upgrade the nodes of an OpenShift Cluster Manager node pool to the desired version
+// by scheduling a node pool upgrade policy.  It additionally reports the progress of an existing
+// upgrade in the status.  It is only relevant for clusters using a hosted control plane, as the nodes
+// of other clusters are upgraded with the cluster.
+func (r *Controller) Upgrade(req *MachinePoolRequest) (ctrl.Result, error) {
+	// return if we are not using a hosted control plane or if the node pool was created
+	// during this reconciliation, in which case it was created at the desired version
+	if !req.Original.Status.Hosted || req.Current == nil {
+		return phases.Next()
+	}
+
+	// ensure the requested version is valid for the cluster
+	if err := req.validateVersion(); err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	poolClient := ocm.NewNodePoolClient(
+		r.Connection,
+		req.Desired.Spec.DisplayName,
+		req.Original.Status.ClusterID,
+	)
+
+	policies, err := poolClient.ListUpgradePolicies(req.Context, req.Desired.Spec.DisplayName)
+	if err != nil {
+		return requeue.OnError(req, errListMachinePoolUpgrades(req, err))
+	}
+
+	original := req.Original.DeepCopy()
+	req.Original.Status.OpenShiftVersion = req.CurrentVersion
+
+	//nolint:nestif
+	if len(policies) > 0 {
+		// report the progress of the existing upgrade
+		req.Original.Status.Upgrade = ocmv1alpha1.MachinePoolUpgradeStatus{
+			Version:     policies[0].Version(),
+			State:       string(policies[0].State().Value()),
+			Description: policies[0].State().Description(),
+		}
+	} else if desiredVersion := req.desiredVersion(); desiredVersion != "" && req.CurrentVersion != "" {
+		comparison, err := ocm.CompareVersions(desiredVersion, req.CurrentVersion)
+		if err != nil {
+			return requeue.OnError(req, err)
+		}
+
+		// schedule an upgrade if the nodes are behind the desired version, otherwise
+		// clear the upgrade status as there is no upgrade in progress
+		if comparison > 0 {
+			r.Logger.Info("upgrading machine pool", append(request.LogValues(req), "version", desiredVersion)...)
+			policy, err := poolClient.Upgrade(req.Context, req.Desired.Spec.DisplayName, desiredVersion)
+			if err != nil {
+				return requeue.OnError(req, errUpgradeMachinePool(req, desiredVersion, err))
+			}
+
+			req.Original.Status.Upgrade = ocmv1alpha1.MachinePoolUpgradeStatus{
+				Version:     policy.Version(),
+				State:       string(policy.State().Value()),
+				Description: policy.State().Description(),
+			}
+
+			// create an event indicating that the machine pool upgrade has been scheduled
+			events.RegisterAction(events.Upgraded, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+		} else {
+			req.Original.Status.Upgrade = ocmv1alpha1.MachinePoolUpgradeStatus{}
+		}
+	}
+
+	if !reflect.DeepEqual(original.Status, req.Original.Status) {
+		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+			return requeue.OnError(req, errUpdateMachinePoolUpgradeStatus(req, err))
+		}
+	}
+
+	return phases.Next()
+}
+
 // Destroy will destroy an OpenShift Cluster Manager machine pool.
 //
 //nolint:forcetypeassert
 func (r *Controller) Destroy(req *MachinePoolRequest) (ctrl.Result, error) {
+	// stop reporting the state of the machine pool once it is being deleted
+	metrics.MachinePoolStates.Delete(req.Original)
+
 	// return immediately if we have already deleted the machine pool
 	if conditions.IsSet(MachinePoolDeleted(), req.Original) {
 		return phases.Next()
 	}
 
 	// return if the cluster does not exist (has been deleted)
-	_, exists, err := ocm.ClusterExists(req.Desired.Spec.ClusterName, req.Reconciler.Connection)
+	_, exists, err := ocm.ClusterExists(req.Context, req.Desired.Spec.ClusterName, req.Reconciler.Connection)
 	if err != nil {
 		return requeue.OnError(req, err)
 	}
@@ -229,35 +362,196 @@ func (r *Controller) Destroy(req *MachinePoolRequest) (ctrl.Result, error) {
 
 //+kubebuilder:rbac:groups=core,resources=nodes,verbs=get;list;watch
 //+kubebuilder:rbac:groups=core,resources=nodes/status,verbs=get;list;watch
+//+kubebuilder:rbac:groups=config.openshift.io,resources=clusterversions,verbs=get
 
 // The above allows us to retrieve the node status to see when the MachinePool is
-// ready.  This limits the controller to only running in the cluster in which
-// it is reconciling against and limits a centralized management solution for
-// MachinePools.
+// ready when the controller is running in the cluster in which it is reconciling
+// against.  The cluster version is used to determine whether this is the case.  When
+// the controller is running in a centralized management cluster, the nodes are retrieved
+// from the parent cluster with a remote client, if a kubeconfig is available.  Otherwise,
+// the current number of nodes is retrieved from OCM instead.
 //
 // See https://github.com/rh-mobb/ocm-operator/issues/1
 
 // WaitUntilReady will requeue until the reconciler determines that the current state of the
-// resource in the cluster is ready.
+// resource in the cluster is ready.  It gives up and sets a failure condition if the resource
+// does not become ready within the requested timeout.  The state of a machine pool of a classic
+// cluster is not reported unless its nodes are observed, as it is not reported by OCM.
 func (r *Controller) WaitUntilReady(req *MachinePoolRequest) (ctrl.Result, error) {
 	// skip the wait check if we are not requesting to wait for readiness
 	if !req.Original.Spec.Wait {
+		if !req.Original.Status.Hosted {
+			metrics.MachinePoolStates.Delete(req.Original)
+		}
+
 		return phases.Next()
 	}
 
-	nodes, err := kubernetes.GetLabeledNodes(req.Context, r, req.Desired.Spec.Labels)
+	ready, observable, err := r.readyReplicas(req)
 	if err != nil {
-		return requeue.OnError(req, (errGetMachinePoolLabels(req, err)))
+		return requeue.OnError(req, err)
 	}
 
-	// ensure all nodes are ready
-	if !kubernetes.NodesAreReady(nodes.Items...) {
-		return requeue.Retry(req)
+	// skip the wait check if we are unable to observe the nodes
+	if !observable {
+		metrics.MachinePoolStates.Delete(req.Original)
+
+		r.Logger.Info(
+			"unable to observe nodes for machine pool outside of cluster; skipping wait",
+			request.LogValues(req)...,
+		)
+
+		return phases.Next()
 	}
 
-	r.Logger.Info("nodes are ready", request.LogValues(req)...)
+	return r.waitForReplicas(req, ready)
+}
 
-	return phases.Next()
+// waitForReplicas requeues until the number of ready nodes of the machine pool reaches the minimum
+// number of replicas.  It gives up with a terminal error once the wait timeout has elapsed, so that
+// the wait is not retried until the object is changed.
+func (r *Controller) waitForReplicas(req *MachinePoolRequest, ready int) (ctrl.Result, error) {
+	generation := req.Original.GetGeneration()
+
+	// set the ready condition if we have the minimum number of ready nodes
+	required := req.Desired.MinimumReplicas()
+	if ready >= required {
+		if err := conditions.Update(req, NodesReady(generation)); err != nil {
+			return requeue.OnError(req, errUpdateMachinePoolNodesCondition(req, err))
+		}
+
+		metrics.MachinePoolStates.Set(req.Original, machinePoolStateReady)
+
+		r.Logger.Info("nodes are ready", request.LogValues(req)...)
+
+		return phases.Next()
+	}
+
+	r.Logger.Info(
+		"waiting for nodes to become ready",
+		append(request.LogValues(req), "ready", ready, "required", required)...,
+	)
+
+	// return the timeout error without restarting the wait if we have already timed out
+	// waiting for this generation of the object
+	timeout := req.waitTimeout()
+	existing := nodesCondition(req.Original)
+
+	if existing != nil && existing.Reason == machinePoolReasonNodesTimedOut && existing.ObservedGeneration == generation {
+		metrics.MachinePoolStates.Set(req.Original, machinePoolStateTimedOut)
+
+		return requeue.Skip(errMachinePoolWaitTimeout(req, timeout))
+	}
+
+	// give up if we have been waiting longer than the timeout
+	if existing != nil && existing.Reason == machinePoolReasonNodesWaiting && time.Since(existing.LastTransitionTime.Time) > timeout {
+		if err := conditions.Update(req, NodesTimedOut(generation, timeout)); err != nil {
+			return requeue.OnError(req, errUpdateMachinePoolNodesCondition(req, err))
+		}
+
+		metrics.MachinePoolStates.Set(req.Original, machinePoolStateTimedOut)
+
+		return requeue.Skip(errMachinePoolWaitTimeout(req, timeout))
+	}
+
+	// set the waiting condition.  this is only updated when we begin waiting, so the
+	// last transition time of the condition represents when the wait began.
+	if err := conditions.Update(req, NodesWaiting(generation)); err != nil {
+		return requeue.OnError(req, errUpdateMachinePoolNodesCondition(req, err))
+	}
+
+	metrics.MachinePoolStates.Set(req.Original, machinePoolStateScaling)
+
+	return requeue.Retry(req)
+}
+
+// readyReplicas returns the number of ready nodes of the machine pool.  If the nodes of the parent
+// cluster are accessible, the nodes are retrieved directly.  Otherwise, the current number of nodes
+// is retrieved from OCM, which is only possible for clusters using a hosted control plane.  It
+// returns false if the nodes are unable to be observed.
+func (r *Controller) readyReplicas(req *MachinePoolRequest) (int, bool, error) {
+	nodeClient, err := r.nodeClient(req)
+	if err != nil {
+		return 0, false, err
+	}
+
+	// retrieve the ready nodes directly if we are able to access the parent cluster
+	if nodeClient != nil {
+		nodes, err := kubernetes.GetLabeledNodes(req.Context, nodeClient, req.nodeLabels())
+		if err != nil {
+			return 0, false, errGetMachinePoolLabels(req, err)
+		}
+
+		return kubernetes.ReadyNodeCount(nodes.Items...), true, nil
+	}
+
+	// ocm does not expose the current number of nodes for a machine pool
+	if !req.Original.Status.Hosted {
+		return 0, false, nil
+	}
+
+	nodePool, err := ocm.NewNodePoolClient(
+		r.Connection,
+		req.Desired.Spec.DisplayName,
+		req.Original.Status.ClusterID,
+	).Get(req.Context)
+	if err != nil {
+		return 0, false, ocm.GetError(req, err)
+	}
+
+	if nodePool == nil {
+		return 0, true, nil
+	}
+
+	return nodePool.Status().CurrentReplicas(), true, nil
+}
+
+// nodeClient returns the client used to retrieve the nodes of the machine pool.  The client of the
+// controller is returned if the controller is running in the parent cluster.  Otherwise, a remote
+// client for the parent cluster is returned.  It returns nil if the nodes are not accessible.
+func (r *Controller) nodeClient(req *MachinePoolRequest) (kubernetes.Client, error) {
+	// the parent cluster is not retrieved when deleting, so we must retrieve it here
+	if req.ClusterExternalID == "" {
+		cluster, err := ocm.NewClusterClient(r.Connection, req.GetClusterName()).Get(req.Context)
+		if err != nil {
+			return nil, ocm.GetError(req, err)
+		}
+
+		// the nodes no longer exist if the parent cluster no longer exists
+		if cluster == nil {
+			return nil, nil
+		}
+
+		req.ClusterExternalID = cluster.ExternalID()
+	}
+
+	clusterID, err := kubernetes.GetClusterID(req.Context, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if clusterID != "" && clusterID == req.ClusterExternalID {
+		return r, nil
+	}
+
+	remote, err := controllers.RemoteClient(
+		req.Context,
+		r,
+		r.Connection,
+		r.RemoteClients,
+		req.Original.Namespace,
+		req.Original.Status.ClusterID,
+	)
+	if err != nil {
+		return nil, errGetMachinePoolRemoteClient(req, err)
+	}
+
+	// return an untyped nil so that callers may check for a nil interface
+	if remote == nil {
+		return nil, nil
+	}
+
+	return remote, nil
 }
 
 // WaitUntilMissing will requeue until the reconciler determines that the nodes
@@ -268,7 +562,22 @@ func (r *Controller) WaitUntilMissing(req *MachinePoolRequest) (ctrl.Result, err
 		return phases.Next()
 	}
 
-	nodes, err := kubernetes.GetLabeledNodes(req.Context, r, req.Desired.Spec.Labels)
+	nodeClient, err := r.nodeClient(req)
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	// skip the wait check if we are unable to observe the nodes
+	if nodeClient == nil {
+		r.Logger.Info(
+			"unable to observe nodes for machine pool outside of cluster; skipping wait",
+			request.LogValues(req)...,
+		)
+
+		return phases.Next()
+	}
+
+	nodes, err := kubernetes.GetLabeledNodes(req.Context, nodeClient, req.nodeLabels())
 	if err != nil {
 		return requeue.OnError(req, (errGetMachinePoolLabels(req, err)))
 	}
@@ -282,3 +591,27 @@ func (r *Controller) WaitUntilMissing(req *MachinePoolRequest) (ctrl.Result, err
 
 	return phases.Next()
 }
+
+// Complete will perform all actions required to successfully complete a create or update reconciliation
+// request.  If the active schedule may change before the reconciliation interval of the controller, the
+// reconciliation is requeued at the next schedule boundary instead.
+func (r *Controller) Complete(req *MachinePoolRequest) (ctrl.Result, error) {
+	result, err := phases.Complete(req, triggers.Create, r)
+	if err != nil || req.NextScheduleBoundary.IsZero() {
+		return result, err
+	}
+
+	// ensure we always requeue, even if the boundary has just passed
+	until := time.Until(req.NextScheduleBoundary)
+	if until < minimumScheduleRequeue {
+		until = minimumScheduleRequeue
+	}
+
+	if until >= result.RequeueAfter {
+		return result, nil
+	}
+
+	r.Logger.Info(fmt.Sprintf("reconciling again at next schedule boundary in %s", until), request.LogValues(req)...)
+
+	return requeue.After(until, nil)
+}
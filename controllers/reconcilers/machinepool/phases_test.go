@@ -0,0 +1,224 @@
+package machinepool
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/go-logr/logr"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlmetrics "sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/metrics"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+)
+
+func TestController_waitForReplicas(t *testing.T) {
+	t.Parallel()
+
+	const generation = 2
+
+	newMachinePool := func(condition *metav1.Condition) *ocmv1alpha1.MachinePool {
+		machinePool := &ocmv1alpha1.MachinePool{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "test", Generation: generation},
+			Spec: ocmv1alpha1.MachinePoolSpec{
+				DisplayName:        "test",
+				Wait:               true,
+				WaitTimeoutMinutes: 30,
+				DefaultMachinePoolFields: ocmv1alpha1.DefaultMachinePoolFields{
+					MinimumNodesPerZone: 2,
+				},
+			},
+			Status: ocmv1alpha1.MachinePoolStatus{Hosted: true},
+		}
+
+		if condition != nil {
+			machinePool.Status.Conditions = []metav1.Condition{*condition}
+		}
+
+		return machinePool
+	}
+
+	waitingSince := func(since time.Duration) *metav1.Condition {
+		condition := NodesWaiting(generation)
+		condition.LastTransitionTime = metav1.NewTime(time.Now().Add(-since))
+
+		return condition
+	}
+
+	tests := []struct {
+		name         string
+		existing     *metav1.Condition
+		ready        int
+		wantReason   string
+		wantRequeue  bool
+		wantTerminal bool
+	}{
+		{
+			name:       "ensure minimum ready nodes are ready",
+			existing:   waitingSince(time.Minute),
+			ready:      2,
+			wantReason: machinePoolReasonNodesReady,
+		},
+		{
+			name:        "ensure missing nodes begin waiting",
+			ready:       1,
+			wantReason:  machinePoolReasonNodesWaiting,
+			wantRequeue: true,
+		},
+		{
+			name:        "ensure missing nodes within the timeout continue waiting",
+			existing:    waitingSince(time.Minute),
+			ready:       1,
+			wantReason:  machinePoolReasonNodesWaiting,
+			wantRequeue: true,
+		},
+		{
+			name:         "ensure missing nodes after the timeout time out with a terminal error",
+			existing:     waitingSince(time.Hour),
+			ready:        1,
+			wantReason:   machinePoolReasonNodesTimedOut,
+			wantTerminal: true,
+		},
+		{
+			name:         "ensure timed out generation does not restart the wait",
+			existing:     NodesTimedOut(generation, 30*time.Minute),
+			ready:        1,
+			wantReason:   machinePoolReasonNodesTimedOut,
+			wantTerminal: true,
+		},
+		{
+			name: "ensure timed out previous generation restarts the wait",
+			existing: func() *metav1.Condition {
+				condition := NodesTimedOut(generation-1, 30*time.Minute)
+				condition.LastTransitionTime = metav1.NewTime(time.Now().Add(-time.Hour))
+
+				return condition
+			}(),
+			ready:       1,
+			wantReason:  machinePoolReasonNodesWaiting,
+			wantRequeue: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			machinePool := newMachinePool(tt.existing)
+			dependencies := controllertest.New(t, nil, machinePool.DeepCopy())
+
+			controller := &Controller{
+				Client: dependencies.Client,
+				Logger: dependencies.Logger,
+			}
+
+			req := &MachinePoolRequest{
+				Context:    context.Background(),
+				Original:   machinePool,
+				Desired:    machinePool.DeepCopy(),
+				Reconciler: controller,
+			}
+
+			result, err := controller.waitForReplicas(req, tt.ready)
+
+			if _, terminal := request.AsTerminal(err); terminal != tt.wantTerminal {
+				t.Fatalf("Controller.waitForReplicas() error = %v, want terminal %v", err, tt.wantTerminal)
+			}
+
+			if tt.wantTerminal && !errors.Is(err, ErrMachinePoolWaitTimeout) {
+				t.Errorf("Controller.waitForReplicas() error = %v, want %v", err, ErrMachinePoolWaitTimeout)
+			}
+
+			if !tt.wantTerminal && err != nil {
+				t.Fatalf("Controller.waitForReplicas() error = %v", err)
+			}
+
+			if result.Requeue != tt.wantRequeue {
+				t.Errorf("Controller.waitForReplicas() requeue = %v, want %v", result.Requeue, tt.wantRequeue)
+			}
+
+			condition := nodesCondition(req.Original)
+			if condition == nil || condition.Reason != tt.wantReason {
+				t.Errorf("Controller.waitForReplicas() condition = %+v, want reason %s", condition, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestController_WaitUntilReady(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		namespace string
+		hosted    bool
+		wantState bool
+	}{
+		{
+			name:      "ensure state of hosted machine pool is reported from ocm",
+			namespace: "wait-hosted",
+			hosted:    true,
+			wantState: true,
+		},
+		{
+			name:      "ensure state of classic machine pool with unobserved nodes is not reported",
+			namespace: "wait-classic",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			machinePool := &ocmv1alpha1.MachinePool{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tt.namespace, Name: "test"},
+				Status:     ocmv1alpha1.MachinePoolStatus{Hosted: tt.hosted},
+			}
+
+			// the state is set from ocm, or left over from before the machine pool was created
+			metrics.MachinePoolStates.Set(machinePool, machinePoolStateScaling)
+
+			controller := &Controller{Logger: logr.Discard()}
+			req := &MachinePoolRequest{
+				Context:    context.Background(),
+				Original:   machinePool,
+				Desired:    machinePool.DeepCopy(),
+				Reconciler: controller,
+			}
+
+			if _, err := controller.WaitUntilReady(req); err != nil {
+				t.Fatalf("Controller.WaitUntilReady() error = %v", err)
+			}
+
+			families, err := ctrlmetrics.Registry.Gather()
+			if err != nil {
+				t.Fatalf("unable to gather metrics - %v", err)
+			}
+
+			var reported bool
+			for _, family := range families {
+				if family.GetName() != "ocm_operator_machine_pool_state" {
+					continue
+				}
+
+				for _, metric := range family.GetMetric() {
+					for _, label := range metric.GetLabel() {
+						if label.GetName() == "namespace" && label.GetValue() == tt.namespace {
+							reported = true
+						}
+					}
+				}
+			}
+
+			if reported != tt.wantState {
+				t.Errorf("Controller.WaitUntilReady() state reported = %v, want %v", reported, tt.wantState)
+			}
+		})
+	}
+}
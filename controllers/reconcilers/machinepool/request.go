@@ -35,6 +35,22 @@ type MachinePoolRequest struct {
 	Desired           *ocmv1alpha1.MachinePool
 	Trigger           triggers.Trigger
 	Reconciler        *Controller
+
+	// ClusterVersion is the raw OpenShift version of the parent cluster (control plane),
+	// ClusterChannelGroup is the channel group of the parent cluster and CurrentVersion is the
+	// raw OpenShift version of the node pool as it exists in OCM.  These are only relevant for
+	// clusters using a hosted control plane.
+	ClusterVersion      string
+	ClusterChannelGroup string
+	CurrentVersion      string
+
+	// ClusterExternalID is the external ID of the parent cluster.  It is used to determine if the
+	// controller is running in the parent cluster.
+	ClusterExternalID string
+
+	// NextScheduleBoundary is the time at which the active schedule of the machine pool may
+	// next change.  It is zero if the machine pool has no schedules.
+	NextScheduleBoundary time.Time
 }
 
 func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
@@ -80,12 +96,28 @@ func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (requ
 		)
 	}
 
-	// if we have a hosted control plane, ensure that we ignore the aws
-	// configuration as it is invalid for a hosted control plane.  this is
-	// only relevant so that the desired state does not drift and constantly
-	// require an update.
+	// ensure that we ignore the aws configuration which is invalid for the type
+	// of cluster.  spot instances are invalid for a hosted control plane and instance
+	// metadata options are configured on the cluster when not using a hosted control
+	// plane.  this is only relevant so that the desired state does not drift and
+	// constantly require an update.
 	if desired.Status.Hosted {
-		desired.Spec.AWS = ocmv1alpha1.MachinePoolProviderAWS{}
+		desired.Spec.AWS.SpotInstances = ocmv1alpha1.MachinePoolProviderAWSSpotInstances{}
+	} else {
+		desired.Spec.AWS.InstanceMetadataHTTPTokens = ""
+	}
+
+	// ensure that we ignore the version, upgrade and node configuration for clusters which
+	// are not using a hosted control plane.  the nodes of these clusters always follow
+	// the version of the cluster and use the cluster-wide kubelet config and node drain
+	// grace period.
+	if !desired.Status.Hosted {
+		desired.Spec.OpenShiftVersion = ""
+		desired.Spec.Upgrade = ocmv1alpha1.MachinePoolUpgrade{}
+		desired.Spec.KubeletConfigs = nil
+		desired.Spec.TuningConfigs = nil
+		desired.Spec.NodeDrainGracePeriodMinutes = 0
+		desired.Spec.AutoRepair = nil
 	}
 
 	return &MachinePoolRequest{
@@ -144,6 +176,10 @@ func (req *MachinePoolRequest) SetClusterStatus(cluster *clustersmgmtv1.Cluster)
 	}
 
 	req.Original.Status.Hosted = cluster.Hypershift().Enabled()
+
+	req.ClusterVersion = cluster.Version().RawID()
+	req.ClusterChannelGroup = cluster.Version().ChannelGroup()
+	req.ClusterExternalID = cluster.ExternalID()
 }
 
 func (req *MachinePoolRequest) desired() bool {
@@ -151,9 +187,68 @@ func (req *MachinePoolRequest) desired() bool {
 		return false
 	}
 
-	// ignore the wait field as it is an internal field to the controller
-	// and does not represent the desired state of the machine pool
+	// ignore the wait fields as they are internal fields to the controller
+	// and do not represent the desired state of the machine pool
 	req.Current.Spec.Wait = req.Desired.Spec.Wait
+	req.Current.Spec.WaitTimeoutMinutes = req.Desired.Spec.WaitTimeoutMinutes
+
+	// ignore the schedules as they are internal fields to the controller.  the number of nodes
+	// of the active schedule has already been applied to the desired state.
+	req.Current.Spec.Schedules = req.Desired.Spec.Schedules
+
+	// ignore the placement fields as they are immutable and the current state
+	// returned from ocm is defaulted to the placement of the parent cluster when
+	// they are unset
+	req.Current.Spec.Subnet = req.Desired.Spec.Subnet
+	req.Current.Spec.AvailabilityZones = req.Desired.Spec.AvailabilityZones
+
+	// ignore the aws fields which are defaulted by ocm when they are unset
+	if req.Desired.Spec.AWS.RootVolumeSize == 0 {
+		req.Current.Spec.AWS.RootVolumeSize = 0
+	}
+
+	if req.Desired.Spec.AWS.InstanceMetadataHTTPTokens == "" {
+		req.Current.Spec.AWS.InstanceMetadataHTTPTokens = ""
+	}
+
+	if len(req.Desired.Spec.AWS.AdditionalSecurityGroupIDs) == 0 {
+		req.Current.Spec.AWS.AdditionalSecurityGroupIDs = req.Desired.Spec.AWS.AdditionalSecurityGroupIDs
+	}
+
+	if len(req.Desired.Spec.AWS.Tags) == 0 {
+		req.Current.Spec.AWS.Tags = req.Desired.Spec.AWS.Tags
+	}
+
+	// ignore the node fields which are defaulted by ocm when they are unset
+	if req.Desired.Spec.AutoRepair == nil {
+		req.Current.Spec.AutoRepair = nil
+	}
+
+	if req.Desired.Spec.NodeDrainGracePeriodMinutes == 0 {
+		req.Current.Spec.NodeDrainGracePeriodMinutes = 0
+	}
+
+	// ignore the difference between empty and unset configs, as ocm may return either
+	if len(req.Desired.Spec.KubeletConfigs) == 0 && len(req.Current.Spec.KubeletConfigs) == 0 {
+		req.Current.Spec.KubeletConfigs = req.Desired.Spec.KubeletConfigs
+	}
+
+	if len(req.Desired.Spec.TuningConfigs) == 0 && len(req.Current.Spec.TuningConfigs) == 0 {
+		req.Current.Spec.TuningConfigs = req.Desired.Spec.TuningConfigs
+	}
+
+	// ignore the version as it is not changed with an update but rather with an
+	// upgrade policy, and ignore the upgrade fields which are defaulted by ocm when
+	// they are unset
+	req.Current.Spec.OpenShiftVersion = req.Desired.Spec.OpenShiftVersion
+
+	if req.Desired.Spec.Upgrade.MaxSurge == "" {
+		req.Current.Spec.Upgrade.MaxSurge = ""
+	}
+
+	if req.Desired.Spec.Upgrade.MaxUnavailable == "" {
+		req.Current.Spec.Upgrade.MaxUnavailable = ""
+	}
 
 	return reflect.DeepEqual(
 		req.Desired.Spec,
@@ -161,9 +256,97 @@ func (req *MachinePoolRequest) desired() bool {
 	)
 }
 
+// validatePlacement validates the requested subnet and availability zones of the machine pool
+// against the subnets and availability zones of the parent cluster.
+func (req *MachinePoolRequest) validatePlacement() error {
+	if req.Desired.Spec.Subnet != "" && !contains(req.Original.Status.Subnets, req.Desired.Spec.Subnet) {
+		return fmt.Errorf(
+			"subnet [%s] not found in cluster subnets [%+v] - %w",
+			req.Desired.Spec.Subnet,
+			req.Original.Status.Subnets,
+			ErrMachinePoolInvalidSubnet,
+		)
+	}
+
+	for _, zone := range req.Desired.Spec.AvailabilityZones {
+		if !contains(req.Original.Status.AvailabilityZones, zone) {
+			return fmt.Errorf(
+				"availability zone [%s] not found in cluster availability zones [%+v] - %w",
+				zone,
+				req.Original.Status.AvailabilityZones,
+				ErrMachinePoolInvalidAvailabilityZone,
+			)
+		}
+	}
+
+	if req.Original.Status.Hosted && len(req.Desired.Spec.AvailabilityZones) > 1 {
+		return fmt.Errorf(
+			"requested availability zones [%+v] - %w",
+			req.Desired.Spec.AvailabilityZones,
+			ErrMachinePoolHostedAvailabilityZones,
+		)
+	}
+
+	return nil
+}
+
+// desiredVersion returns the desired version of the nodes in the node pool.  If a version is
+// not requested, the nodes follow the version of the cluster.
+func (req *MachinePoolRequest) desiredVersion() string {
+	if req.Desired.Spec.OpenShiftVersion != "" {
+		return req.Desired.Spec.OpenShiftVersion
+	}
+
+	return req.ClusterVersion
+}
+
+// validateVersion validates the desired version of the node pool against the version of the
+// cluster and the current version of the node pool.  The nodes may not exceed the version of
+// the control plane and may not be downgraded.
+func (req *MachinePoolRequest) validateVersion() error {
+	desired := req.desiredVersion()
+	if desired == "" {
+		return nil
+	}
+
+	if req.ClusterVersion != "" {
+		comparison, err := ocm.CompareVersions(desired, req.ClusterVersion)
+		if err != nil {
+			return err
+		}
+
+		if comparison > 0 {
+			return fmt.Errorf(
+				"requested version [%s] exceeds cluster version [%s] - %w",
+				desired,
+				req.ClusterVersion,
+				ErrMachinePoolVersionExceedsCluster,
+			)
+		}
+	}
+
+	if req.CurrentVersion != "" {
+		comparison, err := ocm.CompareVersions(desired, req.CurrentVersion)
+		if err != nil {
+			return err
+		}
+
+		if comparison < 0 {
+			return fmt.Errorf(
+				"requested version [%s] is less than current version [%s] - %w",
+				desired,
+				req.CurrentVersion,
+				ErrMachinePoolVersionDowngrade,
+			)
+		}
+	}
+
+	return nil
+}
+
 // createMachinePool creates a machine pool object in OCM.
 func (req *MachinePoolRequest) createMachinePool(poolClient *ocm.MachinePoolClient) error {
-	if _, err := poolClient.Create(req.Desired.MachinePoolBuilder()); err != nil {
+	if _, err := poolClient.Create(req.Context, req.Desired.MachinePoolBuilder()); err != nil {
 		return fmt.Errorf("unable to create machine pool - %w", err)
 	}
 
@@ -172,7 +355,25 @@ func (req *MachinePoolRequest) createMachinePool(poolClient *ocm.MachinePoolClie
 
 // createNodePool creates a node pool object in OCM (hosted control plane).
 func (req *MachinePoolRequest) createNodePool(poolClient *ocm.NodePoolClient) error {
-	if _, err := poolClient.Create(req.Desired.NodePoolBuilder()); err != nil {
+	builder := req.Desired.NodePoolBuilder()
+
+	// set the requested version of the node pool, otherwise ocm creates the node pool
+	// at the version of the cluster
+	if req.Desired.Spec.OpenShiftVersion != "" {
+		version, err := ocm.GetVersionObject(
+			req.Context,
+			req.Reconciler.Connection,
+			req.Desired.Spec.OpenShiftVersion,
+			req.ClusterChannelGroup,
+		)
+		if err != nil {
+			return fmt.Errorf("unable to get version [%s] - %w", req.Desired.Spec.OpenShiftVersion, err)
+		}
+
+		builder = builder.Version(clustersmgmtv1.NewVersion().ID(version.ID()))
+	}
+
+	if _, err := poolClient.Create(req.Context, builder); err != nil {
 		return fmt.Errorf("unable to create node pool - %w", err)
 	}
 
@@ -181,7 +382,7 @@ func (req *MachinePoolRequest) createNodePool(poolClient *ocm.NodePoolClient) er
 
 // updateMachinePool updates a machine pool object in OCM.
 func (req *MachinePoolRequest) updateMachinePool(poolClient *ocm.MachinePoolClient) error {
-	if _, err := poolClient.Update(req.Desired.MachinePoolBuilder()); err != nil {
+	if _, err := poolClient.Update(req.Context, req.Desired.MachinePoolBuilder()); err != nil {
 		return fmt.Errorf("unable to update machine pool - %w", err)
 	}
 
@@ -190,7 +391,7 @@ func (req *MachinePoolRequest) updateMachinePool(poolClient *ocm.MachinePoolClie
 
 // updateNodePool updates a node pool object in OCM.
 func (req *MachinePoolRequest) updateNodePool(poolClient *ocm.NodePoolClient) error {
-	if _, err := poolClient.Update(req.Desired.NodePoolBuilder()); err != nil {
+	if _, err := poolClient.Update(req.Context, req.Desired.NodePoolBuilder()); err != nil {
 		return fmt.Errorf("unable to update node pool - %w", err)
 	}
 
@@ -199,7 +400,7 @@ func (req *MachinePoolRequest) updateNodePool(poolClient *ocm.NodePoolClient) er
 
 // deleteMachinePool deletes a machine pool object in OCM.
 func (req *MachinePoolRequest) deleteMachinePool(poolClient *ocm.MachinePoolClient) error {
-	if err := poolClient.Delete(req.Desired.Spec.DisplayName); err != nil {
+	if err := poolClient.Delete(req.Context, req.Desired.Spec.DisplayName); err != nil {
 		return fmt.Errorf("unable to delete machine pool - %w", err)
 	}
 
@@ -208,9 +409,34 @@ func (req *MachinePoolRequest) deleteMachinePool(poolClient *ocm.MachinePoolClie
 
 // deleteNodePool deletes a node pool object in OCM.
 func (req *MachinePoolRequest) deleteNodePool(poolClient *ocm.NodePoolClient) error {
-	if err := poolClient.Delete(req.Desired.Spec.DisplayName); err != nil {
+	if err := poolClient.Delete(req.Context, req.Desired.Spec.DisplayName); err != nil {
 		return fmt.Errorf("unable to delete node pool - %w", err)
 	}
 
 	return nil
 }
+
+// waitTimeout returns the amount of time to wait for the nodes of the machine pool to become ready.
+func (req *MachinePoolRequest) waitTimeout() time.Duration {
+	if req.Original.Spec.WaitTimeoutMinutes < 1 {
+		return defaultMachinePoolWaitTimeout
+	}
+
+	return time.Duration(req.Original.Spec.WaitTimeoutMinutes) * time.Minute
+}
+
+// nodeLabels returns the labels which uniquely select the nodes of the machine pool.
+func (req *MachinePoolRequest) nodeLabels() map[string]string {
+	return map[string]string{ocm.LabelPrefixName: req.Desired.Spec.DisplayName}
+}
+
+// contains determines if a value exists in a list of values.
+func contains(values []string, value string) bool {
+	for i := range values {
+		if values[i] == value {
+			return true
+		}
+	}
+
+	return false
+}
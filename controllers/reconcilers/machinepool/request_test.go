@@ -1,6 +1,7 @@
 package machinepool
 
 import (
+	"errors"
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
@@ -58,6 +59,96 @@ func TestMachinePoolRequest_desired(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "ensure aws fields defaulted by ocm reflect desired state",
+			fields: fields{
+				Current: func() *ocmv1alpha1.MachinePool {
+					current := object.DeepCopy()
+					current.Spec.AWS.RootVolumeSize = 300
+					current.Spec.AWS.InstanceMetadataHTTPTokens = "optional"
+
+					return current
+				}(),
+				Desired: object.DeepCopy(),
+			},
+			want: true,
+		},
+		{
+			name: "ensure changed aws fields do not reflect desired state",
+			fields: fields{
+				Current: object.DeepCopy(),
+				Desired: func() *ocmv1alpha1.MachinePool {
+					desired := object.DeepCopy()
+					desired.Spec.AWS.AdditionalSecurityGroupIDs = []string{"sg-0123456789abcdef0"}
+
+					return desired
+				}(),
+			},
+			want: false,
+		},
+		{
+			name: "ensure node fields defaulted by ocm reflect desired state",
+			fields: fields{
+				Current: func() *ocmv1alpha1.MachinePool {
+					current := object.DeepCopy()
+					autoRepair := true
+					current.Spec.AutoRepair = &autoRepair
+					current.Spec.NodeDrainGracePeriodMinutes = 60
+
+					return current
+				}(),
+				Desired: object.DeepCopy(),
+			},
+			want: true,
+		},
+		{
+			name: "ensure disabled auto repair does not reflect desired state",
+			fields: fields{
+				Current: func() *ocmv1alpha1.MachinePool {
+					current := object.DeepCopy()
+					autoRepair := true
+					current.Spec.AutoRepair = &autoRepair
+
+					return current
+				}(),
+				Desired: func() *ocmv1alpha1.MachinePool {
+					desired := object.DeepCopy()
+					autoRepair := false
+					desired.Spec.AutoRepair = &autoRepair
+
+					return desired
+				}(),
+			},
+			want: false,
+		},
+		{
+			name: "ensure empty configs returned by ocm reflect desired state",
+			fields: fields{
+				Current: func() *ocmv1alpha1.MachinePool {
+					current := object.DeepCopy()
+					current.Spec.KubeletConfigs = []string{}
+					current.Spec.TuningConfigs = []string{}
+
+					return current
+				}(),
+				Desired: object.DeepCopy(),
+			},
+			want: true,
+		},
+		{
+			name: "ensure removed configs do not reflect desired state",
+			fields: fields{
+				Current: func() *ocmv1alpha1.MachinePool {
+					current := object.DeepCopy()
+					current.Spec.KubeletConfigs = []string{"test"}
+					current.Spec.TuningConfigs = []string{"test"}
+
+					return current
+				}(),
+				Desired: object.DeepCopy(),
+			},
+			want: false,
+		},
 	}
 
 	for _, tt := range tests {
@@ -74,3 +165,126 @@ func TestMachinePoolRequest_desired(t *testing.T) {
 		})
 	}
 }
+
+func TestMachinePoolRequest_validatePlacement(t *testing.T) {
+	t.Parallel()
+
+	status := ocmv1alpha1.MachinePoolStatus{
+		AvailabilityZones: []string{"us-east-1a", "us-east-1b", "us-east-1c"},
+		Subnets:           []string{"subnet-a", "subnet-b", "subnet-c"},
+	}
+
+	hostedStatus := *status.DeepCopy()
+	hostedStatus.Hosted = true
+
+	tests := []struct {
+		name    string
+		spec    ocmv1alpha1.MachinePoolSpec
+		status  ocmv1alpha1.MachinePoolStatus
+		wantErr error
+	}{
+		{
+			name:   "ensure unset placement is valid",
+			spec:   ocmv1alpha1.MachinePoolSpec{},
+			status: status,
+		},
+		{
+			name:   "ensure cluster subnet is valid",
+			spec:   ocmv1alpha1.MachinePoolSpec{Subnet: "subnet-b"},
+			status: status,
+		},
+		{
+			name:    "ensure subnet outside of cluster is invalid",
+			spec:    ocmv1alpha1.MachinePoolSpec{Subnet: "subnet-d"},
+			status:  status,
+			wantErr: ErrMachinePoolInvalidSubnet,
+		},
+		{
+			name:   "ensure cluster availability zones are valid",
+			spec:   ocmv1alpha1.MachinePoolSpec{AvailabilityZones: []string{"us-east-1a", "us-east-1c"}},
+			status: status,
+		},
+		{
+			name:    "ensure availability zone outside of cluster is invalid",
+			spec:    ocmv1alpha1.MachinePoolSpec{AvailabilityZones: []string{"us-east-1a", "us-east-1d"}},
+			status:  status,
+			wantErr: ErrMachinePoolInvalidAvailabilityZone,
+		},
+		{
+			name:    "ensure multiple availability zones are invalid for hosted control plane",
+			spec:    ocmv1alpha1.MachinePoolSpec{AvailabilityZones: []string{"us-east-1a", "us-east-1b"}},
+			status:  hostedStatus,
+			wantErr: ErrMachinePoolHostedAvailabilityZones,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			object := &ocmv1alpha1.MachinePool{Spec: tt.spec, Status: tt.status}
+			request := &MachinePoolRequest{
+				Original: object,
+				Desired:  object.DeepCopy(),
+			}
+			if err := request.validatePlacement(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("MachinePoolRequest.validatePlacement() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestMachinePoolRequest_validateVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		version        string
+		clusterVersion string
+		currentVersion string
+		wantErr        error
+	}{
+		{
+			name:           "ensure unset version follows the cluster",
+			clusterVersion: "4.14.5",
+			currentVersion: "4.14.1",
+		},
+		{
+			name:           "ensure version below the cluster version is valid",
+			version:        "4.14.3",
+			clusterVersion: "4.14.5",
+			currentVersion: "4.14.1",
+		},
+		{
+			name:           "ensure version above the cluster version is invalid",
+			version:        "4.15.0",
+			clusterVersion: "4.14.5",
+			currentVersion: "4.14.1",
+			wantErr:        ErrMachinePoolVersionExceedsCluster,
+		},
+		{
+			name:           "ensure version below the current version is invalid",
+			version:        "4.13.10",
+			clusterVersion: "4.14.5",
+			currentVersion: "4.14.1",
+			wantErr:        ErrMachinePoolVersionDowngrade,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			request := &MachinePoolRequest{
+				Desired: &ocmv1alpha1.MachinePool{
+					Spec: ocmv1alpha1.MachinePoolSpec{OpenShiftVersion: tt.version},
+				},
+				ClusterVersion: tt.clusterVersion,
+				CurrentVersion: tt.currentVersion,
+			}
+			if err := request.validateVersion(); !errors.Is(err, tt.wantErr) {
+				t.Errorf("MachinePoolRequest.validateVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
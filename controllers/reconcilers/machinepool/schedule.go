@@ -0,0 +1,39 @@
+package machinepool
+
+import (
+	"fmt"
+	"time"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/pkg/schedule"
+)
+
+// activeSchedule returns the first schedule which is active at the given time along with the time
+// of the next boundary at which the active schedule may change.  It returns a nil schedule if no
+// schedule is active and a zero time if there are no schedules.
+func activeSchedule(
+	schedules []ocmv1alpha1.MachinePoolSchedule,
+	now time.Time,
+) (*ocmv1alpha1.MachinePoolSchedule, time.Time, error) {
+	windows := make([]schedule.Window, len(schedules))
+
+	for i := range schedules {
+		windows[i] = schedule.Window{
+			Name:     schedules[i].Name,
+			Schedule: schedules[i].Schedule,
+			TimeZone: schedules[i].TimeZone,
+			Duration: time.Duration(schedules[i].DurationMinutes) * time.Minute,
+		}
+	}
+
+	active, next, err := schedule.Active(windows, now)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("%w - %w", ErrMachinePoolInvalidSchedule, err)
+	}
+
+	if active < 0 {
+		return nil, next, nil
+	}
+
+	return &schedules[active], next, nil
+}
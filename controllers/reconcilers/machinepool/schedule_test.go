@@ -0,0 +1,116 @@
+package machinepool
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/pkg/schedule"
+)
+
+// Test_activeSchedule tests the conversion of machine pool schedules to schedule windows.  The
+// evaluation of the windows is tested in the schedule package.
+func Test_activeSchedule(t *testing.T) {
+	t.Parallel()
+
+	// weeknights from 19:00 until 07:00 and weekends from friday 19:00 until monday 07:00
+	weeknights := ocmv1alpha1.MachinePoolSchedule{
+		Name:                "weeknights",
+		Schedule:            "0 19 * * 1-5",
+		TimeZone:            "America/New_York",
+		DurationMinutes:     12 * 60,
+		MinimumNodesPerZone: 0,
+	}
+
+	weekends := ocmv1alpha1.MachinePoolSchedule{
+		Name:                "weekends",
+		Schedule:            "0 19 * * 5",
+		TimeZone:            "America/New_York",
+		DurationMinutes:     60 * 60,
+		MinimumNodesPerZone: 1,
+	}
+
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("unable to load location - %v", err)
+	}
+
+	// 2023-06-05 is a monday
+	at := func(day, hour int) time.Time {
+		return time.Date(2023, time.June, day, hour, 0, 0, 0, location)
+	}
+
+	tests := []struct {
+		name      string
+		schedules []ocmv1alpha1.MachinePoolSchedule
+		now       time.Time
+		want      string
+		wantNext  time.Time
+		wantErr   []error
+	}{
+		{
+			name:      "ensure no schedules are inactive",
+			schedules: []ocmv1alpha1.MachinePoolSchedule{},
+			now:       at(5, 12),
+		},
+		{
+			name:      "ensure schedule outside of window is inactive",
+			schedules: []ocmv1alpha1.MachinePoolSchedule{weeknights},
+			now:       at(5, 12),
+			wantNext:  at(5, 19),
+		},
+		{
+			name:      "ensure duration in minutes is converted",
+			schedules: []ocmv1alpha1.MachinePoolSchedule{weeknights},
+			now:       at(5, 23),
+			want:      "weeknights",
+			wantNext:  at(6, 7),
+		},
+		{
+			name:      "ensure first active schedule is returned",
+			schedules: []ocmv1alpha1.MachinePoolSchedule{weekends, weeknights},
+			now:       at(9, 23),
+			want:      "weekends",
+			wantNext:  at(10, 7),
+		},
+		{
+			name: "ensure invalid schedule is an invalid machine pool schedule",
+			schedules: []ocmv1alpha1.MachinePoolSchedule{
+				{Name: "invalid", Schedule: "invalid", DurationMinutes: 60},
+			},
+			now:     at(5, 12),
+			wantErr: []error{ErrMachinePoolInvalidSchedule, schedule.ErrInvalidWindow},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			active, next, err := activeSchedule(tt.schedules, tt.now)
+			if (err != nil) != (len(tt.wantErr) > 0) {
+				t.Fatalf("activeSchedule() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			for _, wantErr := range tt.wantErr {
+				if !errors.Is(err, wantErr) {
+					t.Errorf("activeSchedule() error = %v, want %v", err, wantErr)
+				}
+			}
+
+			got := ""
+			if active != nil {
+				got = active.Name
+			}
+
+			if got != tt.want {
+				t.Errorf("activeSchedule() active = %v, want %v", got, tt.want)
+			}
+
+			if !next.Equal(tt.wantNext) {
+				t.Errorf("activeSchedule() next = %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
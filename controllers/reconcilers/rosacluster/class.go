@@ -0,0 +1,173 @@
+package rosacluster
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+)
+
+// classUpdateTimeout is the time after which a member cluster which is being updated no longer
+// counts towards the maximum number of concurrent updates of its cluster class.
+const classUpdateTimeout = 30 * time.Minute
+
+// classUpdates tracks the member clusters of each cluster class which are currently being
+// updated so that changes to a cluster class are rolled out to a limited number of member
+// clusters at a time.  A member cluster which does not reach its desired state within the
+// classUpdateTimeout keeps its slot, so that its update is still retried, but no longer blocks
+// the remaining member clusters of the class.
+type classUpdates struct {
+	mutex    sync.Mutex
+	now      func() time.Time
+	updating map[string]map[string]time.Time
+}
+
+func newClassUpdates() *classUpdates {
+	return &classUpdates{now: time.Now, updating: map[string]map[string]time.Time{}}
+}
+
+// acquire reserves an update slot of a cluster class for a member cluster.  It returns false if
+// the maximum number of member clusters of the class are already being updated.
+func (updates *classUpdates) acquire(class, cluster string, maximum int) bool {
+	updates.mutex.Lock()
+	defer updates.mutex.Unlock()
+
+	members := updates.updating[class]
+	if _, exists := members[cluster]; exists {
+		return true
+	}
+
+	active := 0
+	for _, since := range members {
+		if updates.now().Sub(since) < classUpdateTimeout {
+			active++
+		}
+	}
+
+	if active >= maximum {
+		return false
+	}
+
+	if members == nil {
+		members = map[string]time.Time{}
+		updates.updating[class] = members
+	}
+
+	members[cluster] = updates.now()
+
+	return true
+}
+
+// release frees the update slot of a cluster class held by a member cluster, if any.
+func (updates *classUpdates) release(class, cluster string) {
+	updates.mutex.Lock()
+	defer updates.mutex.Unlock()
+
+	delete(updates.updating[class], cluster)
+}
+
+// holds returns whether a member cluster holds an update slot of a cluster class which has not
+// timed out.
+func (updates *classUpdates) holds(class, cluster string) bool {
+	updates.mutex.Lock()
+	defer updates.mutex.Unlock()
+
+	since, exists := updates.updating[class][cluster]
+
+	return exists && updates.now().Sub(since) < classUpdateTimeout
+}
+
+// getClass retrieves the cluster class referenced by a cluster.  It returns nil if the cluster does
+// not reference a cluster class.
+func (r *Controller) getClass(ctx context.Context, cluster *ocmv1alpha1.ROSACluster) (*ocmv1alpha1.ROSAClusterClass, error) {
+	name := cluster.Spec.ClassRef.Name
+	if name == "" {
+		return nil, nil
+	}
+
+	class := &ocmv1alpha1.ROSAClusterClass{}
+	if err := r.Get(ctx, types.NamespacedName{Name: name}, class); err != nil {
+		// return a distinct error here to avoid the missing class being treated as
+		// a missing cluster by the reconciler
+		if apierrs.IsNotFound(err) {
+			return nil, errClassMissing(name)
+		}
+
+		return nil, fmt.Errorf("unable to fetch cluster class [%s] - %w", name, err)
+	}
+
+	return class, nil
+}
+
+// clustersForClass returns a reconcile request for each member cluster of a cluster class.  It is
+// used to roll out changes to a cluster class to its member clusters.
+func (r *Controller) clustersForClass(ctx context.Context, class client.Object) []reconcile.Request {
+	clusters, err := (&ocmv1alpha1.ROSACluster{}).FindAll(ctx, r)
+	if err != nil {
+		r.Logger.Error(err, fmt.Sprintf("unable to find member clusters of cluster class [%s]", class.GetName()))
+
+		return nil
+	}
+
+	requests := []reconcile.Request{}
+
+	for i := range clusters {
+		if clusters[i].Spec.ClassRef.Name != class.GetName() {
+			continue
+		}
+
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: clusters[i].Namespace,
+				Name:      clusters[i].Name,
+			},
+		})
+	}
+
+	return requests
+}
+
+// acquireClassUpdate reserves an update slot of the cluster class for the cluster.  It always
+// succeeds for clusters which do not reference a cluster class.
+func (req *ROSAClusterRequest) acquireClassUpdate() bool {
+	if req.Class == nil {
+		return true
+	}
+
+	return req.Reconciler.classUpdates.acquire(
+		req.Class.Name,
+		req.ControllerRequest.NamespacedName.String(),
+		req.Class.GetMaxConcurrentUpdates(),
+	)
+}
+
+// releaseClassUpdate frees the update slot of the cluster class held by the cluster, if any.
+func (req *ROSAClusterRequest) releaseClassUpdate() {
+	if req.Original.Spec.ClassRef.Name == "" {
+		return
+	}
+
+	req.Reconciler.classUpdates.release(
+		req.Original.Spec.ClassRef.Name,
+		req.ControllerRequest.NamespacedName.String(),
+	)
+}
+
+// holdsClassUpdate returns whether the cluster holds an update slot of its cluster class.
+func (req *ROSAClusterRequest) holdsClassUpdate() bool {
+	if req.Original.Spec.ClassRef.Name == "" {
+		return false
+	}
+
+	return req.Reconciler.classUpdates.holds(
+		req.Original.Spec.ClassRef.Name,
+		req.ControllerRequest.NamespacedName.String(),
+	)
+}
@@ -0,0 +1,105 @@
+package rosacluster
+
+import (
+	"testing"
+	"time"
+)
+
+func Test_classUpdates(t *testing.T) {
+	t.Parallel()
+
+	type acquire struct {
+		class   string
+		cluster string
+		after   time.Duration
+		release bool
+		expired bool
+		want    bool
+	}
+
+	tests := []struct {
+		name     string
+		maximum  int
+		acquires []acquire
+	}{
+		{
+			name:    "ensure clusters beyond the maximum must wait",
+			maximum: 1,
+			acquires: []acquire{
+				{class: "sandbox", cluster: "default/a", want: true},
+				{class: "sandbox", cluster: "default/b", want: false},
+			},
+		},
+		{
+			name:    "ensure cluster holding a slot may acquire it again",
+			maximum: 1,
+			acquires: []acquire{
+				{class: "sandbox", cluster: "default/a", want: true},
+				{class: "sandbox", cluster: "default/a", want: true},
+			},
+		},
+		{
+			name:    "ensure released slot may be acquired by another cluster",
+			maximum: 1,
+			acquires: []acquire{
+				{class: "sandbox", cluster: "default/a", want: true, release: true},
+				{class: "sandbox", cluster: "default/b", want: true},
+			},
+		},
+		{
+			name:    "ensure slots are limited per class",
+			maximum: 1,
+			acquires: []acquire{
+				{class: "sandbox", cluster: "default/a", want: true},
+				{class: "production", cluster: "default/b", want: true},
+			},
+		},
+		{
+			name:    "ensure clusters up to the maximum are updated at the same time",
+			maximum: 2,
+			acquires: []acquire{
+				{class: "sandbox", cluster: "default/a", want: true},
+				{class: "sandbox", cluster: "default/b", want: true},
+				{class: "sandbox", cluster: "default/c", want: false},
+			},
+		},
+		{
+			name:    "ensure cluster which has timed out no longer blocks other clusters",
+			maximum: 1,
+			acquires: []acquire{
+				{class: "sandbox", cluster: "default/a", want: true},
+				{class: "sandbox", cluster: "default/b", after: classUpdateTimeout - time.Minute, want: false},
+				{class: "sandbox", cluster: "default/b", after: time.Minute, want: true},
+				{class: "sandbox", cluster: "default/a", expired: true, want: true},
+				{class: "sandbox", cluster: "default/c", want: false},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Now()
+			updates := newClassUpdates()
+			updates.now = func() time.Time { return now }
+
+			for _, a := range tt.acquires {
+				now = now.Add(a.after)
+
+				if got := updates.acquire(a.class, a.cluster, tt.maximum); got != a.want {
+					t.Errorf("classUpdates.acquire(%s, %s) = %v, want %v", a.class, a.cluster, got, a.want)
+				}
+
+				if got := updates.holds(a.class, a.cluster); got != (a.want && !a.expired) {
+					t.Errorf("classUpdates.holds(%s, %s) = %v, want %v", a.class, a.cluster, got, a.want && !a.expired)
+				}
+
+				if a.release {
+					updates.release(a.class, a.cluster)
+				}
+			}
+		})
+	}
+}
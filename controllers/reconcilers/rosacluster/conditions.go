@@ -11,10 +11,18 @@ const (
 	rosaConditionTypeUpdated      = "ROSAClusterUpdated"
 	rosaConditionTypeUninstalling = "ROSAClusterUninstalling"
 	rosaConditionTypeDeleted      = "ROSAClusterDeleted"
+	rosaConditionTypeHibernated   = "ROSAClusterHibernated"
+	rosaConditionTypeProvisioned  = "ROSAClusterProvisioned"
+	rosaReasonProvisioned         = "Provisioned"
+	rosaReasonHibernated          = "Hibernated"
+	rosaReasonResumed             = "Resumed"
+	rosaMessageHibernated         = "rosa cluster has been requested to hibernate"
+	rosaMessageResumed            = "rosa cluster has been requested to resume"
 	rosaMessageCreated            = "rosa cluster has been created"
 	rosaMessageUpdated            = "rosa cluster has been updated"
 	rosaMessageUninstalling       = "rosa cluster has been deleted from openshift cluster manager and is uninstalling"
 	rosaMessageDeleted            = "rosa infrastructure has been deleted"
+	rosaMessageProvisioned        = "rosa cluster has finished provisioning and is ready"
 
 	awsConditionTypeOperatorRolesDeleted = "ROSAOperatorRolesDeleted"
 	awsMessageOperatorRolesDeleted       = "operator roles have been deleted from aws"
@@ -49,6 +57,18 @@ func ClusterUpdated() *metav1.Condition {
 	}
 }
 
+// ClusterProvisioned return a condition indicating that the ROSA Cluster has
+// finished provisioning and has become ready for the first time.
+func ClusterProvisioned() *metav1.Condition {
+	return &metav1.Condition{
+		Type:               rosaConditionTypeProvisioned,
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             rosaReasonProvisioned,
+		Message:            rosaMessageProvisioned,
+	}
+}
+
 // ClusterUninstalling return a condition indicating that the ROSA Cluster has
 // been deleted from OpenShift Cluster Manager and is uninstalling.
 func ClusterUninstalling() *metav1.Condition {
@@ -108,3 +128,27 @@ func OIDCConfigDeleted() *metav1.Condition {
 		Message:            oidcMessageConfigDeleted,
 	}
 }
+
+// ClusterHibernated return a condition indicating that the ROSA Cluster has
+// been requested to hibernate.
+func ClusterHibernated() *metav1.Condition {
+	return &metav1.Condition{
+		Type:               rosaConditionTypeHibernated,
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             rosaReasonHibernated,
+		Message:            rosaMessageHibernated,
+	}
+}
+
+// ClusterResumed return a condition indicating that the ROSA Cluster has
+// been requested to resume from hibernation.
+func ClusterResumed() *metav1.Condition {
+	return &metav1.Condition{
+		Type:               rosaConditionTypeHibernated,
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionFalse,
+		Reason:             rosaReasonResumed,
+		Message:            rosaMessageResumed,
+	}
+}
@@ -27,21 +27,24 @@ import (
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 
 	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
 	"github.com/rh-mobb/ocm-operator/controllers"
 	"github.com/rh-mobb/ocm-operator/controllers/phases"
 	"github.com/rh-mobb/ocm-operator/controllers/request"
 	"github.com/rh-mobb/ocm-operator/controllers/requeue"
-	"github.com/rh-mobb/ocm-operator/controllers/triggers"
 	"github.com/rh-mobb/ocm-operator/controllers/workload"
 	"github.com/rh-mobb/ocm-operator/pkg/aws"
+	"github.com/rh-mobb/ocm-operator/pkg/ratelimit"
 )
 
 const (
 	defaultClusterRequeue                     = 30 * time.Second
 	defaultClusterRequeueHostedPostProvision  = 60 * time.Second
 	defaultClusterRequeueClassicPostProvision = 300 * time.Second
+	defaultClusterRequeuePowerState           = 60 * time.Second
+	minimumScheduleRequeue                    = 5 * time.Second
 )
 
 // Controller reconciles a Cluster object.
@@ -54,12 +57,16 @@ type Controller struct {
 	Interval   time.Duration
 	Logger     logr.Logger
 
-	AWSClient *aws.Client
+	AWSClient     *aws.Client
+	AWSRateLimits *ratelimit.Limiters
+
+	classUpdates *classUpdates
 }
 
 //+kubebuilder:rbac:groups=ocm.mobb.redhat.com,resources=rosaclusters,verbs=get;list;watch;create;update;patch;delete
 //+kubebuilder:rbac:groups=ocm.mobb.redhat.com,resources=rosaclusters/status,verbs=get;update;patch
 //+kubebuilder:rbac:groups=ocm.mobb.redhat.com,resources=rosaclusters/finalizers,verbs=update
+//+kubebuilder:rbac:groups=ocm.mobb.redhat.com,resources=rosaclusterclasses,verbs=get;list;watch
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -85,8 +92,11 @@ func (r *Controller) ReconcileCreate(reconcileRequest request.Request) (ctrl.Res
 	return phases.NewHandler(req,
 		phases.NewPhase("GetCurrentState", func() (ctrl.Result, error) { return r.GetCurrentState(req) }),
 		phases.NewPhase("ApplyCluster", func() (ctrl.Result, error) { return r.ApplyCluster(req) }),
+		phases.NewPhase("ApplyPowerState", func() (ctrl.Result, error) { return r.ApplyPowerState(req) }),
+		phases.NewPhase("WaitUntilPowerState", func() (ctrl.Result, error) { return r.WaitUntilPowerState(req) }),
 		phases.NewPhase("WaitUntilReady", func() (ctrl.Result, error) { return r.WaitUntilReady(req) }),
-		phases.NewPhase("Complete", func() (ctrl.Result, error) { return phases.Complete(req, triggers.Create, r) }),
+		phases.NewPhase("Upgrade", func() (ctrl.Result, error) { return r.Upgrade(req) }),
+		phases.NewPhase("Complete", func() (ctrl.Result, error) { return r.Complete(req) }),
 	).Execute()
 }
 
@@ -132,7 +142,7 @@ func (r *Controller) Setup(reconcileRequest request.Request) (*ROSAClusterReques
 	// having to create the client multiple times for each reconcile
 	// request.
 	if r.AWSClient == nil {
-		awsClient, err := aws.NewClient(req.Desired.Spec.Region)
+		awsClient, err := aws.NewClient(req.Desired.Spec.Region, r.AWSRateLimits)
 		if err != nil {
 			return req, fmt.Errorf("unable to create aws client - %w", err)
 		}
@@ -155,9 +165,14 @@ func (r *Controller) Log() logr.Logger {
 }
 
 // SetupWithManager sets up the controller with the Manager.
+//
+// Changes to a cluster class are rolled out by reconciling each of its member clusters.
 func (r *Controller) SetupWithManager(mgr ctrl.Manager) error {
+	r.classUpdates = newClassUpdates()
+
 	return ctrl.NewControllerManagedBy(mgr).
 		WithEventFilter(workload.Predicates()).
 		For(&ocmv1alpha1.ROSACluster{}).
+		Watches(&ocmv1alpha1.ROSAClusterClass{}, handler.EnqueueRequestsFromMapFunc(r.clustersForClass)).
 		Complete(r)
 }
@@ -0,0 +1,34 @@
+package rosacluster
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+)
+
+var (
+	ErrClassMissing    = errors.New("cluster class does not exist")
+	ErrMissingUserRole = request.NewValidationError("iam.userRole must be set on the cluster or its cluster class")
+)
+
+// errClassMissing produces an error indicating the cluster class referenced by a cluster does
+// not exist.
+func errClassMissing(name string) error {
+	return fmt.Errorf("unable to find cluster class [%s] - %w", name, ErrClassMissing)
+}
+
+// errVersionUnresolved produces an error indicating the requested version of a cluster does not
+// match any available version.  It is terminal, as the available versions are not expected to
+// change until the requested version or channel group is changed.
+func errVersionUnresolved(constraint, channelGroup string, err error) error {
+	return &request.TerminalError{
+		Reason: request.ReasonInvalidConfiguration,
+		Err: fmt.Errorf(
+			"unable to resolve version [%s] in channel group [%s] - %w",
+			constraint,
+			channelGroup,
+			err,
+		),
+	}
+}
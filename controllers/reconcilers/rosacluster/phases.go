@@ -2,6 +2,8 @@ package rosacluster
 
 import (
 	"fmt"
+	"reflect"
+	"time"
 
 	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -10,10 +12,13 @@ import (
 	"github.com/rh-mobb/ocm-operator/controllers"
 	"github.com/rh-mobb/ocm-operator/controllers/conditions"
 	"github.com/rh-mobb/ocm-operator/controllers/events"
+	"github.com/rh-mobb/ocm-operator/controllers/metrics"
 	"github.com/rh-mobb/ocm-operator/controllers/phases"
 	"github.com/rh-mobb/ocm-operator/controllers/request"
 	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
 	"github.com/rh-mobb/ocm-operator/controllers/workload"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
 	"github.com/rh-mobb/ocm-operator/pkg/ocm"
 )
 
@@ -21,10 +26,16 @@ import (
 // is stored in OpenShift Cluster Manager.  It will be compared against the desired state which exists
 // within the OpenShift cluster in which this controller is reconciling against.
 func (r *Controller) GetCurrentState(req *ROSAClusterRequest) (ctrl.Result, error) {
+	// set the version.  this is not done when the request is created so that a version which may not
+	// be resolved fails the cluster rather than preventing it from being deleted.
+	if err := req.setVersion(); err != nil {
+		return requeue.OnError(req, fmt.Errorf("unable to determine openshift version - %w", err))
+	}
+
 	// retrieve the cluster
 	req.OCMClient = ocm.NewClusterClient(req.Reconciler.Connection, req.Desired.Spec.DisplayName)
 
-	cluster, err := req.OCMClient.Get()
+	cluster, err := req.OCMClient.Get(req.Context)
 	if err != nil {
 		return requeue.OnError(req, fmt.Errorf(
 			"unable to retrieve cluster from ocm [name=%s] - %w",
@@ -44,6 +55,11 @@ func (r *Controller) GetCurrentState(req *ROSAClusterRequest) (ctrl.Result, erro
 	req.Current.CopyFrom(cluster)
 	req.Cluster = cluster
 
+	metrics.ClusterStates.Set(req.Original, string(cluster.State()))
+
+	// ensure changes to the cluster class only apply to fields which may be updated
+	req.inheritProvisioned()
+
 	return phases.Next()
 }
 
@@ -80,11 +96,30 @@ func (r *Controller) ApplyCluster(req *ROSAClusterRequest) (ctrl.Result, error)
 	if req.desired() {
 		req.Log.V(controllers.LogLevelDebug).Info("rosa cluster already in desired state", request.LogValues(req)...)
 
+		// the update is complete, so allow the next member of the cluster class to update
+		req.releaseClassUpdate()
+
 		return phases.Next()
 	}
 
-	// update the existing rosa cluster
+	// limit the number of members of the cluster class which are updated at the same time
+	if !req.acquireClassUpdate() {
+		req.Log.Info(fmt.Sprintf(
+			"waiting for other members of cluster class [%s] to finish updating",
+			req.Class.Name,
+		), request.LogValues(req)...)
+		req.Log.Info(fmt.Sprintf("checking again in %s", defaultClusterRequeue.String()), request.LogValues(req)...)
+
+		return requeue.After(defaultClusterRequeue, nil)
+	}
+
+	// update the existing rosa cluster.  a cluster which may not be updated without a change to
+	// it must not hold up the remaining members of the cluster class.
 	if err := req.updateCluster(); err != nil {
+		if _, terminal := request.AsTerminal(err); terminal {
+			req.releaseClassUpdate()
+		}
+
 		return requeue.OnError(req, fmt.Errorf(
 			"error in updateCluster - %w",
 			err,
@@ -99,6 +134,117 @@ func (r *Controller) ApplyCluster(req *ROSAClusterRequest) (ctrl.Result, error)
 	return phases.Next()
 }
 
+// ApplyPowerState hibernates or resumes the cluster in OCM so that it matches the desired power state.  The
+// cluster is hibernated while a hibernation schedule is active, regardless of the spec.powerState field.
+//
+//nolint:exhaustive
+func (r *Controller) ApplyPowerState(req *ROSAClusterRequest) (ctrl.Result, error) {
+	activeSchedule, err := req.setPowerState(time.Now())
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	// record the active hibernation schedule
+	if req.Original.Status.ActiveHibernationSchedule != activeSchedule {
+		original := req.Original.DeepCopy()
+		req.Original.Status.ActiveHibernationSchedule = activeSchedule
+
+		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+			return requeue.OnError(req, fmt.Errorf("unable to update status activeHibernationSchedule=%s - %w", activeSchedule, err))
+		}
+	}
+
+	// return if the cluster was not yet created prior to this reconciliation
+	if req.Cluster == nil {
+		return phases.Next()
+	}
+
+	switch req.Cluster.State() {
+	case clustersmgmtv1.ClusterStateReady:
+		if req.PowerState != ocmv1alpha1.ROSAClusterPowerStateHibernating {
+			return phases.Next()
+		}
+
+		req.Log.Info("hibernating cluster", request.LogValues(req)...)
+
+		if err := req.OCMClient.Hibernate(req.Context, req.Cluster.ID()); err != nil {
+			return requeue.OnError(req, fmt.Errorf("unable to hibernate cluster - %w", err))
+		}
+
+		// send a notification that the cluster has been hibernated
+		if err := req.notify(events.Hibernated, ClusterHibernated(), rosaConditionTypeHibernated); err != nil {
+			return requeue.OnError(req, fmt.Errorf("error sending cluster hibernated notification - %w", err))
+		}
+	case clustersmgmtv1.ClusterStateHibernating:
+		if req.PowerState != ocmv1alpha1.ROSAClusterPowerStateRunning {
+			return phases.Next()
+		}
+
+		req.Log.Info("resuming cluster", request.LogValues(req)...)
+
+		if err := req.OCMClient.Resume(req.Context, req.Cluster.ID()); err != nil {
+			return requeue.OnError(req, fmt.Errorf("unable to resume cluster - %w", err))
+		}
+
+		// send a notification that the cluster has been resumed
+		if err := req.notify(events.Resumed, ClusterResumed(), rosaConditionTypeHibernated); err != nil {
+			return requeue.OnError(req, fmt.Errorf("error sending cluster resumed notification - %w", err))
+		}
+	}
+
+	return phases.Next()
+}
+
+// WaitUntilPowerState will requeue until the reconciler determines that the cluster has reached the
+// desired power state.  Clusters which are still being provisioned are handled by the WaitUntilReady
+// phase.
+//
+//nolint:exhaustive
+func (r *Controller) WaitUntilPowerState(req *ROSAClusterRequest) (ctrl.Result, error) {
+	// return if the cluster was not yet created prior to this reconciliation
+	if req.Cluster == nil {
+		return phases.Next()
+	}
+
+	var powerState string
+
+	switch req.Cluster.State() {
+	case clustersmgmtv1.ClusterStateReady:
+		powerState = ocmv1alpha1.ROSAClusterPowerStateRunning
+	case clustersmgmtv1.ClusterStateHibernating:
+		powerState = ocmv1alpha1.ROSAClusterPowerStateHibernating
+	case clustersmgmtv1.ClusterStatePoweringDown, clustersmgmtv1.ClusterStateResuming:
+		req.Log.Info(fmt.Sprintf("cluster with state [%s] is changing power state", req.Cluster.State()), request.LogValues(req)...)
+		req.Log.Info(fmt.Sprintf("checking again in %s", defaultClusterRequeuePowerState.String()), request.LogValues(req)...)
+
+		return requeue.After(defaultClusterRequeuePowerState, nil)
+	default:
+		return phases.Next()
+	}
+
+	// requeue if we have just requested a change in power state
+	if powerState != req.PowerState {
+		req.Log.Info(
+			fmt.Sprintf("waiting for cluster to change power state from [%s] to [%s]", powerState, req.PowerState),
+			request.LogValues(req)...,
+		)
+
+		return requeue.After(defaultClusterRequeuePowerState, nil)
+	}
+
+	// record the power state that the cluster has reached
+	if req.Original.Status.PowerState != powerState {
+		original := req.Original.DeepCopy()
+		req.Original.Status.PowerState = powerState
+
+		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+			return requeue.OnError(req, fmt.Errorf("unable to update status powerState=%s - %w", powerState, err))
+		}
+	}
+
+	return phases.Next()
+}
+
 // FindChildObjects finds all of the child objects related to this cluster.  This is intended to run during the delete
 // workflow and will return a requeue if any child objects are found.  This is to prevent deletion of the cluster while
 // objects are still attached, which leaves the controller spamming error messages.
@@ -106,9 +252,17 @@ func (r *Controller) FindChildObjects(req *ROSAClusterRequest) (ctrl.Result, err
 	// loop through each of our children types and ensure we have no remaining objects based on the
 	// status of the cluster id
 	for _, object := range []workload.ClusterChild{
+		&ocmv1alpha1.AddOn{},
+		&ocmv1alpha1.ClusterAutoscaler{},
+		&ocmv1alpha1.ClusterGroupMembership{},
 		&ocmv1alpha1.GitLabIdentityProvider{},
+		&ocmv1alpha1.GoogleIdentityProvider{},
+		&ocmv1alpha1.HTPasswdIdentityProvider{},
+		&ocmv1alpha1.Ingress{},
+		&ocmv1alpha1.KubeletConfig{},
 		&ocmv1alpha1.LDAPIdentityProvider{},
 		&ocmv1alpha1.MachinePool{},
+		&ocmv1alpha1.TuningConfig{},
 	} {
 		exists, err := object.ExistsForClusterID(req.Context, req.Reconciler, req.Original.Status.ClusterID)
 		if err != nil {
@@ -149,7 +303,7 @@ func (r *Controller) DestroyCluster(req *ROSAClusterRequest) (ctrl.Result, error
 	req.Log.Info("deleting cluster", request.LogValues(req)...)
 	req.OCMClient = ocm.NewClusterClient(req.Reconciler.Connection, req.Desired.Spec.DisplayName)
 
-	if err := req.OCMClient.Delete(req.Original.Status.ClusterID); err != nil {
+	if err := req.OCMClient.Delete(req.Context, req.Original.Status.ClusterID); err != nil {
 		return requeue.OnError(req, fmt.Errorf(
 			"unable to delete cluster with id [%s] from ocm - %w",
 			req.Original.Status.ClusterID,
@@ -174,8 +328,11 @@ func (r *Controller) WaitUntilMissing(req *ROSAClusterRequest) (ctrl.Result, err
 		return phases.Next()
 	}
 
+	// a deleted cluster no longer needs to be updated
+	req.releaseClassUpdate()
+
 	// retrieve the cluster and return if it does not exist (has been deleted)
-	cluster, exists, err := ocm.ClusterExists(req.Desired.Spec.DisplayName, req.Reconciler.Connection)
+	cluster, exists, err := ocm.ClusterExists(req.Context, req.Desired.Spec.DisplayName, req.Reconciler.Connection)
 	if err != nil {
 		return requeue.OnError(req, fmt.Errorf(
 			"unable to retrieve cluster from ocm [name=%s] - %w",
@@ -190,6 +347,8 @@ func (r *Controller) WaitUntilMissing(req *ROSAClusterRequest) (ctrl.Result, err
 
 	// set the deleted condition and return if we have no cluster
 	if cluster == nil {
+		metrics.ClusterStates.Delete(req.Original)
+
 		if err := conditions.Update(req, ClusterDeleted()); err != nil {
 			return requeue.OnError(req, fmt.Errorf("error updating deleted condition - %w", err))
 		}
@@ -199,6 +358,8 @@ func (r *Controller) WaitUntilMissing(req *ROSAClusterRequest) (ctrl.Result, err
 		return phases.Next()
 	}
 
+	metrics.ClusterStates.Set(req.Original, string(cluster.State()))
+
 	// return if we are still uninstalling
 	switch cluster.State() {
 	case clustersmgmtv1.ClusterStateUninstalling:
@@ -251,7 +412,7 @@ func (r *Controller) DestroyOIDC(req *ROSAClusterRequest) (ctrl.Result, error) {
 		req.Log.Info("deleting oidc provider", request.LogValues(req)...)
 		if err := ocm.NewOIDCConfigClient(
 			req.Reconciler.Connection,
-		).Delete(req.Original.Status.OIDCConfigID); err != nil {
+		).Delete(req.Context, req.Original.Status.OIDCConfigID); err != nil {
 			return requeue.OnError(req, fmt.Errorf(
 				"unable to delete oidc provider - %w",
 				err,
@@ -267,7 +428,7 @@ func (r *Controller) DestroyOIDC(req *ROSAClusterRequest) (ctrl.Result, error) {
 	// only destroy the oidc configuration if we have not already done so
 	if !conditions.IsSet(OIDCConfigDeleted(), req.Original) {
 		req.Log.Info("deleting oidc config", request.LogValues(req)...)
-		if err := req.Reconciler.AWSClient.DeleteOIDCProvider(req.Original.Status.OIDCProviderARN); err != nil {
+		if err := req.Reconciler.AWSClient.DeleteOIDCProvider(req.Context, req.Original.Status.OIDCProviderARN); err != nil {
 			return requeue.OnError(req, fmt.Errorf(
 				"unable to delete oidc config - %w",
 				err,
@@ -289,9 +450,17 @@ func (r *Controller) DestroyOIDC(req *ROSAClusterRequest) (ctrl.Result, error) {
 //nolint:exhaustive
 func (r *Controller) WaitUntilReady(req *ROSAClusterRequest) (ctrl.Result, error) {
 	switch req.Cluster.State() {
+	case clustersmgmtv1.ClusterStateHibernating:
+		req.Log.Info("cluster is hibernating", request.LogValues(req)...)
+
+		return phases.Next()
 	case clustersmgmtv1.ClusterStateReady:
 		req.Log.Info("cluster is ready", request.LogValues(req)...)
 
+		if err := req.recordProvisioned(); err != nil {
+			return requeue.OnError(req, fmt.Errorf("error updating provisioned condition - %w", err))
+		}
+
 		return phases.Next()
 	case clustersmgmtv1.ClusterStateError:
 		req.Log.Error(fmt.Errorf("cluster has error state"), fmt.Sprintf(
@@ -306,3 +475,108 @@ func (r *Controller) WaitUntilReady(req *ROSAClusterRequest) (ctrl.Result, error
 		return requeue.After(req.provisionRequeueTime(), nil)
 	}
 }
+
+// Upgrade schedules an upgrade of the cluster in OCM if the desired version is newer than the version
+// which the cluster is running, and reports the progress of any upgrade which is in progress.
+func (r *Controller) Upgrade(req *ROSAClusterRequest) (ctrl.Result, error) {
+	// only upgrade clusters which existed prior to this reconciliation and are ready
+	if req.Cluster == nil || req.Cluster.State() != clustersmgmtv1.ClusterStateReady {
+		return phases.Next()
+	}
+
+	upgrade, err := req.OCMClient.GetUpgrade(req.Context, req.Original.Status.ClusterID, req.Desired.Spec.HostedControlPlane)
+	if err != nil {
+		return requeue.OnError(req, fmt.Errorf("unable to retrieve cluster upgrade - %w", err))
+	}
+
+	original := req.Original.DeepCopy()
+	req.Original.Status.CurrentOpenShiftVersion = req.Cluster.Version().RawID()
+
+	//nolint:nestif
+	if upgrade != nil {
+		// report the progress of the existing upgrade
+		req.Original.Status.Upgrade = ocmv1alpha1.ROSAClusterUpgradeStatus{
+			Version:     upgrade.Version,
+			State:       upgrade.State,
+			Description: upgrade.Description,
+		}
+	} else {
+		comparison, err := ocm.CompareVersions(req.Desired.Spec.OpenShiftVersion, req.Original.Status.CurrentOpenShiftVersion)
+		if err != nil {
+			return requeue.OnError(req, err)
+		}
+
+		// schedule an upgrade if the cluster is behind the desired version, otherwise
+		// clear the upgrade status as there is no upgrade in progress
+		if comparison > 0 {
+			req.Log.Info("upgrading cluster", append(request.LogValues(req), "version", req.Desired.Spec.OpenShiftVersion)...)
+
+			upgrade, err := req.OCMClient.Upgrade(
+				req.Context,
+				req.Original.Status.ClusterID,
+				req.Desired.Spec.OpenShiftVersion,
+				req.Desired.Spec.HostedControlPlane,
+			)
+			if err != nil {
+				return requeue.OnError(req, fmt.Errorf(
+					"unable to upgrade cluster to version [%s] - %w",
+					req.Desired.Spec.OpenShiftVersion,
+					err,
+				))
+			}
+
+			req.Original.Status.Upgrade = ocmv1alpha1.ROSAClusterUpgradeStatus{
+				Version:     upgrade.Version,
+				State:       upgrade.State,
+				Description: upgrade.Description,
+			}
+
+			// create an event indicating that the cluster upgrade has been scheduled
+			events.RegisterAction(events.Upgraded, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+		} else {
+			req.Original.Status.Upgrade = ocmv1alpha1.ROSAClusterUpgradeStatus{}
+		}
+	}
+
+	if !reflect.DeepEqual(original.Status, req.Original.Status) {
+		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+			return requeue.OnError(req, fmt.Errorf("unable to update status upgrade - %w", err))
+		}
+	}
+
+	return phases.Next()
+}
+
+// Complete will perform all actions required to successfully complete a create or update reconciliation
+// request.  If the active hibernation schedule may change before the reconciliation interval of the
+// controller, the reconciliation is requeued at the next schedule boundary instead.
+func (r *Controller) Complete(req *ROSAClusterRequest) (ctrl.Result, error) {
+	result, err := phases.Complete(req, triggers.Create, r)
+	if err != nil {
+		return result, err
+	}
+
+	// check again shortly while an update of a cluster class member is in progress so that
+	// the remaining members of the cluster class are not held up until the next interval
+	if req.holdsClassUpdate() && defaultClusterRequeue < result.RequeueAfter {
+		result.RequeueAfter = defaultClusterRequeue
+	}
+
+	if req.NextScheduleBoundary.IsZero() {
+		return result, nil
+	}
+
+	// ensure we always requeue, even if the boundary has just passed
+	until := time.Until(req.NextScheduleBoundary)
+	if until < minimumScheduleRequeue {
+		until = minimumScheduleRequeue
+	}
+
+	if until >= result.RequeueAfter {
+		return result, nil
+	}
+
+	req.Log.Info(fmt.Sprintf("reconciling again at next schedule boundary in %s", until), request.LogValues(req)...)
+
+	return requeue.After(until, nil)
+}
@@ -2,6 +2,7 @@ package rosacluster
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"time"
@@ -15,12 +16,15 @@ import (
 	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
 	"github.com/rh-mobb/ocm-operator/controllers/conditions"
 	"github.com/rh-mobb/ocm-operator/controllers/events"
+	"github.com/rh-mobb/ocm-operator/controllers/metrics"
 	"github.com/rh-mobb/ocm-operator/controllers/request"
 	"github.com/rh-mobb/ocm-operator/controllers/triggers"
 	"github.com/rh-mobb/ocm-operator/controllers/workload"
 	"github.com/rh-mobb/ocm-operator/pkg/aws"
 	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
 	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+	"github.com/rh-mobb/ocm-operator/pkg/schedule"
+	"github.com/rh-mobb/ocm-operator/pkg/tracing"
 )
 
 // ROSAClusterRequest is an object that is unique to each reconciliation
@@ -36,9 +40,18 @@ type ROSAClusterRequest struct {
 	Reconciler        *Controller
 	OCMClient         *ocm.ClusterClient
 
+	// Class is the cluster class referenced by the cluster, if any.
+	Class *ocmv1alpha1.ROSAClusterClass
+
 	// data obtained during request reconciliation
 	Cluster *clustersmgmtv1.Cluster
 	Version *clustersmgmtv1.Version
+
+	// PowerState is the power state which the cluster should be in, taking into account
+	// the active hibernation schedule.  NextScheduleBoundary is the time at which the active
+	// hibernation schedule may next change.  It is zero if the cluster has no schedules.
+	PowerState           string
+	NextScheduleBoundary time.Time
 }
 
 func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
@@ -53,12 +66,29 @@ func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (requ
 		return &ROSAClusterRequest{}, err
 	}
 
-	// create the desired state of the request based on the inputs
+	// retrieve the cluster class.  a missing class does not block the deletion of
+	// the cluster.
+	class, err := r.getClass(ctx, original)
+	if err != nil {
+		if !errors.Is(err, ErrClassMissing) || triggers.GetTrigger(original) != triggers.Delete {
+			return &ROSAClusterRequest{}, err
+		}
+	}
+
+	// create the desired state of the request based on the inputs.  the defaults from
+	// the cluster class are merged under the inputs, with the fields which may only be
+	// set at provisioning time being merged only until the cluster has been created.
 	desired := original.DeepCopy()
 	if desired.Spec.DisplayName == "" {
 		desired.Spec.DisplayName = desired.Name
 	}
 
+	if class != nil {
+		class.Apply(desired, original.Status.ClusterID == "")
+	}
+
+	desired.SetDefaults()
+
 	// set the prefix to the cluster name with a random id if it is unset.  additionally
 	// store the prefix in the status so that the user knows what their prefix was
 	// which is important if the prefix was auto-generated.
@@ -96,11 +126,7 @@ func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (requ
 		Log:               r.Logger,
 		Trigger:           triggers.GetTrigger(original),
 		Reconciler:        r,
-	}
-
-	// set the version
-	if err := req.setVersion(); err != nil {
-		return req, fmt.Errorf("unable to determine openshift version - %w", err)
+		Class:             class,
 	}
 
 	return req, nil
@@ -140,6 +166,19 @@ func (req *ROSAClusterRequest) desired() bool {
 	// ignore the account id as it does not show up in the api request
 	req.Current.Spec.AccountID = req.Desired.Spec.AccountID
 
+	// ignore the kubeconfig secret and class reference as they are only used by this controller
+	req.Current.Spec.KubeconfigSecret = req.Desired.Spec.KubeconfigSecret
+	req.Current.Spec.ClassRef = req.Desired.Spec.ClassRef
+
+	// ignore the power state and version fields as they are reconciled separately from the
+	// cluster configuration
+	req.Current.Spec.PowerState = req.Desired.Spec.PowerState
+	req.Current.Spec.HibernationSchedules = req.Desired.Spec.HibernationSchedules
+	req.Current.Spec.OpenShiftVersion = req.Desired.Spec.OpenShiftVersion
+
+	// ignore the channel group as it is immutable and only used when the cluster is provisioned
+	req.Current.Spec.ChannelGroup = req.Desired.Spec.ChannelGroup
+
 	// ignore the tags as there are red hat managed tags that get added
 	// that are not a part of the spec.  only compare the tags that are
 	// in our desired spec.
@@ -156,33 +195,26 @@ func (req *ROSAClusterRequest) desired() bool {
 	)
 }
 
-// setVersion sets the desired requested OpenShift version for the req.  If
-// a version is requested in the spec, it is validated against a list of versions
-// from the OCM API.  If one is not requested in the spec, the latest available
-// version is automatically selected.
+// setVersion sets the desired requested OpenShift version for the req.  The version
+// requested in the spec is resolved, against a list of versions from the OCM API, to
+// the latest version of the channel group which matches it.  If one is not requested in
+// the spec, the default version of the channel group is automatically selected.  The
+// resolved version is stored in the status and is only resolved again once the version
+// requested in the spec changes.
 func (req *ROSAClusterRequest) setVersion() (err error) {
-	// ensure the desired version is set on the spec
-	if req.Desired.Spec.OpenShiftVersion == "" {
-		// get the default version if we have not stored a valid version
-		// in the status.
-		if req.Desired.Status.OpenShiftVersion == "" {
-			version, err := ocm.GetDefaultVersion(req.Reconciler.Connection)
-			if err != nil {
-				return fmt.Errorf("unable to retrieve default version - %w", err)
-			}
-
-			req.Desired.Spec.OpenShiftVersion = version.RawID()
-			req.Version = version
-		} else {
-			// get the version from the status.  at this point we know the version has
-			// been validated if it is stored on the status.
-			req.Desired.Spec.OpenShiftVersion = req.Desired.Status.OpenShiftVersion
-		}
-	}
-
-	// get the version object from our desired version
-	if req.Version == nil {
-		version, err := ocm.GetVersionObject(req.Reconciler.Connection, req.Desired.Spec.OpenShiftVersion)
+	constraint := req.Desired.Spec.OpenShiftVersion
+
+	// get the version from the status if it was resolved from the requested version.  at
+	// this point we know the version has been validated if it is stored on the status.
+	if req.Desired.Status.OpenShiftVersion != "" && req.Desired.Status.OpenShiftVersionConstraint == constraint {
+		req.Desired.Spec.OpenShiftVersion = req.Desired.Status.OpenShiftVersion
+
+		version, err := ocm.GetVersionObject(
+			req.Context,
+			req.Reconciler.Connection,
+			req.Desired.Spec.OpenShiftVersion,
+			req.Desired.Spec.ChannelGroup,
+		)
 		if err != nil {
 			return fmt.Errorf(
 				"found invalid version [%s] - %w",
@@ -192,28 +224,95 @@ func (req *ROSAClusterRequest) setVersion() (err error) {
 		}
 
 		req.Version = version
+
+		return nil
 	}
 
-	// set the id needed for the api call in the status.
-	if req.Original.Status.OpenShiftVersionID == "" {
-		// update the status to include the proper version id and the desired version id
-		original := req.Original.DeepCopy()
-		req.Original.Status.OpenShiftVersion = req.Desired.Spec.OpenShiftVersion
-		req.Original.Status.OpenShiftVersionID = req.Version.ID()
-		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
-			return fmt.Errorf("unable to update status operatorRolesCreated=true - %w", err)
+	// resolve the requested version to the latest matching version
+	version, err := ocm.ResolveVersion(req.Context, req.Reconciler.Connection, constraint, req.Desired.Spec.ChannelGroup)
+	if err != nil {
+		if errors.Is(err, ocm.ErrVersionNotFound) || errors.Is(err, ocm.ErrInvalidVersionConstraint) {
+			return errVersionUnresolved(constraint, req.Desired.Spec.ChannelGroup, err)
 		}
+
+		return fmt.Errorf(
+			"unable to resolve version [%s] in channel group [%s] - %w",
+			constraint,
+			req.Desired.Spec.ChannelGroup,
+			err,
+		)
+	}
+
+	req.Desired.Spec.OpenShiftVersion = version.RawID()
+	req.Version = version
+
+	// update the status to include the resolved version, the resolved version id and
+	// the requested version it was resolved from.
+	original := req.Original.DeepCopy()
+	req.Original.Status.OpenShiftVersion = version.RawID()
+	req.Original.Status.OpenShiftVersionID = version.ID()
+	req.Original.Status.OpenShiftVersionConstraint = constraint
+	if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+		return fmt.Errorf("unable to update status openshiftVersion=%s - %w", version.RawID(), err)
 	}
 
 	return nil
 }
 
+// inheritProvisioned sets the fields which may only be set at provisioning time, and which were
+// not set on the cluster, to the values which the cluster was provisioned with.  These may have
+// been inherited from the cluster class, which may have changed since the cluster was provisioned.
+func (req *ROSAClusterRequest) inheritProvisioned() {
+	if req.Current == nil || req.Class == nil {
+		return
+	}
+
+	if req.Original.Spec.Region == "" {
+		req.Desired.Spec.Region = req.Current.Spec.Region
+	}
+
+	if req.Original.Spec.IAM.AccountRolesPrefix == "" {
+		req.Desired.Spec.IAM.AccountRolesPrefix = req.Current.Spec.IAM.AccountRolesPrefix
+	}
+
+	if req.Original.Spec.IAM.UserRole == "" {
+		req.Desired.Spec.IAM.UserRole = req.Current.Spec.IAM.UserRole
+	}
+
+	if req.Original.Spec.DefaultMachinePool.InstanceType == "" {
+		req.Desired.Spec.DefaultMachinePool.InstanceType = req.Current.Spec.DefaultMachinePool.InstanceType
+	}
+
+	if req.Original.Spec.Encryption.ETCD.Key == "" {
+		req.Desired.Spec.Encryption.ETCD.Key = req.Current.Spec.Encryption.ETCD.Key
+	}
+
+	if req.Original.Spec.Encryption.EBS.Key == "" {
+		req.Desired.Spec.Encryption.EBS.Key = req.Current.Spec.Encryption.EBS.Key
+	}
+
+	if !req.Original.HasProxy() {
+		req.Desired.Spec.Network.Proxy = req.Current.Spec.Network.Proxy
+	}
+
+	if req.Original.Spec.AdditionalTrustBundle == "" {
+		req.Desired.Spec.AdditionalTrustBundle = req.Current.Spec.AdditionalTrustBundle
+	}
+}
+
 // createCluster performs all operations necessary for creating a ROSA cluster.
-func (req *ROSAClusterRequest) createCluster() error {
+func (req *ROSAClusterRequest) createCluster() (err error) {
+	ctx, span := tracing.Start(req.Context, "createCluster")
+	defer func() { tracing.End(span, err) }()
+
+	if req.Desired.Spec.IAM.UserRole == "" {
+		return ErrMissingUserRole
+	}
+
 	original := req.Original.DeepCopy()
 
 	// create oidc provider and config
-	oidc, err := req.ensureOIDCProvider()
+	oidc, err := req.ensureOIDCProvider(ctx)
 	if err != nil {
 		return err
 	}
@@ -221,7 +320,7 @@ func (req *ROSAClusterRequest) createCluster() error {
 	// create the operator roles
 	if !req.Original.Status.OperatorRolesCreated {
 		req.Log.Info("creating operator roles", request.LogValues(req)...)
-		if createErr := req.createOperatorRoles(oidc); createErr != nil {
+		if createErr := req.createOperatorRoles(ctx, oidc); createErr != nil {
 			return createErr
 		}
 	}
@@ -229,7 +328,7 @@ func (req *ROSAClusterRequest) createCluster() error {
 	// get the availability zones if we provided subnets
 	var availabilityZones []string
 	if req.Desired.HasSubnets() {
-		availabilityZones, err = req.Reconciler.AWSClient.GetAvailabilityZonesBySubnet(req.Desired.Spec.Network.Subnets)
+		availabilityZones, err = req.Reconciler.AWSClient.GetAvailabilityZonesBySubnet(ctx, req.Desired.Spec.Network.Subnets)
 		if err != nil {
 			return fmt.Errorf("unable to retrieve availability zones from provided subnets - %w", err)
 		}
@@ -237,7 +336,7 @@ func (req *ROSAClusterRequest) createCluster() error {
 
 	// create the cluster
 	req.Log.Info("creating rosa cluster", request.LogValues(req)...)
-	cluster, err := req.OCMClient.Create(req.Desired.Builder(
+	cluster, err := req.OCMClient.Create(req.Context, req.Desired.Builder(
 		oidc,
 		req.Original.Status.OpenShiftVersionID,
 		availabilityZones,
@@ -260,7 +359,7 @@ func (req *ROSAClusterRequest) createCluster() error {
 // updateCluster performs all necessary actions for updating a ROSA cluster.
 func (req *ROSAClusterRequest) updateCluster() error {
 	// retrieve oidc config
-	oidc, err := ocm.NewOIDCConfigClient(req.Reconciler.Connection).Get(req.Original.Status.OIDCConfigID)
+	oidc, err := ocm.NewOIDCConfigClient(req.Reconciler.Connection).Get(req.Context, req.Original.Status.OIDCConfigID)
 	if err != nil {
 		return fmt.Errorf("unable to get oidc config from ocm - %w", err)
 	}
@@ -268,17 +367,18 @@ func (req *ROSAClusterRequest) updateCluster() error {
 	// get the availability zones if we provided subnets
 	var availabilityZones []string
 	if req.Desired.HasSubnets() {
-		availabilityZones, err = req.Reconciler.AWSClient.GetAvailabilityZonesBySubnet(req.Desired.Spec.Network.Subnets)
+		availabilityZones, err = req.Reconciler.AWSClient.GetAvailabilityZonesBySubnet(req.Context, req.Desired.Spec.Network.Subnets)
 		if err != nil {
 			return fmt.Errorf("unable to retrieve availability zones from provided subnets - %w", err)
 		}
 	}
 
-	// update the rosa cluster if it does exist
+	// update the rosa cluster if it does exist.  the current version of the cluster is used
+	// as the version is only changed by upgrading the cluster.
 	req.Log.Info("updating rosa cluster", request.LogValues(req)...)
-	cluster, err := req.OCMClient.Update(req.Desired.Builder(
+	cluster, err := req.OCMClient.Update(req.Context, req.Desired.Builder(
 		oidc,
-		req.Original.Status.OpenShiftVersionID,
+		req.Cluster.Version().ID(),
 		availabilityZones,
 	).ID(req.Original.Status.ClusterID),
 	)
@@ -292,13 +392,16 @@ func (req *ROSAClusterRequest) updateCluster() error {
 }
 
 // ensureOIDCProvider creates the OIDC Provider in AWS.
-func (req *ROSAClusterRequest) ensureOIDCProvider() (config *clustersmgmtv1.OidcConfig, err error) {
+func (req *ROSAClusterRequest) ensureOIDCProvider(ctx context.Context) (config *clustersmgmtv1.OidcConfig, err error) {
+	ctx, span := tracing.Start(ctx, "ensureOIDCProvider")
+	defer func() { tracing.End(span, err) }()
+
 	original := req.Original.DeepCopy()
 
 	// create oidc config only if we have not created it already
 	if req.Original.Status.OIDCConfigID == "" {
 		req.Log.Info("creating oidc config", request.LogValues(req)...)
-		config, err = ocm.NewOIDCConfigClient(req.Reconciler.Connection).Create()
+		config, err = ocm.NewOIDCConfigClient(req.Reconciler.Connection).Create(ctx)
 		if err != nil {
 			return config, fmt.Errorf("unable to create oidc config - %w", err)
 		}
@@ -310,7 +413,7 @@ func (req *ROSAClusterRequest) ensureOIDCProvider() (config *clustersmgmtv1.Oidc
 		}
 	} else {
 		// get the oidc config
-		config, err = ocm.NewOIDCConfigClient(req.Reconciler.Connection).Get(req.Original.Status.OIDCConfigID)
+		config, err = ocm.NewOIDCConfigClient(req.Reconciler.Connection).Get(ctx, req.Original.Status.OIDCConfigID)
 		if err != nil {
 			return config, fmt.Errorf("unable to get oidc config [%s] - %w", req.Original.Status.OIDCConfigID, err)
 		}
@@ -319,7 +422,7 @@ func (req *ROSAClusterRequest) ensureOIDCProvider() (config *clustersmgmtv1.Oidc
 	// create the oidc provider if we have not created it already
 	if req.Original.Status.OIDCProviderARN == "" {
 		req.Log.Info("creating oidc provider", request.LogValues(req)...)
-		providerARN, err := req.Reconciler.AWSClient.CreateOIDCProvider(config.IssuerUrl())
+		providerARN, err := req.Reconciler.AWSClient.CreateOIDCProvider(ctx, config.IssuerUrl())
 		if err != nil {
 			return config, fmt.Errorf("unable to create oidc provider - %w", err)
 		}
@@ -335,7 +438,10 @@ func (req *ROSAClusterRequest) ensureOIDCProvider() (config *clustersmgmtv1.Oidc
 }
 
 // createOperatorRoles creates the operator roles in AWS.
-func (req *ROSAClusterRequest) createOperatorRoles(oidc *clustersmgmtv1.OidcConfig) error {
+func (req *ROSAClusterRequest) createOperatorRoles(ctx context.Context, oidc *clustersmgmtv1.OidcConfig) (err error) {
+	ctx, span := tracing.Start(ctx, "createOperatorRoles")
+	defer func() { tracing.End(span, err) }()
+
 	// create the sts client
 	stsClient := ocm.NewSTSClient(
 		req.Reconciler.Connection,
@@ -347,13 +453,13 @@ func (req *ROSAClusterRequest) createOperatorRoles(oidc *clustersmgmtv1.OidcConf
 	)
 
 	// retrieve the credential requests
-	requests, err := stsClient.GetCredentialRequests()
+	requests, err := stsClient.GetCredentialRequests(ctx)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve sts credential requests - %w", err)
 	}
 
 	// create the operator roles
-	if err := stsClient.CreateOperatorRoles(req.Reconciler.AWSClient, req.Version, requests...); err != nil {
+	if err := stsClient.CreateOperatorRoles(ctx, req.Reconciler.AWSClient, req.Version, requests...); err != nil {
 		return fmt.Errorf("unable to create operator roles - %w", err)
 	}
 
@@ -382,13 +488,13 @@ func (req *ROSAClusterRequest) destroyOperatorRoles() error {
 	)
 
 	// retrieve the credential requests
-	requests, err := stsClient.GetCredentialRequests()
+	requests, err := stsClient.GetCredentialRequests(req.Context)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve sts credential requests - %w", err)
 	}
 
 	// delete the operator roles
-	if err := stsClient.DeleteOperatorRoles(req.Reconciler.AWSClient, requests...); err != nil {
+	if err := stsClient.DeleteOperatorRoles(req.Context, req.Reconciler.AWSClient, requests...); err != nil {
 		return fmt.Errorf("unable to delete operator roles - %w", err)
 	}
 
@@ -411,6 +517,65 @@ func (req *ROSAClusterRequest) notify(event events.Event, condition *metav1.Cond
 	return conditions.Update(req, condition)
 }
 
+// setPowerState sets the power state which the cluster should be in.  The cluster is hibernated
+// while a hibernation schedule is active, regardless of the power state in the spec.  It returns
+// the name of the active hibernation schedule, if any.
+func (req *ROSAClusterRequest) setPowerState(now time.Time) (string, error) {
+	req.PowerState = req.Desired.Spec.PowerState
+	if req.PowerState == "" {
+		req.PowerState = ocmv1alpha1.ROSAClusterPowerStateRunning
+	}
+
+	// clusters using a hosted control plane may not be hibernated
+	if req.Desired.Spec.HostedControlPlane {
+		req.PowerState = ocmv1alpha1.ROSAClusterPowerStateRunning
+
+		return "", nil
+	}
+
+	schedules := req.Desired.Spec.HibernationSchedules
+	windows := make([]schedule.Window, len(schedules))
+
+	for i := range schedules {
+		windows[i] = schedule.Window{
+			Name:     schedules[i].Name,
+			Schedule: schedules[i].Schedule,
+			TimeZone: schedules[i].TimeZone,
+			Duration: time.Duration(schedules[i].DurationMinutes) * time.Minute,
+		}
+	}
+
+	active, next, err := schedule.Active(windows, now)
+	if err != nil {
+		return "", fmt.Errorf("invalid hibernation schedule - %w", err)
+	}
+
+	req.NextScheduleBoundary = next
+
+	if active < 0 {
+		return "", nil
+	}
+
+	req.PowerState = ocmv1alpha1.ROSAClusterPowerStateHibernating
+
+	return schedules[active].Name, nil
+}
+
+// recordProvisioned records the time taken to provision the cluster the first time that the cluster
+// is observed to be ready.  The time is only recorded for clusters which were created by the operator,
+// as clusters which already existed may have become ready long before they were observed.
+func (req *ROSAClusterRequest) recordProvisioned() error {
+	if conditions.IsSet(ClusterProvisioned(), req.Original) {
+		return nil
+	}
+
+	if conditions.IsSet(ClusterCreated(), req.Original) && !req.Cluster.CreationTimestamp().IsZero() {
+		metrics.ClusterProvisioningDuration.Observe(time.Since(req.Cluster.CreationTimestamp()).Seconds())
+	}
+
+	return conditions.Update(req, ClusterProvisioned())
+}
+
 // provisionRequeueTime determines the requeue time when the cluster prior to the cluster being ready.
 func (req *ROSAClusterRequest) provisionRequeueTime() time.Duration {
 	// change the requeue time based on whether we have a hosted control plane or
@@ -0,0 +1,231 @@
+package rosacluster
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm/ocmtest"
+)
+
+func TestROSAClusterRequest_inheritProvisioned(t *testing.T) {
+	t.Parallel()
+
+	// provisioned is the state of a cluster which was provisioned from an earlier version
+	// of its cluster class
+	provisioned := ocmv1alpha1.ROSAClusterSpec{
+		Region:                "us-east-1",
+		AdditionalTrustBundle: "provisioned-bundle",
+		Encryption: ocmv1alpha1.ROSAEncryption{
+			ETCD: ocmv1alpha1.ROSAKey{Key: "arn:aws:kms:us-east-1:111111111111:key/etcd"},
+			EBS:  ocmv1alpha1.ROSAKey{Key: "arn:aws:kms:us-east-1:111111111111:key/ebs"},
+		},
+		Network: ocmv1alpha1.ROSANetwork{
+			Subnets: []string{"subnet-a"},
+			Proxy:   ocmv1alpha1.ROSAProxy{HTTPSProxy: "http://old-proxy.example.com:3128"},
+		},
+		DefaultMachinePool: ocmv1alpha1.DefaultMachinePoolFields{InstanceType: "m5.xlarge"},
+		IAM: ocmv1alpha1.ROSAIAM{
+			AccountRolesPrefix: "Old",
+			UserRole:           "arn:aws:iam::111111111111:role/old-user-role",
+		},
+	}
+
+	// changed is the cluster class after it has changed since the cluster was provisioned
+	changed := &ocmv1alpha1.ROSAClusterClass{
+		Spec: ocmv1alpha1.ROSAClusterClassSpec{
+			Region:                "us-east-2",
+			AdditionalTrustBundle: "class-bundle",
+			Encryption: ocmv1alpha1.ROSAClusterClassEncryption{
+				ETCD: ocmv1alpha1.ROSAClusterClassKey{Key: "arn:aws:kms:us-east-2:111111111111:key/etcd"},
+				EBS:  ocmv1alpha1.ROSAClusterClassKey{Key: "arn:aws:kms:us-east-2:111111111111:key/ebs"},
+			},
+			Proxy:              ocmv1alpha1.ROSAClusterClassProxy{HTTPSProxy: "http://proxy.example.com:3128"},
+			DefaultMachinePool: ocmv1alpha1.ROSAClusterClassMachinePool{InstanceType: "m6i.xlarge"},
+			IAM: ocmv1alpha1.ROSAClusterClassIAM{
+				AccountRolesPrefix: "New",
+				UserRole:           "arn:aws:iam::111111111111:role/new-user-role",
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		original ocmv1alpha1.ROSAClusterSpec
+		class    *ocmv1alpha1.ROSAClusterClass
+		current  bool
+		want     ocmv1alpha1.ROSAClusterSpec
+	}{
+		{
+			name:     "ensure fields inherited from the class keep their provisioned values",
+			original: ocmv1alpha1.ROSAClusterSpec{Network: ocmv1alpha1.ROSANetwork{Subnets: []string{"subnet-a"}}},
+			class:    changed,
+			current:  true,
+			want:     provisioned,
+		},
+		{
+			name: "ensure fields set on the cluster are not replaced",
+			original: ocmv1alpha1.ROSAClusterSpec{
+				Region:  "us-west-2",
+				Network: ocmv1alpha1.ROSANetwork{Subnets: []string{"subnet-a"}, Proxy: ocmv1alpha1.ROSAProxy{NoProxy: ".example.com"}},
+			},
+			class:   changed,
+			current: true,
+			want: func() ocmv1alpha1.ROSAClusterSpec {
+				want := *provisioned.DeepCopy()
+				want.Region = "us-west-2"
+				want.Network.Proxy = ocmv1alpha1.ROSAProxy{NoProxy: ".example.com"}
+
+				return want
+			}(),
+		},
+		{
+			name:     "ensure clusters which have not been provisioned inherit from the class",
+			original: ocmv1alpha1.ROSAClusterSpec{Network: ocmv1alpha1.ROSANetwork{Subnets: []string{"subnet-a"}}},
+			class:    changed,
+			want: func() ocmv1alpha1.ROSAClusterSpec {
+				want := *provisioned.DeepCopy()
+				want.Region = "us-east-2"
+				want.AdditionalTrustBundle = "class-bundle"
+				want.Encryption.ETCD.Key = "arn:aws:kms:us-east-2:111111111111:key/etcd"
+				want.Encryption.EBS.Key = "arn:aws:kms:us-east-2:111111111111:key/ebs"
+				want.Network.Proxy = ocmv1alpha1.ROSAProxy{HTTPSProxy: "http://proxy.example.com:3128"}
+				want.IAM.AccountRolesPrefix = "New"
+				want.IAM.UserRole = "arn:aws:iam::111111111111:role/new-user-role"
+				want.DefaultMachinePool.InstanceType = "m6i.xlarge"
+
+				return want
+			}(),
+		},
+		{
+			name:     "ensure clusters without a class are unchanged",
+			original: ocmv1alpha1.ROSAClusterSpec{Network: ocmv1alpha1.ROSANetwork{Subnets: []string{"subnet-a"}}},
+			current:  true,
+			want:     ocmv1alpha1.ROSAClusterSpec{Network: ocmv1alpha1.ROSANetwork{Subnets: []string{"subnet-a"}}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			req := &ROSAClusterRequest{
+				Original: &ocmv1alpha1.ROSACluster{Spec: *tt.original.DeepCopy()},
+				Desired:  &ocmv1alpha1.ROSACluster{Spec: *tt.original.DeepCopy()},
+				Class:    tt.class,
+			}
+
+			if tt.class != nil {
+				tt.class.Apply(req.Desired, true)
+			}
+
+			if tt.current {
+				req.Current = &ocmv1alpha1.ROSACluster{Spec: *provisioned.DeepCopy()}
+			}
+
+			req.inheritProvisioned()
+			if !reflect.DeepEqual(req.Desired.Spec, tt.want) {
+				t.Errorf("ROSAClusterRequest.inheritProvisioned() = %+v, want %+v", req.Desired.Spec, tt.want)
+			}
+		})
+	}
+}
+
+func TestROSAClusterRequest_setVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		constraint   string
+		channelGroup string
+		versions     bool
+		want         string
+		wantErr      bool
+		wantTerminal bool
+	}{
+		{
+			name:         "ensure matching version is resolved",
+			constraint:   "~4.15",
+			channelGroup: "resolve-candidate",
+			versions:     true,
+			want:         "4.15.0-rc.2",
+		},
+		{
+			name:         "ensure version which does not match is terminal",
+			constraint:   "~4.16",
+			channelGroup: "resolve-missing",
+			versions:     true,
+			wantErr:      true,
+			wantTerminal: true,
+		},
+		{
+			name:         "ensure invalid version constraint is terminal",
+			constraint:   "latest",
+			channelGroup: "resolve-invalid",
+			versions:     true,
+			wantErr:      true,
+			wantTerminal: true,
+		},
+		{
+			name:         "ensure failure to list versions is transient",
+			constraint:   "~4.15",
+			channelGroup: "resolve-unavailable",
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			routes := map[string]ocmtest.Response{}
+			if tt.versions {
+				routes[http.MethodGet+" /api/clusters_mgmt/v1/versions"] = ocmtest.Response{
+					Body: `{"kind":"VersionList","page":1,"size":1,"total":1,"items":[` +
+						`{"kind":"Version","id":"openshift-v4.15.0-rc.2","raw_id":"4.15.0-rc.2"}]}`,
+				}
+			}
+
+			cluster := &ocmv1alpha1.ROSACluster{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "test"},
+				Spec: ocmv1alpha1.ROSAClusterSpec{
+					DisplayName:      "test",
+					OpenShiftVersion: tt.constraint,
+					ChannelGroup:     tt.channelGroup,
+				},
+			}
+
+			dependencies := controllertest.New(t, ocmtest.NewServer(t, routes), cluster)
+			req := &ROSAClusterRequest{
+				Context:  context.Background(),
+				Original: cluster,
+				Desired:  cluster.DeepCopy(),
+				Reconciler: &Controller{
+					Client:     dependencies.Client,
+					Connection: dependencies.Connection,
+				},
+			}
+
+			err := req.setVersion()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ROSAClusterRequest.setVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if _, terminal := request.AsTerminal(err); terminal != tt.wantTerminal {
+				t.Errorf("ROSAClusterRequest.setVersion() error = %v, wantTerminal %v", err, tt.wantTerminal)
+			}
+
+			if !tt.wantErr && req.Original.Status.OpenShiftVersion != tt.want {
+				t.Errorf("ROSAClusterRequest.setVersion() status.openshiftVersion = %v, want %v", req.Original.Status.OpenShiftVersion, tt.want)
+			}
+		})
+	}
+}
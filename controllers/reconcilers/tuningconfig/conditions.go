@@ -0,0 +1,24 @@
+package tuningconfig
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+)
+
+const (
+	tuningConfigConditionTypeDeleted = "TuningConfigDeleted"
+	tuningConfigMessageDeleted       = "tuning config has been deleted from openshift cluster manager"
+)
+
+// TuningConfigDeleted return a condition indicating that the tuning config has
+// been deleted from OpenShift Cluster Manager.
+func TuningConfigDeleted() *metav1.Condition {
+	return &metav1.Condition{
+		Type:               tuningConfigConditionTypeDeleted,
+		LastTransitionTime: metav1.Now(),
+		Status:             metav1.ConditionTrue,
+		Reason:             triggers.Delete.String(),
+		Message:            tuningConfigMessageDeleted,
+	}
+}
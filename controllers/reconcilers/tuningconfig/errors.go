@@ -0,0 +1,79 @@
+package tuningconfig
+
+import (
+	"errors"
+	"fmt"
+
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+)
+
+var (
+	ErrTuningConfigInUse     = errors.New("tuning config is referenced by machine pools")
+	ErrTuningConfigNotHosted = request.NewValidationError("tuning configs are only valid for clusters using a hosted control plane")
+)
+
+// errUnableToUpdateStatusConfigID produces an error indicating the tuning config status was unable
+// to be updated.
+func errUnableToUpdateStatusConfigID(request *TuningConfigRequest, id string, err error) (ctrl.Result, error) {
+	return requeue.OnError(request, fmt.Errorf(
+		"unable to update tuning config [%s] status [configID=%s] - %w",
+		request.GetName(),
+		id,
+		err,
+	))
+}
+
+// errUnableToFindMachinePools produces an error indicating the machine pools which reference the
+// tuning config were unable to be determined.
+func errUnableToFindMachinePools(request *TuningConfigRequest, err error) error {
+	return fmt.Errorf(
+		"unable to find machine pools referencing tuning config [%s] - %w",
+		request.GetName(),
+		err,
+	)
+}
+
+// errTuningConfigInUse produces an error indicating the tuning config is unable to be deleted
+// because it is still referenced by machine pools.
+func errTuningConfigInUse(request *TuningConfigRequest, machinePools []string) error {
+	return fmt.Errorf(
+		"unable to delete tuning config [%s] referenced by machine pools %v - %w",
+		request.GetName(),
+		machinePools,
+		ErrTuningConfigInUse,
+	)
+}
+
+// errUnableToBuildTuningConfig produces an error indicating the tuning config was unable to be
+// built from the custom resource.
+func errUnableToBuildTuningConfig(request *TuningConfigRequest, err error) error {
+	return fmt.Errorf(
+		"unable to build tuning config [%s] - %w",
+		request.GetName(),
+		err,
+	)
+}
+
+// errTuningConfigNotHosted produces an error indicating the tuning config is unable to be created
+// because the cluster is not using a hosted control plane.
+func errTuningConfigNotHosted(request *TuningConfigRequest) error {
+	return fmt.Errorf(
+		"unable to apply tuning config [%s] to cluster [%s] - %w",
+		request.GetName(),
+		request.GetClusterName(),
+		ErrTuningConfigNotHosted,
+	)
+}
+
+// errTuningConfigCopy produces an error indicating the tuning config from OCM was unable to be
+// copied.
+func errTuningConfigCopy(request *TuningConfigRequest, err error) error {
+	return fmt.Errorf(
+		"unable to copy ocm tuning config object [%s] - %w",
+		request.GetName(),
+		err,
+	)
+}
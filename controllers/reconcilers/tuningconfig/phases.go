@@ -0,0 +1,160 @@
+package tuningconfig
+
+import (
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers"
+	"github.com/rh-mobb/ocm-operator/controllers/conditions"
+	"github.com/rh-mobb/ocm-operator/controllers/events"
+	"github.com/rh-mobb/ocm-operator/controllers/phases"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/requeue"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// GetCurrentState gets the current state of the TuningConfig resource.  The current state of the TuningConfig resource
+// is stored in OpenShift Cluster Manager.  It will be compared against the desired state which exists
+// within the OpenShift cluster in which this controller is reconciling against.
+func (r *Controller) GetCurrentState(req *TuningConfigRequest) (ctrl.Result, error) {
+	// tuning configs are only valid for clusters using a hosted control plane
+	if !req.Original.Status.Hosted {
+		return requeue.OnError(req, errTuningConfigNotHosted(req))
+	}
+
+	req.OCMClient = ocm.NewTuningConfigClient(
+		req.Reconciler.Connection,
+		req.Desired.Spec.DisplayName,
+		req.Original.Status.ClusterID,
+	)
+
+	tuningConfig, err := req.OCMClient.Get(req.Context)
+	if err != nil {
+		return requeue.OnError(req, ocm.GetError(req, err))
+	}
+
+	// return if there is no tuning config found
+	if tuningConfig == nil {
+		return phases.Next()
+	}
+
+	// store the current state
+	req.Current = &ocmv1alpha1.TuningConfig{}
+	req.Current.Spec.ClusterName = req.Desired.Spec.ClusterName
+	req.Current.Spec.DisplayName = req.Desired.Spec.DisplayName
+
+	if err := req.Current.CopyFrom(tuningConfig); err != nil {
+		return requeue.OnError(req, errTuningConfigCopy(req, err))
+	}
+
+	// store the id of the tuning config so that it may be updated
+	req.Desired.Status.ConfigID = tuningConfig.ID()
+
+	return phases.Next()
+}
+
+// ApplyTuningConfig applies the tuning config state to OCM.  This includes creating and/or updating
+// the tuning config based on the provided attributes from the custom resource.
+func (r *Controller) ApplyTuningConfig(req *TuningConfigRequest) (ctrl.Result, error) {
+	// return if it is already in its desired state
+	if req.desired() {
+		r.Logger.V(controllers.LogLevelDebug).Info(
+			"tuning config already in desired state",
+			request.LogValues(req)...,
+		)
+
+		return phases.Next()
+	}
+
+	builder, err := req.Desired.Builder()
+	if err != nil {
+		return requeue.OnError(req, errUnableToBuildTuningConfig(req, err))
+	}
+
+	// create the tuning config if it does not exist
+	if req.Current == nil {
+		r.Logger.Info("creating tuning config", request.LogValues(req)...)
+		tuningConfig, err := req.OCMClient.Create(req.Context, builder)
+		if err != nil {
+			return requeue.OnError(req, ocm.CreateError(req, err))
+		}
+
+		// store the required tuning config data in the status
+		original := req.Original.DeepCopy()
+		req.Original.Status.ConfigID = tuningConfig.ID()
+
+		if err := kubernetes.PatchStatus(req.Context, req.Reconciler, original, req.Original); err != nil {
+			return errUnableToUpdateStatusConfigID(req, tuningConfig.ID(), err)
+		}
+
+		// create an event indicating that the tuning config has been created
+		events.RegisterAction(events.Created, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+
+		return phases.Next()
+	}
+
+	// update the tuning config if it does exist
+	r.Logger.Info("updating tuning config", request.LogValues(req)...)
+	if _, err := req.OCMClient.Update(req.Context, builder); err != nil {
+		return requeue.OnError(req, ocm.UpdateError(req, err))
+	}
+
+	// create an event indicating that the tuning config has been updated
+	events.RegisterAction(events.Updated, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+
+	return phases.Next()
+}
+
+// Destroy will destroy an OpenShift Cluster Manager tuning config.  Deletion is blocked while the
+// tuning config is still referenced by a machine pool.
+func (r *Controller) Destroy(req *TuningConfigRequest) (ctrl.Result, error) {
+	// return immediately if we have already deleted the tuning config
+	if conditions.IsSet(TuningConfigDeleted(), req.Original) {
+		return phases.Next()
+	}
+
+	// return if the cluster does not exist (has been deleted)
+	_, exists, err := ocm.ClusterExists(req.Context, req.Desired.Spec.ClusterName, req.Reconciler.Connection)
+	if err != nil {
+		return requeue.OnError(req, err)
+	}
+
+	if !exists {
+		return phases.Next()
+	}
+
+	// ensure the tuning config is no longer referenced by a machine pool
+	machinePools, err := req.referencingMachinePools()
+	if err != nil {
+		return requeue.OnError(req, errUnableToFindMachinePools(req, err))
+	}
+
+	if len(machinePools) > 0 {
+		return requeue.OnError(req, errTuningConfigInUse(req, machinePools))
+	}
+
+	// delete the object
+	r.Logger.Info("deleting tuning config", request.LogValues(req)...)
+	if req.Original.Status.ConfigID != "" {
+		ocmClient := ocm.NewTuningConfigClient(
+			req.Reconciler.Connection,
+			req.Desired.Spec.DisplayName,
+			req.Original.Status.ClusterID,
+		)
+
+		if err := ocmClient.Delete(req.Context, req.Original.Status.ConfigID); err != nil {
+			return requeue.OnError(req, ocm.DeleteError(req, err))
+		}
+	}
+
+	// create an event indicating that the tuning config has been deleted
+	events.RegisterAction(events.Deleted, req.Original, r.Recorder, req.Desired.Spec.DisplayName, req.Original.Status.ClusterID)
+
+	// set the deleted condition
+	if err := conditions.Update(req, TuningConfigDeleted()); err != nil {
+		return requeue.OnError(req, conditions.UpdateDeletedConditionError(err))
+	}
+
+	return phases.Next()
+}
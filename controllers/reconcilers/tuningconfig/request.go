@@ -0,0 +1,148 @@
+package tuningconfig
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/controllers/request"
+	"github.com/rh-mobb/ocm-operator/controllers/triggers"
+	"github.com/rh-mobb/ocm-operator/controllers/workload"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+// TuningConfigRequest is an object that is unique to each reconciliation
+// req.
+type TuningConfigRequest struct {
+	Context           context.Context
+	ControllerRequest ctrl.Request
+	Current           *ocmv1alpha1.TuningConfig
+	Original          *ocmv1alpha1.TuningConfig
+	Desired           *ocmv1alpha1.TuningConfig
+	Trigger           triggers.Trigger
+	Reconciler        *Controller
+	OCMClient         *ocm.TuningConfigClient
+}
+
+func (r *Controller) NewRequest(ctx context.Context, ctrlReq ctrl.Request) (request.Request, error) {
+	original := &ocmv1alpha1.TuningConfig{}
+
+	// get the object (desired state) from the cluster
+	if err := r.Get(ctx, ctrlReq.NamespacedName, original); err != nil {
+		if !apierrs.IsNotFound(err) {
+			return &TuningConfigRequest{}, fmt.Errorf("unable to fetch cluster object - %w", err)
+		}
+
+		return &TuningConfigRequest{}, err
+	}
+
+	// create the desired state of the request based on the inputs
+	desired := original.DeepCopy()
+	if desired.Spec.DisplayName == "" {
+		desired.Spec.DisplayName = desired.Name
+	}
+
+	return &TuningConfigRequest{
+		Original:          original,
+		Desired:           desired,
+		ControllerRequest: ctrlReq,
+		Context:           ctx,
+		Trigger:           triggers.GetTrigger(original),
+		Reconciler:        r,
+	}, nil
+}
+
+// DefaultRequeue returns the default requeue time for a request.
+func (req *TuningConfigRequest) DefaultRequeue() time.Duration {
+	return defaultTuningConfigRequeue
+}
+
+// GetObject returns the original object to satisfy the controllers.Request interface.
+func (req *TuningConfigRequest) GetObject() workload.Workload {
+	return req.Original
+}
+
+// GetName returns the name as it should appear in OCM.
+func (req *TuningConfigRequest) GetName() string {
+	return req.Desired.Spec.DisplayName
+}
+
+// GetClusterName returns the cluster name that this object belongs to.
+func (req *TuningConfigRequest) GetClusterName() string {
+	return req.Desired.Spec.ClusterName
+}
+
+// GetContext returns the context of the request.
+func (req *TuningConfigRequest) GetContext() context.Context {
+	return req.Context
+}
+
+// GetReconciler returns the context of the request.
+func (req *TuningConfigRequest) GetReconciler() kubernetes.Client {
+	return req.Reconciler
+}
+
+// SetClusterStatus sets the relevant cluster fields in the status.  It is used
+// to satisfy the request.Request interface.
+func (req *TuningConfigRequest) SetClusterStatus(cluster *clustersmgmtv1.Cluster) {
+	if req.Original.Status.ClusterID == "" {
+		req.Original.Status.ClusterID = cluster.ID()
+	}
+
+	req.Original.Status.Hosted = cluster.Hypershift().Enabled()
+}
+
+func (req *TuningConfigRequest) desired() bool {
+	if req.Desired == nil || req.Current == nil {
+		return false
+	}
+
+	// compare the tuned spec separately as its raw representation may differ in formatting
+	// from what is returned from ocm
+	desiredTunedSpec, err := req.Desired.GetTunedSpec()
+	if err != nil {
+		return false
+	}
+
+	currentTunedSpec, err := req.Current.GetTunedSpec()
+	if err != nil {
+		return false
+	}
+
+	if !reflect.DeepEqual(desiredTunedSpec, currentTunedSpec) {
+		return false
+	}
+
+	return req.Desired.Spec.ClusterName == req.Current.Spec.ClusterName &&
+		req.Desired.Spec.DisplayName == req.Current.Spec.DisplayName
+}
+
+// referencingMachinePools returns the names of the machine pools which reference the kubelet
+// config.  A tuning config may not be deleted while it is still referenced by a machine pool.
+func (req *TuningConfigRequest) referencingMachinePools() ([]string, error) {
+	machinePools, err := (&ocmv1alpha1.MachinePool{}).FindAllByClusterID(
+		req.Context,
+		req.Reconciler,
+		req.Original.Status.ClusterID,
+	)
+	if err != nil {
+		return []string{}, err
+	}
+
+	names := []string{}
+
+	for i := range machinePools {
+		if machinePools[i].ReferencesTuningConfig(req.Desired.Spec.DisplayName) {
+			names = append(names, fmt.Sprintf("%s/%s", machinePools[i].Namespace, machinePools[i].Name))
+		}
+	}
+
+	return names, nil
+}
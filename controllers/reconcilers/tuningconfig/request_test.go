@@ -0,0 +1,63 @@
+package tuningconfig
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+)
+
+func TestTuningConfigRequest_desired(t *testing.T) {
+	t.Parallel()
+
+	newTuningConfig := func(raw string) *ocmv1alpha1.TuningConfig {
+		return &ocmv1alpha1.TuningConfig{
+			Spec: ocmv1alpha1.TuningConfigSpec{
+				ClusterName: "test",
+				DisplayName: "test",
+				TunedSpec:   runtime.RawExtension{Raw: []byte(raw)},
+			},
+		}
+	}
+
+	tests := []struct {
+		name    string
+		current *ocmv1alpha1.TuningConfig
+		desired *ocmv1alpha1.TuningConfig
+		want    bool
+	}{
+		{
+			name:    "ensure differently formatted tuned specs reflect desired state",
+			current: newTuningConfig(`{"recommend":[{"priority":20,"profile":"test"}],"profile":[{"name":"test"}]}`),
+			desired: newTuningConfig(`{"profile": [{"name": "test"}], "recommend": [{"profile": "test", "priority": 20}]}`),
+			want:    true,
+		},
+		{
+			name:    "ensure changed tuned specs do not reflect desired state",
+			current: newTuningConfig(`{"recommend":[{"priority":20,"profile":"test"}]}`),
+			desired: newTuningConfig(`{"recommend":[{"priority":10,"profile":"test"}]}`),
+			want:    false,
+		},
+		{
+			name:    "ensure missing current state does not reflect desired state",
+			current: nil,
+			desired: newTuningConfig(`{}`),
+			want:    false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			request := &TuningConfigRequest{
+				Current: tt.current,
+				Desired: tt.desired,
+			}
+			if got := request.desired(); got != tt.want {
+				t.Errorf("TuningConfigRequest.desired() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
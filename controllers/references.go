@@ -0,0 +1,98 @@
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// ReferenceIndex represents a field index on an object which stores the names of other
+// objects, such as secrets and config maps, that the object references.
+type ReferenceIndex struct {
+	// Field is the name of the field index (e.g. .spec.clientSecret.name).
+	Field string
+
+	// Extract returns the names of the referenced objects from the object.
+	Extract func(client.Object) []string
+}
+
+// IndexReferences registers field indexers on an object for each of the provided reference
+// indexes, such as with the field indexer of the manager.  These are used to lookup which objects
+// reference a particular object by name.
+func IndexReferences(ctx context.Context, indexer client.FieldIndexer, object client.Object, indexes ...ReferenceIndex) error {
+	for _, index := range indexes {
+		extract := index.Extract
+
+		if err := indexer.IndexField(ctx, object, index.Field, func(o client.Object) []string {
+			names := []string{}
+
+			for _, name := range extract(o) {
+				if name != "" {
+					names = append(names, name)
+				}
+			}
+
+			return names
+		}); err != nil {
+			return fmt.Errorf("unable to index field [%s] for object [%T] - %w", index.Field, object, err)
+		}
+	}
+
+	return nil
+}
+
+// EnqueueReferencing returns an event handler which enqueues a reconciliation request for each
+// object in the same namespace which references the object that triggered the event by name.  The
+// fields must have been previously indexed with IndexReferences.
+func EnqueueReferencing(c client.Reader, list client.ObjectList, fields ...string) handler.EventHandler {
+	return handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, object client.Object) []reconcile.Request {
+		found := map[types.NamespacedName]bool{}
+		requests := []reconcile.Request{}
+
+		for _, field := range fields {
+			objects, ok := list.DeepCopyObject().(client.ObjectList)
+			if !ok {
+				continue
+			}
+
+			if err := c.List(ctx, objects,
+				client.InNamespace(object.GetNamespace()),
+				client.MatchingFields{field: object.GetName()},
+			); err != nil {
+				log.FromContext(ctx).Error(err, "unable to list referencing objects", "field", field, "name", object.GetName())
+
+				continue
+			}
+
+			items, err := meta.ExtractList(objects)
+			if err != nil {
+				log.FromContext(ctx).Error(err, "unable to extract referencing objects", "field", field, "name", object.GetName())
+
+				continue
+			}
+
+			for _, item := range items {
+				referencing, ok := item.(client.Object)
+				if !ok {
+					continue
+				}
+
+				name := types.NamespacedName{Namespace: referencing.GetNamespace(), Name: referencing.GetName()}
+				if found[name] {
+					continue
+				}
+
+				found[name] = true
+				requests = append(requests, reconcile.Request{NamespacedName: name})
+			}
+		}
+
+		return requests
+	})
+}
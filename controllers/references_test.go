@@ -0,0 +1,142 @@
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/internal/controllertest"
+)
+
+const (
+	testBindPasswordField = ".spec.bindPassword.name"
+	testCAField           = ".spec.ca.name"
+)
+
+// testIndexer stores the field indexers which are registered so that they may be added to a
+// fake client.
+type testIndexer struct {
+	indexers map[string]client.IndexerFunc
+}
+
+func (indexer *testIndexer) IndexField(_ context.Context, _ client.Object, field string, extract client.IndexerFunc) error {
+	indexer.indexers[field] = extract
+
+	return nil
+}
+
+// testLDAP returns an ldap identity provider which references a bind password secret and a ca config map.
+func testLDAP(namespace, name, bindPassword, ca string) *ocmv1alpha1.LDAPIdentityProvider {
+	return &ocmv1alpha1.LDAPIdentityProvider{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Spec: ocmv1alpha1.LDAPIdentityProviderSpec{
+			BindPassword: configv1.SecretNameReference{Name: bindPassword},
+			CA:           configv1.ConfigMapNameReference{Name: ca},
+		},
+	}
+}
+
+func TestEnqueueReferencing(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		object client.Object
+		fields []string
+		want   []string
+	}{
+		{
+			name:   "ensure secret change enqueues only the objects which reference it",
+			object: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "bind-password"}},
+			fields: []string{testBindPasswordField},
+			want:   []string{"test/first", "test/second"},
+		},
+		{
+			name:   "ensure config map change enqueues only the objects which reference it",
+			object: &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "ca"}},
+			fields: []string{testCAField},
+			want:   []string{"test/first"},
+		},
+		{
+			name:   "ensure object which references a change by many fields is enqueued once",
+			object: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "shared"}},
+			fields: []string{testBindPasswordField, testCAField},
+			want:   []string{"test/shared"},
+		},
+		{
+			name:   "ensure change which is not referenced enqueues nothing",
+			object: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "test", Name: "unreferenced"}},
+			fields: []string{testBindPasswordField},
+			want:   []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			indexer := &testIndexer{indexers: map[string]client.IndexerFunc{}}
+			if err := IndexReferences(context.Background(), indexer, &ocmv1alpha1.LDAPIdentityProvider{},
+				ReferenceIndex{
+					Field: testBindPasswordField,
+					Extract: func(object client.Object) []string {
+						return []string{object.(*ocmv1alpha1.LDAPIdentityProvider).Spec.BindPassword.Name}
+					},
+				},
+				ReferenceIndex{
+					Field: testCAField,
+					Extract: func(object client.Object) []string {
+						return []string{object.(*ocmv1alpha1.LDAPIdentityProvider).Spec.CA.Name}
+					},
+				},
+			); err != nil {
+				t.Fatalf("IndexReferences() error = %v", err)
+			}
+
+			builder := fake.NewClientBuilder().
+				WithScheme(controllertest.NewScheme(t)).
+				WithObjects(
+					testLDAP("test", "first", "bind-password", "ca"),
+					testLDAP("test", "second", "bind-password", ""),
+					testLDAP("test", "other", "other", "other"),
+					testLDAP("test", "shared", "shared", "shared"),
+					testLDAP("other", "first", "bind-password", "ca"),
+				)
+
+			for field, extract := range indexer.indexers {
+				builder = builder.WithIndex(&ocmv1alpha1.LDAPIdentityProvider{}, field, extract)
+			}
+
+			queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+			defer queue.ShutDown()
+
+			EnqueueReferencing(builder.Build(), &ocmv1alpha1.LDAPIdentityProviderList{}, tt.fields...).
+				Update(context.Background(), event.UpdateEvent{ObjectOld: tt.object, ObjectNew: tt.object}, queue)
+
+			got := []string{}
+			for queue.Len() > 0 {
+				item, _ := queue.Get()
+				got = append(got, item.(reconcile.Request).String())
+				queue.Done(item)
+			}
+
+			sort.Strings(got)
+
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("EnqueueReferencing() requests = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
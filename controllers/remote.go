@@ -0,0 +1,86 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm"
+)
+
+var (
+	ErrMissingKubeconfig = errors.New("unable to locate kubeconfig in secret")
+)
+
+// Access to read the ROSA clusters and their kubeconfig secrets is needed so that the operator
+// can access remote clusters when it is running in a separate management cluster.
+
+//+kubebuilder:rbac:groups=ocm.mobb.redhat.com,resources=rosaclusters,verbs=get;list;watch
+//+kubebuilder:rbac:groups="",resources=secrets,verbs=get;list;watch
+
+// RemoteClient returns a client for the cluster with the given OCM cluster ID.  The kubeconfig used
+// to build the client is retrieved from the secret referenced by the ROSACluster resource of the
+// cluster, if one exists in the same namespace as the requesting object.  Otherwise, the admin
+// kubeconfig of the cluster is retrieved from OCM, but only if the factory permits the use of admin
+// credentials.  It returns nil if no kubeconfig is available for the cluster.
+func RemoteClient(
+	ctx context.Context,
+	c kubernetes.Client,
+	connection *sdk.Connection,
+	factory *kubernetes.RemoteClientFactory,
+	namespace, clusterID string,
+) (client.Client, error) {
+	if factory == nil || clusterID == "" {
+		return nil, nil
+	}
+
+	// only search the namespace of the requesting object so that objects may not use the
+	// credentials which are stored in other namespaces
+	cluster, err := (&ocmv1alpha1.ROSACluster{}).FindByClusterID(ctx, c, namespace, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	var kubeconfig string
+
+	if cluster != nil && cluster.Spec.KubeconfigSecret.Name != "" {
+		kubeconfig, err = kubernetes.GetSecretData(
+			ctx,
+			c,
+			cluster.Spec.KubeconfigSecret.Name,
+			cluster.Namespace,
+			ocmv1alpha1.ROSAClusterKubeconfigKey,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if kubeconfig == "" {
+			return nil, fmt.Errorf(
+				"%w [%s/%s] with key [%s]",
+				ErrMissingKubeconfig,
+				cluster.Namespace,
+				cluster.Spec.KubeconfigSecret.Name,
+				ocmv1alpha1.ROSAClusterKubeconfigKey,
+			)
+		}
+	} else {
+		kubeconfig, err = factory.AdminKubeconfig(clusterID, func() (string, error) {
+			return ocm.NewClusterClient(connection, "").GetKubeconfig(ctx, clusterID)
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve kubeconfig for cluster [%s] - %w", clusterID, err)
+		}
+
+		if kubeconfig == "" {
+			return nil, nil
+		}
+	}
+
+	return factory.For(clusterID, kubeconfig)
+}
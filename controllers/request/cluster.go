@@ -1,8 +1,8 @@
 package request
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 	"reflect"
 
 	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -25,8 +25,8 @@ type Cluster interface {
 // ClusterFetcher is an interface that represents a client that
 // fetches a cluster.  It is mostly used for testing purposes.
 type ClusterFetcher interface {
-	Get() (*clustersmgmtv1.Cluster, error)
-	For(string) *clustersmgmtv1.ClusterClient
+	Get(context.Context) (*clustersmgmtv1.Cluster, error)
+	GetByID(context.Context, string) (*clustersmgmtv1.Cluster, error)
 }
 
 // GetUpstreamCluster finds the actual cluster from OCM and sets the relevant cluster status fields on
@@ -37,7 +37,7 @@ func GetUpstreamCluster(request Cluster, client ClusterFetcher) (cluster *cluste
 	// retrieve the cluster
 	if request.GetObject().GetClusterID() == "" {
 		// retrieve the cluster from ocm
-		cluster, err = client.Get()
+		cluster, err = client.Get(request.GetContext())
 		if err != nil {
 			return cluster, fmt.Errorf("%s: [%s] - %w", errRetrieveClusterMessage, request.GetClusterName(), err)
 		}
@@ -51,17 +51,15 @@ func GetUpstreamCluster(request Cluster, client ClusterFetcher) (cluster *cluste
 			return cluster, fmt.Errorf("%s: [%s] - %w", errRetrieveClusterMessage, request.GetClusterName(), ErrMissingClusterID)
 		}
 	} else {
-		var response *clustersmgmtv1.ClusterGetResponse
-
 		// retrieve the cluster from ocm by id
-		response, err = client.For(request.GetObject().GetClusterID()).Get().Send()
+		cluster, err = client.GetByID(request.GetContext(), request.GetObject().GetClusterID())
 		if err != nil {
-			if response.Status() == http.StatusNotFound {
-				return cluster, nil
-			}
+			return cluster, fmt.Errorf("%s: [%s] - %w", errRetrieveClusterMessage, request.GetClusterName(), err)
 		}
 
-		cluster = response.Body()
+		if cluster == nil {
+			return nil, nil
+		}
 	}
 
 	// keep track of the original object
@@ -3,10 +3,16 @@ package request
 import (
 	"errors"
 	"fmt"
+	"net/http"
+
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
 )
 
 const (
 	errRetrieveClusterMessage = "unable to retrieve cluster from ocm"
+
+	ReasonInvalidConfiguration = "InvalidConfiguration"
+	ReasonOCMRequestRejected   = "OCMRequestRejected"
 )
 
 var (
@@ -33,3 +39,56 @@ func Error(request Request, err error) error {
 func TypeConvertError(t interface{}) error {
 	return fmt.Errorf("unable to convert request.Request interface to underlying request type [%T]", t)
 }
+
+// TerminalError represents an error which will not be resolved by retrying the request, such as
+// an invalid configuration or a request which was rejected by OCM.  Requests which fail with a
+// terminal error are not retried until the object is changed.
+type TerminalError struct {
+	Reason  string
+	Message string
+	Err     error
+}
+
+// Error returns the error message.  It is used to satisfy the error interface.
+func (err *TerminalError) Error() string {
+	return err.Err.Error()
+}
+
+// Unwrap returns the underlying error.
+func (err *TerminalError) Unwrap() error {
+	return err.Err
+}
+
+// NewValidationError returns a terminal error indicating that the configuration of an object
+// is invalid.
+func NewValidationError(message string) error {
+	return &TerminalError{
+		Reason: ReasonInvalidConfiguration,
+		Err:    errors.New(message),
+	}
+}
+
+// AsTerminal returns the terminal error of an error, if it is terminal.  In addition to errors
+// which are explicitly terminal, requests which OCM rejected as invalid (400 and 422) are terminal.
+// All other errors, such as server errors (5xx), throttled requests (429) and timeouts, are
+// transient.
+func AsTerminal(err error) (*TerminalError, bool) {
+	var terminal *TerminalError
+	if errors.As(err, &terminal) {
+		return terminal, true
+	}
+
+	var ocmErr *ocmerrors.Error
+	if errors.As(err, &ocmErr) {
+		switch ocmErr.Status() {
+		case http.StatusBadRequest, http.StatusUnprocessableEntity:
+			return &TerminalError{
+				Reason:  ReasonOCMRequestRejected,
+				Message: fmt.Sprintf("%s: %s", ocmErr.Code(), ocmErr.Reason()),
+				Err:     err,
+			}, true
+		}
+	}
+
+	return nil, false
+}
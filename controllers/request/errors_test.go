@@ -0,0 +1,80 @@
+package request
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	ocmerrors "github.com/openshift-online/ocm-sdk-go/errors"
+)
+
+func TestAsTerminal(t *testing.T) {
+	t.Parallel()
+
+	ocmError := func(status int) error {
+		err, buildErr := ocmerrors.NewError().Status(status).Code("CLUSTERS-MGMT-400").Reason("invalid request").Build()
+		if buildErr != nil {
+			t.Fatalf("unable to build ocm error - %v", buildErr)
+		}
+
+		return fmt.Errorf("unable to create cluster - %w", err)
+	}
+
+	tests := []struct {
+		name       string
+		err        error
+		want       bool
+		wantReason string
+	}{
+		{
+			name:       "ensure validation error is terminal",
+			err:        fmt.Errorf("phase failed - %w", NewValidationError("invalid subnet")),
+			want:       true,
+			wantReason: ReasonInvalidConfiguration,
+		},
+		{
+			name:       "ensure ocm bad request is terminal",
+			err:        ocmError(http.StatusBadRequest),
+			want:       true,
+			wantReason: ReasonOCMRequestRejected,
+		},
+		{
+			name:       "ensure ocm unprocessable entity is terminal",
+			err:        ocmError(http.StatusUnprocessableEntity),
+			want:       true,
+			wantReason: ReasonOCMRequestRejected,
+		},
+		{
+			name: "ensure ocm server error is transient",
+			err:  ocmError(http.StatusInternalServerError),
+			want: false,
+		},
+		{
+			name: "ensure ocm throttled request is transient",
+			err:  ocmError(http.StatusTooManyRequests),
+			want: false,
+		},
+		{
+			name: "ensure generic error is transient",
+			err:  errors.New("connection reset"),
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := AsTerminal(tt.err)
+			if ok != tt.want {
+				t.Fatalf("AsTerminal() ok = %v, want %v", ok, tt.want)
+			}
+
+			if ok && got.Reason != tt.wantReason {
+				t.Errorf("AsTerminal() reason = %v, want %v", got.Reason, tt.wantReason)
+			}
+		})
+	}
+}
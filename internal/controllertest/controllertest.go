@@ -0,0 +1,85 @@
+// Package controllertest provides the fake cluster, and the dependencies shared by each of the
+// controllers, for testing the phases of the controllers.  It is kept separate from ocmtest as
+// the api types which it registers depend on the ocm package, which is tested with ocmtest.
+package controllertest
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm/ocmtest"
+)
+
+// Dependencies are the dependencies which are shared by each of the controllers.
+type Dependencies struct {
+	Client     client.Client
+	Scheme     *runtime.Scheme
+	Connection *sdk.Connection
+	Recorder   *record.FakeRecorder
+	Logger     logr.Logger
+}
+
+// New returns the dependencies of a controller, with a fake cluster which stores the given
+// objects and a connection to the given fake OpenShift Cluster Manager API.  The connection is
+// nil if no server is given, for testing phases which do not interact with OpenShift Cluster
+// Manager.
+func New(t *testing.T, server *ocmtest.Server, objects ...client.Object) *Dependencies {
+	t.Helper()
+
+	scheme := NewScheme(t)
+
+	dependencies := &Dependencies{
+		Client:   NewClient(scheme, objects...),
+		Scheme:   scheme,
+		Recorder: record.NewFakeRecorder(10),
+		Logger:   logr.Discard(),
+	}
+
+	if server != nil {
+		dependencies.Connection = server.Connection(t)
+	}
+
+	return dependencies
+}
+
+// NewScheme returns a scheme with the types which the controllers interact with.
+func NewScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+
+	scheme := runtime.NewScheme()
+	for _, add := range []func(*runtime.Scheme) error{ocmv1alpha1.AddToScheme, corev1.AddToScheme} {
+		if err := add(scheme); err != nil {
+			t.Fatalf("unable to build scheme - %v", err)
+		}
+	}
+
+	return scheme
+}
+
+// NewClient returns a client for a fake cluster which stores the given objects.  The status of
+// the api types is a subresource, as it is in a real cluster, so that it is only updated by a
+// status patch.
+func NewClient(scheme *runtime.Scheme, objects ...client.Object) client.Client {
+	statuses := []client.Object{}
+
+	for _, object := range objects {
+		kinds, _, err := scheme.ObjectKinds(object)
+		if err == nil && kinds[0].Group == ocmv1alpha1.GroupVersion.Group {
+			statuses = append(statuses, object)
+		}
+	}
+
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objects...).
+		WithStatusSubresource(statuses...).
+		Build()
+}
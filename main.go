@@ -17,7 +17,9 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
+	"net/http"
 	"os"
 	"time"
 
@@ -32,19 +34,37 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
 
 	ocmv1alpha1 "github.com/rh-mobb/ocm-operator/api/v1alpha1"
 	"github.com/rh-mobb/ocm-operator/controllers"
+	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/addon"
+	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/clusterautoscaler"
+	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/clustergroupmembership"
+	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/clusterrollout"
 	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/gitlabidentityprovider"
+	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/googleidentityprovider"
+	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/htpasswdidentityprovider"
+	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/ingress"
+	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/kubeletconfig"
 	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/ldapidentityprovider"
 	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/machinepool"
 	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/rosacluster"
+	"github.com/rh-mobb/ocm-operator/controllers/reconcilers/tuningconfig"
+	"github.com/rh-mobb/ocm-operator/pkg/kubernetes"
+	"github.com/rh-mobb/ocm-operator/pkg/ratelimit"
+	"github.com/rh-mobb/ocm-operator/pkg/tracing"
 	//+kubebuilder:scaffold:imports
 )
 
 const (
 	defaultPollerIntervalMinutes = 5
+	defaultOCMQPS                = 10
+	defaultOCMBurst              = 20
+	defaultAWSQPS                = 5
+	defaultAWSBurst              = 10
 	tokenEnvKey                  = "OCM_TOKEN"
+	ocmMetricsSubsystem          = "ocm_operator_ocm"
 )
 
 var (
@@ -70,6 +90,13 @@ func main() {
 			"Enabling this will ensure there is only one active controller manager.")
 	flag.IntVar(&config.PollerIntervalMinutes, "poller-interval", defaultPollerIntervalMinutes, "Default interval, in minutes, by "+
 		"which the controller should reconcile desired state.")
+	config.OCMRateLimit.BindFlags(flag.CommandLine, "ocm", defaultOCMQPS, defaultOCMBurst)
+	config.AWSRateLimit.BindFlags(flag.CommandLine, "aws", defaultAWSQPS, defaultAWSBurst)
+	flag.BoolVar(&config.RemoteAdminCredentials, "remote-admin-credentials", false, "Retrieve the admin credentials of a "+
+		"cluster from OCM to access it when it has no kubeconfig secret.")
+	flag.DurationVar(&config.RemoteAdminCredentialsTTL, "remote-admin-credentials-ttl", kubernetes.DefaultCredentialsTTL,
+		"Duration for which the admin credentials of a cluster which were retrieved from OCM are reused.")
+	config.Tracing.BindFlags(flag.CommandLine)
 	opts := zap.Options{
 		Development: true,
 	}
@@ -102,6 +129,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	// set up the exporter of traces.  tracing is disabled unless an endpoint is configured.
+	shutdownTracing, err := tracing.Setup(context.Background(), config.Tracing)
+	if err != nil {
+		setupLog.Error(err, "unable to set up tracing")
+		os.Exit(1)
+	}
+
 	// load the token and create the ocm client
 	token, tokenExists := os.LookupEnv(tokenEnvKey)
 	if !tokenExists {
@@ -109,15 +143,37 @@ func main() {
 		os.Exit(1)
 	}
 
-	// create the connection
+	// create the connection.  requests are retried by the rate limited transport, which
+	// honors the delay requested by the server, rather than by the connection itself.  the
+	// metrics of the requests are served alongside the metrics of the manager.  the tracing
+	// transport is registered first so that it wraps the retries of the rate limited transport.
+	ocmLimiter := config.OCMRateLimit.NewLimiter()
 	connection, err := sdk.NewConnectionBuilder().
 		Tokens(token).
+		RetryLimit(0).
+		MetricsSubsystem(ocmMetricsSubsystem).
+		MetricsRegisterer(metrics.Registry).
+		TransportWrapper(func(next http.RoundTripper) http.RoundTripper {
+			return tracing.NewTransport(next)
+		}).
+		TransportWrapper(func(next http.RoundTripper) http.RoundTripper {
+			return ratelimit.NewTransport(next, ocmLimiter, config.OCMRateLimit)
+		}).
 		Build()
 	if err != nil {
 		setupLog.Error(err, "unable to create ocm client", "environment variable", tokenEnvKey)
 		os.Exit(1)
 	}
 
+	// create the factory used to access remote clusters when running in a management cluster.  the
+	// admin credentials of the clusters are only used when explicitly enabled.
+	var adminCredentialsTTL time.Duration
+	if config.RemoteAdminCredentials {
+		adminCredentialsTTL = config.RemoteAdminCredentialsTTL
+	}
+
+	remoteClients := kubernetes.NewRemoteClientFactory(mgr.GetScheme(), adminCredentialsTTL)
+
 	if err = (&machinepool.Controller{
 		Connection: connection,
 		Client:     mgr.GetClient(),
@@ -125,6 +181,8 @@ func main() {
 		Recorder:   mgr.GetEventRecorderFor("machinepool-controller"),
 		Interval:   time.Duration(config.PollerIntervalMinutes) * time.Minute,
 		Logger:     ctrl.Log.WithName("machinepool-controller"),
+
+		RemoteClients: remoteClients,
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "MachinePool")
 		os.Exit(1)
@@ -158,10 +216,112 @@ func main() {
 		Recorder:   mgr.GetEventRecorderFor("rosa-cluster-controller"),
 		Interval:   time.Duration(config.PollerIntervalMinutes) * time.Minute,
 		Logger:     ctrl.Log.WithName("rosa-cluster-controller"),
+
+		AWSRateLimits: ratelimit.NewLimiters(config.AWSRateLimit),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "Cluster")
 		os.Exit(1)
 	}
+	if err = (&htpasswdidentityprovider.Controller{
+		Connection: connection,
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorderFor("htpasswd-idp-controller"),
+		Interval:   time.Duration(config.PollerIntervalMinutes) * time.Minute,
+		Logger:     ctrl.Log.WithName("htpasswd-idp-controller"),
+
+		ChecksumKey: []byte(token),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "HTPasswdIdentityProvider")
+		os.Exit(1)
+	}
+	if err = (&googleidentityprovider.Controller{
+		Connection: connection,
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorderFor("google-idp-controller"),
+		Interval:   time.Duration(config.PollerIntervalMinutes) * time.Minute,
+		Logger:     ctrl.Log.WithName("google-idp-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "GoogleIdentityProvider")
+		os.Exit(1)
+	}
+	if err = (&clustergroupmembership.Controller{
+		Connection: connection,
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorderFor("cluster-group-membership-controller"),
+		Interval:   time.Duration(config.PollerIntervalMinutes) * time.Minute,
+		Logger:     ctrl.Log.WithName("cluster-group-membership-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterGroupMembership")
+		os.Exit(1)
+	}
+	if err = (&kubeletconfig.Controller{
+		Connection: connection,
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorderFor("kubelet-config-controller"),
+		Interval:   time.Duration(config.PollerIntervalMinutes) * time.Minute,
+		Logger:     ctrl.Log.WithName("kubelet-config-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "KubeletConfig")
+		os.Exit(1)
+	}
+	if err = (&tuningconfig.Controller{
+		Connection: connection,
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorderFor("tuning-config-controller"),
+		Interval:   time.Duration(config.PollerIntervalMinutes) * time.Minute,
+		Logger:     ctrl.Log.WithName("tuning-config-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "TuningConfig")
+		os.Exit(1)
+	}
+	if err = (&clusterautoscaler.Controller{
+		Connection: connection,
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorderFor("cluster-autoscaler-controller"),
+		Interval:   time.Duration(config.PollerIntervalMinutes) * time.Minute,
+		Logger:     ctrl.Log.WithName("cluster-autoscaler-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterAutoscaler")
+		os.Exit(1)
+	}
+	if err = (&ingress.Controller{
+		Connection: connection,
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorderFor("ingress-controller"),
+		Interval:   time.Duration(config.PollerIntervalMinutes) * time.Minute,
+		Logger:     ctrl.Log.WithName("ingress-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "Ingress")
+		os.Exit(1)
+	}
+	if err = (&addon.Controller{
+		Connection: connection,
+		Client:     mgr.GetClient(),
+		Scheme:     mgr.GetScheme(),
+		Recorder:   mgr.GetEventRecorderFor("add-on-controller"),
+		Interval:   time.Duration(config.PollerIntervalMinutes) * time.Minute,
+		Logger:     ctrl.Log.WithName("add-on-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "AddOn")
+		os.Exit(1)
+	}
+	if err = (&clusterrollout.Controller{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Recorder: mgr.GetEventRecorderFor("cluster-rollout-controller"),
+		Interval: time.Duration(config.PollerIntervalMinutes) * time.Minute,
+		Logger:   ctrl.Log.WithName("cluster-rollout-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ClusterRollout")
+		os.Exit(1)
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
@@ -174,8 +334,15 @@ func main() {
 	}
 
 	setupLog.Info("starting manager")
-	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
-		setupLog.Error(err, "problem running manager")
+	startErr := mgr.Start(ctrl.SetupSignalHandler())
+
+	// flush the spans which have not yet been exported
+	if err := shutdownTracing(context.Background()); err != nil {
+		setupLog.Error(err, "unable to shut down tracing")
+	}
+
+	if startErr != nil {
+		setupLog.Error(startErr, "problem running manager")
 
 		if err := connection.Close(); err != nil {
 			setupLog.Error(err, "unable to close ocm connection")
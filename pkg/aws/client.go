@@ -1,12 +1,29 @@
 package aws
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
+	"net/http"
 
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
 	rosa "github.com/openshift/rosa/pkg/aws"
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/time/rate"
+
+	"github.com/rh-mobb/ocm-operator/pkg/ratelimit"
+	"github.com/rh-mobb/ocm-operator/pkg/tracing"
+)
+
+const (
+	rpcService = "aws"
+
+	errorCodeKey = attribute.Key("aws.error.code")
 )
 
 var (
@@ -15,11 +32,17 @@ var (
 
 type Client struct {
 	Connection rosa.Client
+	AccountID  string
+
+	limiter *rate.Limiter
+	retry   ratelimit.Config
 }
 
 // NewClient returns a new instance of an AWS client.  This client is loaded
-// from the rosa package to maintain consistency and supportability.
-func NewClient(region string) (*Client, error) {
+// from the rosa package to maintain consistency and supportability.  Calls made
+// with the client are limited by the limiter of the AWS account, which is shared
+// with all other clients for the same account.
+func NewClient(region string, limiters *ratelimit.Limiters) (*Client, error) {
 	// create the client from the rosa package
 	aws, err := rosa.NewClient().
 		Logger(&logrus.Logger{Out: io.Discard}).
@@ -29,5 +52,74 @@ func NewClient(region string) (*Client, error) {
 		return &Client{}, fmt.Errorf("unable to create aws client - %w", err)
 	}
 
-	return &Client{Connection: aws}, nil
+	// retrieve the account of the client so that calls are limited per account
+	creator, err := aws.GetCreator()
+	if err != nil {
+		return &Client{}, fmt.Errorf("unable to retrieve aws account - %w", err)
+	}
+
+	client := &Client{Connection: aws, AccountID: creator.AccountID}
+
+	if limiters != nil {
+		client.limiter = limiters.For(creator.AccountID)
+		client.retry = limiters.Config
+	}
+
+	return client, nil
+}
+
+// Call calls a function which makes requests to AWS once the limiter of the AWS account allows it.
+// Throttled calls are retried as they were not processed by AWS, while calls which failed with a
+// server error, including a 503 which may have come from a call that was partially processed, are
+// only retried if the call is idempotent.  Each attempt is recorded in the metrics of the operation,
+// and the call, including its retries, is traced as a child of the context.
+func (awsClient *Client) Call(ctx context.Context, operation string, idempotent bool, call func() error) (err error) {
+	limiter := awsClient.limiter
+	if limiter == nil {
+		limiter = rate.NewLimiter(rate.Inf, 0)
+	}
+
+	ctx, span := tracing.Start(ctx, fmt.Sprintf("AWS %s", operation),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.RPCService(rpcService),
+			semconv.RPCMethod(operation),
+			semconv.CloudAccountID(awsClient.AccountID),
+		),
+	)
+
+	defer func() {
+		if err != nil {
+			span.SetAttributes(errorCodeKey.String(errorCode(err)))
+		}
+
+		tracing.End(span, err)
+	}()
+
+	return ratelimit.Do(ctx, limiter, awsClient.retry, func(err error) bool {
+		return retryable(err, idempotent)
+	}, func() error {
+		return observe(operation, call)
+	})
+}
+
+// retryable determines if a call which returned an error should be retried.  AWS does not return
+// the Retry-After header, so a 503 may only be identified as throttled by its error code.
+func retryable(err error, idempotent bool) bool {
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) && request.IsErrorThrottle(awsErr) {
+		return true
+	}
+
+	var failure awserr.RequestFailure
+	if errors.As(err, &failure) {
+		switch {
+		case failure.StatusCode() == http.StatusTooManyRequests:
+			return true
+		case failure.StatusCode() >= http.StatusInternalServerError:
+			return idempotent
+		}
+	}
+
+	return false
 }
@@ -0,0 +1,67 @@
+package aws
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+)
+
+func Test_retryable(t *testing.T) {
+	t.Parallel()
+
+	failure := func(code string, status int) error {
+		return fmt.Errorf("wrapped - %w", awserr.NewRequestFailure(awserr.New(code, "test", nil), status, "request-id"))
+	}
+
+	tests := []struct {
+		name       string
+		err        error
+		idempotent bool
+		want       bool
+	}{
+		{
+			name: "ensure throttled call is retried regardless of idempotency",
+			err:  failure("Throttling", http.StatusBadRequest),
+			want: true,
+		},
+		{
+			name: "ensure too many requests is retried regardless of idempotency",
+			err:  failure("TooManyRequests", http.StatusTooManyRequests),
+			want: true,
+		},
+		{
+			name: "ensure unavailable service is not retried for non-idempotent call",
+			err:  failure("ServiceUnavailable", http.StatusServiceUnavailable),
+		},
+		{
+			name:       "ensure unavailable service is retried for idempotent call",
+			err:        failure("ServiceUnavailable", http.StatusServiceUnavailable),
+			idempotent: true,
+			want:       true,
+		},
+		{
+			name:       "ensure client error is not retried",
+			err:        failure("AccessDenied", http.StatusForbidden),
+			idempotent: true,
+		},
+		{
+			name:       "ensure error which did not come from aws is not retried",
+			err:        errors.New("test"),
+			idempotent: true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := retryable(tt.err, tt.idempotent); got != tt.want {
+				t.Errorf("retryable() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -3,6 +3,7 @@ package aws
 //nolint:gosec
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"crypto/sha1"
 	"encoding/hex"
@@ -32,14 +33,18 @@ var (
 // CreateOIDCProvider creates an IAM OIDC Identity Provider in AWS.  It uses the
 // libraries from the rosa CLI to accomplish this in order to maintain consistent
 // and supportable behavior.
-func (awsClient *Client) CreateOIDCProvider(issuerURL string) (providerARN string, err error) {
+func (awsClient *Client) CreateOIDCProvider(ctx context.Context, issuerURL string) (providerARN string, err error) {
 	thumbprint, err := waitForThumbprint(issuerURL)
 	if err != nil {
 		return providerARN, fmt.Errorf("unable to retrieve oidc provider thumbprint - %w", err)
 	}
 
 	// create the oidc provider
-	providerARN, err = awsClient.Connection.CreateOpenIDConnectProvider(issuerURL, thumbprint, "")
+	err = awsClient.Call(ctx, "CreateOpenIDConnectProvider", false, func() (err error) {
+		providerARN, err = awsClient.Connection.CreateOpenIDConnectProvider(issuerURL, thumbprint, "")
+
+		return err
+	})
 	if err != nil {
 		return providerARN, fmt.Errorf("unable to create oidc provider - %w", err)
 	}
@@ -50,9 +55,11 @@ func (awsClient *Client) CreateOIDCProvider(issuerURL string) (providerARN strin
 // DeleteOIDCProvider deletes an IAM OIDC Identity Provider from AWS.  It uses the
 // libraries from the rosa CLI to accomplish this in order to maintain consistent
 // and supportable behavior.
-func (awsClient *Client) DeleteOIDCProvider(oidcProviderARN string) error {
+func (awsClient *Client) DeleteOIDCProvider(ctx context.Context, oidcProviderARN string) error {
 	// delete the oidc provider
-	if err := awsClient.Connection.DeleteOpenIDConnectProvider(oidcProviderARN); err != nil {
+	if err := awsClient.Call(ctx, "DeleteOpenIDConnectProvider", true, func() error {
+		return awsClient.Connection.DeleteOpenIDConnectProvider(oidcProviderARN)
+	}); err != nil {
 		return fmt.Errorf("delete oidc provider - %w", err)
 	}
 
@@ -0,0 +1,63 @@
+package aws
+
+import (
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	codeSuccess = "OK"
+	codeUnknown = "Unknown"
+)
+
+var (
+	requestDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "ocm_operator_aws_request_duration_seconds",
+			Help:    "Time taken by a request to AWS.",
+			Buckets: prometheus.ExponentialBuckets(0.05, 2, 10),
+		},
+		[]string{"operation"},
+	)
+
+	requestsTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ocm_operator_aws_requests_total",
+			Help: "Number of requests to AWS, by the error code which was returned.",
+		},
+		[]string{"operation", "code"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(requestDuration, requestsTotal)
+}
+
+// observe records the duration and the result of a request to AWS.
+func observe(operation string, call func() error) error {
+	start := time.Now()
+	err := call()
+
+	requestDuration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+	requestsTotal.WithLabelValues(operation, errorCode(err)).Inc()
+
+	return err
+}
+
+// errorCode returns the AWS error code of an error, such as AccessDenied or Throttling.
+func errorCode(err error) string {
+	if err == nil {
+		return codeSuccess
+	}
+
+	var awsErr awserr.Error
+	if errors.As(err, &awsErr) {
+		return awsErr.Code()
+	}
+
+	return codeUnknown
+}
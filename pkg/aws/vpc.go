@@ -1,14 +1,23 @@
 package aws
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // GetAvailabilityZonesBySubnet returns the availability zone ids for a list of
 // subnet IDs.
-func (awsClient *Client) GetAvailabilityZonesBySubnet(subnetIDs []string) ([]string, error) {
+func (awsClient *Client) GetAvailabilityZonesBySubnet(ctx context.Context, subnetIDs []string) ([]string, error) {
 	availabilityZones := make([]string, len(subnetIDs))
 
 	for i := range subnetIDs {
-		availabilityZone, err := awsClient.Connection.GetSubnetAvailabilityZone(subnetIDs[i])
+		var availabilityZone string
+
+		err := awsClient.Call(ctx, "GetSubnetAvailabilityZone", true, func() (err error) {
+			availabilityZone, err = awsClient.Connection.GetSubnetAvailabilityZone(subnetIDs[i])
+
+			return err
+		})
 		if err != nil {
 			return availabilityZones, fmt.Errorf(
 				"unable to retrieve subnet id [%s] - %w",
@@ -3,35 +3,68 @@ package identityprovider
 import (
 	"errors"
 	"fmt"
+	"net/http"
 	"reflect"
 
 	"github.com/xanzy/go-gitlab"
 )
 
+const (
+	gitLabAPIPath = "/api/v4"
+)
+
 var (
 	ErrMissingApplication = errors.New("unable to find gitlab application")
+	ErrApplicationDeleted = errors.New("gitlab application was deleted but not recreated")
 )
 
 type GitLab struct {
 	Client *gitlab.Client
 }
 
-func (glc *GitLab) GetApplication(name string) (*gitlab.Application, error) {
-	// list all applications
-	applications, _, err := glc.Client.Applications.ListApplications(&gitlab.ListApplicationsOptions{})
+// NewGitLab returns a GitLab object with a client used to interact with the GitLab API
+// at a particular URL.
+func NewGitLab(url, accessToken string) (*GitLab, error) {
+	client, err := gitlab.NewClient(accessToken, gitlab.WithBaseURL(url+gitLabAPIPath))
 	if err != nil {
-		return &gitlab.Application{}, fmt.Errorf("unable to list gitlab applications - %w", err)
+		return nil, fmt.Errorf("error creating gitlab api client - %w", err)
 	}
 
-	// find the correct application based on the name
-	for i := range applications {
-		if applications[i].ApplicationName == name {
-			return applications[i], nil
+	return &GitLab{Client: client}, nil
+}
+
+// GetApplication finds an application by its id.  Application names are not unique within a GitLab
+// instance, so the name is only used to find the application when its id is not yet known (0).
+func (glc *GitLab) GetApplication(id int, name string) (*gitlab.Application, error) {
+	options := &gitlab.ListApplicationsOptions{}
+
+	for {
+		// list all applications
+		applications, response, err := glc.Client.Applications.ListApplications(options)
+		if err != nil {
+			return &gitlab.Application{}, fmt.Errorf("unable to list gitlab applications - %w", err)
+		}
+
+		// find the correct application based on the id, or the name if the id is unknown
+		for i := range applications {
+			if id != 0 && applications[i].ID == id {
+				return applications[i], nil
+			}
+
+			if id == 0 && applications[i].ApplicationName == name {
+				return applications[i], nil
+			}
+		}
+
+		if response.NextPage == 0 {
+			break
 		}
+
+		options.Page = response.NextPage
 	}
 
 	// return a nil object if we were unable to find any
-	return nil, fmt.Errorf("unable to find gitlab application [%s] - %w", name, ErrMissingApplication)
+	return nil, fmt.Errorf("unable to find gitlab application [id=%d, name=%s] - %w", id, name, ErrMissingApplication)
 }
 
 func (glc *GitLab) CreateApplication(name, callbackURL string) (*gitlab.Application, error) {
@@ -49,21 +82,38 @@ func (glc *GitLab) CreateApplication(name, callbackURL string) (*gitlab.Applicat
 	return application, nil
 }
 
-func (glc *GitLab) UpdateApplication(name, callbackURL string) (*gitlab.Application, error) {
-	// create the application
-	application, _, err := glc.Client.Applications.CreateApplication(&gitlab.CreateApplicationOptions{
-		Name:         gitlab.String(name),
-		RedirectURI:  gitlab.String(callbackURL),
-		Scopes:       gitlab.String("openid"),
-		Confidential: gitlab.Bool(true),
-	})
+// UpdateApplication updates an existing application.  The GitLab applications API does not
+// provide a method to update an application, so the application is deleted and recreated.  This
+// means that the returned application will have a new client ID and secret.  If the application is
+// deleted but unable to be recreated, the returned error wraps ErrApplicationDeleted so that callers
+// may stop referencing the deleted application.
+func (glc *GitLab) UpdateApplication(id int, name, callbackURL string) (*gitlab.Application, error) {
+	if err := glc.DeleteApplication(id); err != nil {
+		return &gitlab.Application{}, fmt.Errorf("unable to update gitlab application - %w", err)
+	}
+
+	application, err := glc.CreateApplication(name, callbackURL)
 	if err != nil {
-		return &gitlab.Application{}, fmt.Errorf("unable to create gitlab application - %w", err)
+		return &gitlab.Application{}, fmt.Errorf("unable to update gitlab application - %w: %w", ErrApplicationDeleted, err)
 	}
 
 	return application, nil
 }
 
+func (glc *GitLab) DeleteApplication(id int) error {
+	// delete the application
+	response, err := glc.Client.Applications.DeleteApplication(id)
+	if err != nil {
+		if response != nil && response.StatusCode == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("unable to delete gitlab application - %w", err)
+	}
+
+	return nil
+}
+
 func EqualGitLab(compare, with gitlab.Application) bool {
 	// ignore the id as this is simply the id of the backend object
 	compare.ID = with.ID
@@ -0,0 +1,49 @@
+package kubernetes
+
+import (
+	"context"
+	"fmt"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	clusterVersionName = "version"
+)
+
+var (
+	clusterVersionGVK = schema.GroupVersionKind{
+		Group:   "config.openshift.io",
+		Version: "v1",
+		Kind:    "ClusterVersion",
+	}
+)
+
+// GetClusterID returns the unique identifier of the OpenShift cluster in which the controller is
+// running.  This matches the external ID of the cluster in OpenShift Cluster Manager.  An empty string is
+// returned if the controller is not running in an OpenShift cluster.
+func GetClusterID(ctx context.Context, c Client) (string, error) {
+	// use an unstructured object so that the openshift config api does not need to be registered
+	// with the scheme and so that the object is read directly rather than from the cache
+	clusterVersion := &unstructured.Unstructured{}
+	clusterVersion.SetGroupVersionKind(clusterVersionGVK)
+
+	if err := c.Get(ctx, client.ObjectKey{Name: clusterVersionName}, clusterVersion); err != nil {
+		if apierrs.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("unable to retrieve cluster version - %w", err)
+	}
+
+	clusterID, _, err := unstructured.NestedString(clusterVersion.Object, "spec", "clusterID")
+	if err != nil {
+		return "", fmt.Errorf("unable to retrieve cluster id from cluster version - %w", err)
+	}
+
+	return clusterID, nil
+}
@@ -0,0 +1,22 @@
+package kubernetes
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+)
+
+// Hash returns a checksum of data that has been retrieved from objects such as secrets and
+// config maps.  It is used to detect changes to data which is unable to be retrieved from
+// an external API for comparison (e.g. a password).
+func Hash(data ...string) string {
+	hash := sha256.New()
+
+	for _, value := range data {
+		// write the length of each value so that values shifting between positions
+		// produce a different checksum
+		hash.Write([]byte{byte(len(value) >> 24), byte(len(value) >> 16), byte(len(value) >> 8), byte(len(value))})
+		hash.Write([]byte(value))
+	}
+
+	return hex.EncodeToString(hash.Sum(nil))
+}
@@ -0,0 +1,47 @@
+package kubernetes
+
+import "testing"
+
+func TestHash(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		first     []string
+		second    []string
+		wantEqual bool
+	}{
+		{
+			name:      "ensure same data produces the same checksum",
+			first:     []string{"ab", "c"},
+			second:    []string{"ab", "c"},
+			wantEqual: true,
+		},
+		{
+			name:   "ensure data shifting between values produces a different checksum",
+			first:  []string{"ab", "c"},
+			second: []string{"a", "bc"},
+		},
+		{
+			name:   "ensure empty value produces a different checksum",
+			first:  []string{"a"},
+			second: []string{"a", ""},
+		},
+		{
+			name:   "ensure changed data produces a different checksum",
+			first:  []string{"secret"},
+			second: []string{"rotated"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := Hash(tt.first...) == Hash(tt.second...); got != tt.wantEqual {
+				t.Errorf("Hash(%q) == Hash(%q) = %v, want %v", tt.first, tt.second, got, tt.wantEqual)
+			}
+		})
+	}
+}
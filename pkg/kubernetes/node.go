@@ -29,21 +29,29 @@ func GetLabeledNodes(ctx context.Context, c Client, nodeLabels map[string]string
 	return &nodeList, nil
 }
 
-// NodesAreReady determines if nodes are in a ready state.
-//
-//nolint:gocritic
+// NodesAreReady determines if nodes are in a ready state.  A node is only considered ready
+// if its Ready condition is explicitly true.
 func NodesAreReady(nodes ...corev1.Node) bool {
 	if len(nodes) < 1 {
 		return false
 	}
 
+	return ReadyNodeCount(nodes...) == len(nodes)
+}
+
+// ReadyNodeCount returns the number of nodes which are in a ready state.
+//
+//nolint:gocritic
+func ReadyNodeCount(nodes ...corev1.Node) (count int) {
 	for _, node := range nodes {
 		for _, condition := range node.Status.Conditions {
-			if condition.Type == corev1.NodeConditionType(nodeConditionReady) && condition.Status == corev1.ConditionFalse {
-				return false
+			if condition.Type == corev1.NodeConditionType(nodeConditionReady) && condition.Status == corev1.ConditionTrue {
+				count++
+
+				break
 			}
 		}
 	}
 
-	return true
+	return count
 }
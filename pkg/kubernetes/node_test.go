@@ -0,0 +1,53 @@
+package kubernetes
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestReadyNodeCount(t *testing.T) {
+	t.Parallel()
+
+	newNode := func(status corev1.ConditionStatus) corev1.Node {
+		return corev1.Node{
+			Status: corev1.NodeStatus{
+				Conditions: []corev1.NodeCondition{
+					{Type: corev1.NodeReady, Status: status},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name  string
+		nodes []corev1.Node
+		want  int
+	}{
+		{
+			name:  "ensure ready nodes are counted",
+			nodes: []corev1.Node{newNode(corev1.ConditionTrue), newNode(corev1.ConditionTrue)},
+			want:  2,
+		},
+		{
+			name:  "ensure nodes with unknown readiness are not counted",
+			nodes: []corev1.Node{newNode(corev1.ConditionTrue), newNode(corev1.ConditionUnknown)},
+			want:  1,
+		},
+		{
+			name:  "ensure nodes without a ready condition are not counted",
+			nodes: []corev1.Node{newNode(corev1.ConditionFalse), {}},
+			want:  0,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := ReadyNodeCount(tt.nodes...); got != tt.want {
+				t.Errorf("ReadyNodeCount() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package kubernetes
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	remoteClientTimeout = 30 * time.Second
+
+	// DefaultCredentialsTTL is the default duration for which a kubeconfig which was retrieved
+	// from the admin credentials of a cluster is reused before it is retrieved again.
+	DefaultCredentialsTTL = 10 * time.Minute
+)
+
+// RemoteClientFactory builds and caches clients used to access remote clusters, such as when
+// the controller is running in a management cluster rather than the cluster that it manages
+// objects for.  Clients are cached by cluster ID and are rebuilt when the kubeconfig used to
+// build them changes.
+type RemoteClientFactory struct {
+	scheme      *runtime.Scheme
+	clients     map[string]*remoteClient
+	credentials map[string]*remoteCredentials
+	mutex       sync.Mutex
+
+	// credentialsTTL is the duration for which retrieved admin credentials are reused.  The
+	// admin credentials of a cluster are never used if this is zero.
+	credentialsTTL time.Duration
+	now            func() time.Time
+}
+
+type remoteClient struct {
+	client client.Client
+	hash   string
+}
+
+type remoteCredentials struct {
+	kubeconfig string
+	expires    time.Time
+}
+
+// NewRemoteClientFactory returns a factory which builds clients from the given scheme.  The admin
+// credentials of a cluster are used when no other kubeconfig is available only if the given
+// credentials TTL is greater than zero, in which case they are reused for that duration.
+func NewRemoteClientFactory(scheme *runtime.Scheme, credentialsTTL time.Duration) *RemoteClientFactory {
+	return &RemoteClientFactory{
+		scheme:         scheme,
+		clients:        map[string]*remoteClient{},
+		credentials:    map[string]*remoteCredentials{},
+		credentialsTTL: credentialsTTL,
+		now:            time.Now,
+	}
+}
+
+// AdminCredentialsEnabled returns whether the admin credentials of a cluster may be used to
+// access it when no other kubeconfig is available.
+func (factory *RemoteClientFactory) AdminCredentialsEnabled() bool {
+	return factory.credentialsTTL > 0
+}
+
+// AdminKubeconfig returns the admin kubeconfig of the cluster with the given cluster ID.  The
+// kubeconfig is retrieved with the given function and is reused until the credentials TTL of the
+// factory expires, including when no kubeconfig is available, so that the credentials are not
+// retrieved on every request.  It returns an empty kubeconfig if the use of admin credentials
+// is disabled.
+func (factory *RemoteClientFactory) AdminKubeconfig(clusterID string, retrieve func() (string, error)) (string, error) {
+	if !factory.AdminCredentialsEnabled() {
+		return "", nil
+	}
+
+	factory.mutex.Lock()
+	cached, ok := factory.credentials[clusterID]
+	factory.mutex.Unlock()
+
+	if ok && factory.now().Before(cached.expires) {
+		return cached.kubeconfig, nil
+	}
+
+	// retrieve the credentials without holding the lock so that other clusters are not blocked
+	kubeconfig, err := retrieve()
+	if err != nil {
+		return "", err
+	}
+
+	factory.mutex.Lock()
+	defer factory.mutex.Unlock()
+
+	factory.credentials[clusterID] = &remoteCredentials{
+		kubeconfig: kubeconfig,
+		expires:    factory.now().Add(factory.credentialsTTL),
+	}
+
+	return kubeconfig, nil
+}
+
+// For returns a client for the cluster with the given cluster ID which is built from the
+// given kubeconfig.  A cached client is returned if one has previously been built from
+// the same kubeconfig.  The returned client reads directly from the remote cluster
+// rather than from a cache.
+func (factory *RemoteClientFactory) For(clusterID, kubeconfig string) (client.Client, error) {
+	hash := Hash(kubeconfig)
+
+	factory.mutex.Lock()
+	defer factory.mutex.Unlock()
+
+	if cached, ok := factory.clients[clusterID]; ok && cached.hash == hash {
+		return cached.client, nil
+	}
+
+	config, err := clientcmd.RESTConfigFromKubeConfig([]byte(kubeconfig))
+	if err != nil {
+		return nil, fmt.Errorf("unable to load kubeconfig for cluster [%s] - %w", clusterID, err)
+	}
+
+	config.Timeout = remoteClientTimeout
+
+	remote, err := client.New(config, client.Options{Scheme: factory.scheme})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create client for cluster [%s] - %w", clusterID, err)
+	}
+
+	factory.clients[clusterID] = &remoteClient{client: remote, hash: hash}
+
+	return remote, nil
+}
+
+// Forget removes the cached client and admin kubeconfig for the cluster with the given cluster ID.
+func (factory *RemoteClientFactory) Forget(clusterID string) {
+	factory.mutex.Lock()
+	defer factory.mutex.Unlock()
+
+	delete(factory.clients, clusterID)
+	delete(factory.credentials, clusterID)
+}
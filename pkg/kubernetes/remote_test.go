@@ -0,0 +1,164 @@
+package kubernetes
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/kubernetes/scheme"
+)
+
+func TestRemoteClientFactory_For(t *testing.T) {
+	t.Parallel()
+
+	kubeconfig := func(server string) string {
+		return fmt.Sprintf(`apiVersion: v1
+kind: Config
+clusters:
+- name: test
+  cluster:
+    server: %s
+contexts:
+- name: test
+  context:
+    cluster: test
+    user: test
+current-context: test
+users:
+- name: test
+  user:
+    token: test
+`, server)
+	}
+
+	factory := NewRemoteClientFactory(scheme.Scheme, 0)
+
+	first, err := factory.For("test", kubeconfig("https://api.test.example.com:6443"))
+	if err != nil {
+		t.Fatalf("RemoteClientFactory.For() error = %v", err)
+	}
+
+	// ensure the client is cached when the kubeconfig is unchanged
+	cached, err := factory.For("test", kubeconfig("https://api.test.example.com:6443"))
+	if err != nil {
+		t.Fatalf("RemoteClientFactory.For() error = %v", err)
+	}
+
+	if cached != first {
+		t.Errorf("RemoteClientFactory.For() returned a new client for an unchanged kubeconfig")
+	}
+
+	// ensure the client is rebuilt when the kubeconfig changes
+	rebuilt, err := factory.For("test", kubeconfig("https://api.other.example.com:6443"))
+	if err != nil {
+		t.Fatalf("RemoteClientFactory.For() error = %v", err)
+	}
+
+	if rebuilt == first {
+		t.Errorf("RemoteClientFactory.For() returned a cached client for a changed kubeconfig")
+	}
+
+	// ensure an invalid kubeconfig returns an error
+	if _, err := factory.For("invalid", "invalid"); err == nil {
+		t.Errorf("RemoteClientFactory.For() expected error for invalid kubeconfig")
+	}
+}
+
+func TestRemoteClientFactory_AdminKubeconfig(t *testing.T) {
+	t.Parallel()
+
+	errRetrieve := errors.New("retrieve failed")
+
+	tests := []struct {
+		name           string
+		credentialsTTL time.Duration
+		cached         *remoteCredentials
+		retrieved      string
+		retrieveErr    error
+		want           string
+		wantRetrieves  int
+		wantErr        error
+	}{
+		{
+			name:      "ensure admin credentials are not retrieved when disabled",
+			retrieved: "retrieved",
+		},
+		{
+			name:           "ensure admin credentials are retrieved when not cached",
+			credentialsTTL: time.Minute,
+			retrieved:      "retrieved",
+			want:           "retrieved",
+			wantRetrieves:  1,
+		},
+		{
+			name:           "ensure cached admin credentials are reused",
+			credentialsTTL: time.Minute,
+			cached:         &remoteCredentials{kubeconfig: "cached", expires: time.Now().Add(time.Minute)},
+			retrieved:      "retrieved",
+			want:           "cached",
+		},
+		{
+			name:           "ensure cached missing admin credentials are reused",
+			credentialsTTL: time.Minute,
+			cached:         &remoteCredentials{expires: time.Now().Add(time.Minute)},
+			retrieved:      "retrieved",
+		},
+		{
+			name:           "ensure expired admin credentials are retrieved again",
+			credentialsTTL: time.Minute,
+			cached:         &remoteCredentials{kubeconfig: "cached", expires: time.Now().Add(-time.Second)},
+			retrieved:      "retrieved",
+			want:           "retrieved",
+			wantRetrieves:  1,
+		},
+		{
+			name:           "ensure error retrieving admin credentials is returned",
+			credentialsTTL: time.Minute,
+			retrieveErr:    errRetrieve,
+			wantRetrieves:  1,
+			wantErr:        errRetrieve,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			factory := NewRemoteClientFactory(scheme.Scheme, tt.credentialsTTL)
+			if tt.cached != nil {
+				factory.credentials["test"] = tt.cached
+			}
+
+			retrieves := 0
+			retrieve := func() (string, error) {
+				retrieves++
+
+				return tt.retrieved, tt.retrieveErr
+			}
+
+			got, err := factory.AdminKubeconfig("test", retrieve)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RemoteClientFactory.AdminKubeconfig() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("RemoteClientFactory.AdminKubeconfig() = %v, want %v", got, tt.want)
+			}
+
+			if retrieves != tt.wantRetrieves {
+				t.Errorf("RemoteClientFactory.AdminKubeconfig() retrieves = %v, want %v", retrieves, tt.wantRetrieves)
+			}
+
+			// ensure the retrieved credentials are reused by the next request
+			if tt.wantErr != nil || tt.credentialsTTL == 0 {
+				return
+			}
+
+			if _, err := factory.AdminKubeconfig("test", retrieve); err != nil || retrieves != tt.wantRetrieves {
+				t.Errorf("RemoteClientFactory.AdminKubeconfig() retrieved cached credentials again")
+			}
+		})
+	}
+}
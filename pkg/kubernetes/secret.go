@@ -8,14 +8,14 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 )
 
-func GetSecretData(ctx context.Context, c Client, name, namespace, key string) (string, error) {
+func GetSecret(ctx context.Context, c Client, name, namespace string) (*corev1.Secret, error) {
 	secret := &corev1.Secret{}
 
 	if err := c.Get(ctx, types.NamespacedName{
 		Namespace: namespace,
 		Name:      name,
 	}, secret); err != nil {
-		return "", fmt.Errorf(
+		return nil, fmt.Errorf(
 			"unable to retrieve secret [%s/%s] from cluster - %w",
 			namespace,
 			name,
@@ -23,6 +23,15 @@ func GetSecretData(ctx context.Context, c Client, name, namespace, key string) (
 		)
 	}
 
+	return secret, nil
+}
+
+func GetSecretData(ctx context.Context, c Client, name, namespace, key string) (string, error) {
+	secret, err := GetSecret(ctx, c, name, namespace)
+	if err != nil {
+		return "", err
+	}
+
 	if secret.Data == nil || len(secret.Data[key]) == 0 {
 		return "", nil
 	}
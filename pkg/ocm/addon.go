@@ -0,0 +1,94 @@
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// AddOnClient represents the client used to interact with an add-on installation API object.  Add-on
+// installations are identified by the ID of the add-on which they install.
+type AddOnClient struct {
+	id         string
+	connection *clustersmgmtv1.AddOnInstallationsClient
+}
+
+func NewAddOnClient(connection *sdk.Connection, id, clusterID string) *AddOnClient {
+	return &AddOnClient{
+		id:         id,
+		connection: connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Addons(),
+	}
+}
+
+func (ac *AddOnClient) For() *clustersmgmtv1.AddOnInstallationClient {
+	return ac.connection.Addoninstallation(ac.id)
+}
+
+func (ac *AddOnClient) Get(ctx context.Context) (addOn *clustersmgmtv1.AddOnInstallation, err error) {
+	// retrieve the add-on installation from ocm
+	response, err := ac.For().Get().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return addOn, nil
+		}
+
+		return addOn, fmt.Errorf("error in get request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (ac *AddOnClient) Create(
+	ctx context.Context,
+	builder *clustersmgmtv1.AddOnInstallationBuilder,
+) (addOn *clustersmgmtv1.AddOnInstallation, err error) {
+	// build the object to create
+	object, err := builder.Build()
+	if err != nil {
+		return addOn, fmt.Errorf("unable to build object for add-on installation creation - %w", err)
+	}
+
+	// create the add-on installation in ocm
+	response, err := ac.connection.Add().Body(object).SendContext(ctx)
+	if err != nil {
+		return addOn, fmt.Errorf("error in create request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (ac *AddOnClient) Update(
+	ctx context.Context,
+	builder *clustersmgmtv1.AddOnInstallationBuilder,
+) (addOn *clustersmgmtv1.AddOnInstallation, err error) {
+	// build the object to update
+	object, err := builder.Build()
+	if err != nil {
+		return addOn, fmt.Errorf("unable to build object for add-on installation update - %w", err)
+	}
+
+	// update the add-on installation in ocm
+	response, err := ac.For().Update().Body(object).SendContext(ctx)
+	if err != nil {
+		return addOn, fmt.Errorf("error in update request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (ac *AddOnClient) Delete(ctx context.Context) error {
+	// delete the add-on installation in ocm
+	response, err := ac.For().Delete().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error in delete request - %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,163 @@
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/singleflight"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/rh-mobb/ocm-operator/pkg/tracing"
+)
+
+const (
+	clusterCacheName = "cluster"
+	clusterCacheTTL  = 10 * time.Second
+
+	versionCacheName = "version"
+	versionCacheTTL  = 5 * time.Minute
+
+	// cacheFetchTimeout limits the time which a value which is shared by many requests may be
+	// fetched for, as the fetch is not cancelled when the requests are.
+	cacheFetchTimeout = 2 * time.Minute
+)
+
+var (
+	cacheNameKey = attribute.Key("ocm.cache.name")
+	cacheHitKey  = attribute.Key("ocm.cache.hit")
+)
+
+var (
+	cacheHits = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ocm_operator_ocm_cache_hits_total",
+			Help: "Number of OpenShift Cluster Manager requests which were served from the cache.",
+		},
+		[]string{"cache"},
+	)
+
+	cacheMisses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ocm_operator_ocm_cache_misses_total",
+			Help: "Number of OpenShift Cluster Manager requests which were not served from the cache.",
+		},
+		[]string{"cache"},
+	)
+)
+
+// clusterCache and versionCache are shared by all controllers so that many objects which
+// relate to the same cluster, or which request the same versions, do not each make their
+// own requests to OCM.
+var (
+	clusterCache = newCache[*clustersmgmtv1.Cluster](clusterCacheName, clusterCacheTTL)
+	versionCache = newCache[[]*clustersmgmtv1.Version](versionCacheName, versionCacheTTL)
+)
+
+func init() {
+	metrics.Registry.MustRegister(cacheHits, cacheMisses)
+}
+
+// cache is a cache of responses from OCM which expire after a time to live.  Concurrent
+// requests for the same key, which are not in the cache, are coalesced into a single request.
+type cache[T any] struct {
+	name    string
+	ttl     time.Duration
+	now     func() time.Time
+	group   singleflight.Group
+	mutex   sync.Mutex
+	entries map[string]cacheEntry[T]
+
+	// generation is incremented each time the cache is invalidated.  it prevents a response
+	// which was requested prior to the invalidation from being stored in the cache, or from
+	// being shared with requests which were made after the invalidation.
+	generation uint64
+}
+
+type cacheEntry[T any] struct {
+	value   T
+	expires time.Time
+}
+
+func newCache[T any](name string, ttl time.Duration) *cache[T] {
+	return &cache[T]{
+		name:    name,
+		ttl:     ttl,
+		now:     time.Now,
+		entries: map[string]cacheEntry[T]{},
+	}
+}
+
+// get returns the value stored in the cache for a key.  If the key is missing or has expired,
+// the value is retrieved with the fetch function and stored in the cache.  The fetch is shared by
+// all concurrent requests for the key, so it is not cancelled along with the context of the request
+// which started it, but is limited by a timeout instead.  Each request stops waiting for the fetch
+// once its own context is done.
+func (c *cache[T]) get(ctx context.Context, key string, fetch func(context.Context) (T, error)) (value T, err error) {
+	ctx, span := tracing.Start(ctx, fmt.Sprintf("OCM cache %s", c.name),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(cacheNameKey.String(c.name)),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	c.mutex.Lock()
+	entry, found := c.entries[key]
+	generation := c.generation
+	c.mutex.Unlock()
+
+	if found && c.now().Before(entry.expires) {
+		cacheHits.WithLabelValues(c.name).Inc()
+		span.SetAttributes(cacheHitKey.Bool(true))
+
+		return entry.value, nil
+	}
+
+	cacheMisses.WithLabelValues(c.name).Inc()
+	span.SetAttributes(cacheHitKey.Bool(false))
+
+	result := c.group.DoChan(fmt.Sprintf("%s/%d", key, generation), func() (interface{}, error) {
+		fetchCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), cacheFetchTimeout)
+		defer cancel()
+
+		value, err := fetch(fetchCtx)
+		if err != nil {
+			return value, err
+		}
+
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		if c.generation == generation {
+			c.entries[key] = cacheEntry[T]{value: value, expires: c.now().Add(c.ttl)}
+		}
+
+		return value, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return value, fmt.Errorf("context done while waiting for %s cache - %w", c.name, ctx.Err())
+	case shared := <-result:
+		//nolint:forcetypeassert
+		return shared.Val.(T), shared.Err
+	}
+}
+
+// invalidate removes all keys from the cache which match a function.
+func (c *cache[T]) invalidate(match func(key string, value T) bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	for key, entry := range c.entries {
+		if match(key, entry.value) {
+			delete(c.entries, key)
+		}
+	}
+
+	c.generation++
+}
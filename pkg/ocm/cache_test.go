@@ -0,0 +1,131 @@
+package ocm
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func Test_cache_get(t *testing.T) {
+	t.Parallel()
+
+	errFetch := errors.New("fetch error")
+
+	tests := []struct {
+		name       string
+		elapsed    time.Duration
+		invalidate bool
+		fetchErr   error
+		wantCalls  int
+	}{
+		{
+			name:      "ensure cached value is returned before it expires",
+			elapsed:   time.Second,
+			wantCalls: 1,
+		},
+		{
+			name:      "ensure value is fetched again once it expires",
+			elapsed:   time.Minute,
+			wantCalls: 2,
+		},
+		{
+			name:       "ensure value is fetched again once it is invalidated",
+			elapsed:    time.Second,
+			invalidate: true,
+			wantCalls:  2,
+		},
+		{
+			name:      "ensure errors are not cached",
+			elapsed:   time.Second,
+			fetchErr:  errFetch,
+			wantCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			now := time.Now()
+			c := newCache[string]("test", 10*time.Second)
+			c.now = func() time.Time { return now }
+
+			calls := 0
+			fetch := func(context.Context) (string, error) {
+				calls++
+
+				return "value", tt.fetchErr
+			}
+
+			for i := 0; i < 2; i++ {
+				got, err := c.get(context.Background(), "key", fetch)
+				if !errors.Is(err, tt.fetchErr) {
+					t.Fatalf("cache.get() error = %v, wantErr %v", err, tt.fetchErr)
+				}
+
+				if err == nil && got != "value" {
+					t.Errorf("cache.get() = %v, want %v", got, "value")
+				}
+
+				now = now.Add(tt.elapsed)
+
+				if tt.invalidate {
+					c.invalidate(func(key string, value string) bool { return key == "key" })
+				}
+			}
+
+			if calls != tt.wantCalls {
+				t.Errorf("cache.get() fetched %d times, want %d", calls, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func Test_cache_get_cancelled(t *testing.T) {
+	t.Parallel()
+
+	c := newCache[string]("test", 10*time.Second)
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	fetch := func(ctx context.Context) (string, error) {
+		close(started)
+		<-release
+
+		// the shared fetch must not be cancelled along with the request which started it
+		return "value", ctx.Err()
+	}
+
+	firstCtx, cancelFirst := context.WithCancel(context.Background())
+	first := make(chan error)
+
+	go func() {
+		_, err := c.get(firstCtx, "key", fetch)
+		first <- err
+	}()
+
+	<-started
+
+	second := make(chan string)
+
+	go func() {
+		value, _ := c.get(context.Background(), "key", fetch)
+		second <- value
+	}()
+
+	// ensure the request which started the fetch stops waiting once it is cancelled
+	cancelFirst()
+
+	if err := <-first; !errors.Is(err, context.Canceled) {
+		t.Errorf("cache.get() error = %v, want %v", err, context.Canceled)
+	}
+
+	// ensure the other requests receive the value which was fetched
+	close(release)
+
+	if got := <-second; got != "value" {
+		t.Errorf("cache.get() = %v, want %v", got, "value")
+	}
+}
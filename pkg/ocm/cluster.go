@@ -1,6 +1,7 @@
 package ocm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -29,21 +30,44 @@ func (cc *ClusterClient) For(id string) *clustersmgmtv1.ClusterClient {
 	return cc.Connection.Cluster(id)
 }
 
-func (cc *ClusterClient) Get() (cluster *clustersmgmtv1.Cluster, err error) {
-	// retrieve the cluster from openshift cluster manager
-	clusterList, err := cc.Connection.List().Search(fmt.Sprintf("name = '%s'", cc.Name)).Send()
-	if err != nil {
-		return cluster, fmt.Errorf("unable to retrieve cluster from openshift cluster manager - %w", err)
-	}
+// Get retrieves a cluster from OCM by its name.  Responses are cached for a short period of
+// time, as the same cluster is retrieved by each of the objects which relate to it.
+func (cc *ClusterClient) Get(ctx context.Context) (cluster *clustersmgmtv1.Cluster, err error) {
+	return clusterCache.get(ctx, clusterNameKey(cc.Name), func(ctx context.Context) (*clustersmgmtv1.Cluster, error) {
+		// retrieve the cluster from openshift cluster manager
+		clusterList, err := cc.Connection.List().Search(fmt.Sprintf("name = '%s'", cc.Name)).SendContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("unable to retrieve cluster from openshift cluster manager - %w", err)
+		}
 
-	if len(clusterList.Items().Slice()) == 0 {
-		return cluster, nil
-	}
+		if len(clusterList.Items().Slice()) == 0 {
+			return nil, nil
+		}
+
+		return clusterList.Items().Slice()[0], nil
+	})
+}
+
+// GetByID retrieves a cluster from OCM by its id.  A nil cluster is returned if the cluster
+// does not exist.  Responses are cached for a short period of time, as the same cluster is
+// retrieved by each of the objects which relate to it.
+func (cc *ClusterClient) GetByID(ctx context.Context, id string) (cluster *clustersmgmtv1.Cluster, err error) {
+	return clusterCache.get(ctx, clusterIDKey(id), func(ctx context.Context) (*clustersmgmtv1.Cluster, error) {
+		response, err := cc.For(id).Get().SendContext(ctx)
+		if err != nil {
+			if response != nil && response.Status() == http.StatusNotFound {
+				return nil, nil
+			}
+
+			return nil, fmt.Errorf("unable to retrieve cluster from openshift cluster manager - %w", err)
+		}
 
-	return clusterList.Items().Slice()[0], nil
+		return response.Body(), nil
+	})
 }
 
 func (cc *ClusterClient) Create(
+	ctx context.Context,
 	builder *clustersmgmtv1.ClusterBuilder,
 ) (cluster *clustersmgmtv1.Cluster, err error) {
 	// build the object to create
@@ -53,7 +77,8 @@ func (cc *ClusterClient) Create(
 	}
 
 	// create the cluster in ocm
-	response, err := cc.Connection.Add().Body(object).Send()
+	response, err := cc.Connection.Add().Body(object).SendContext(ctx)
+	invalidateCluster(response.Body().ID(), object.Name())
 	if err != nil {
 		return cluster, fmt.Errorf("error in create request - %w", err)
 	}
@@ -62,6 +87,7 @@ func (cc *ClusterClient) Create(
 }
 
 func (cc *ClusterClient) Update(
+	ctx context.Context,
 	builder *clustersmgmtv1.ClusterBuilder,
 ) (cluster *clustersmgmtv1.Cluster, err error) {
 	// build the object to update
@@ -71,7 +97,8 @@ func (cc *ClusterClient) Update(
 	}
 
 	// update the cluster in ocm
-	response, err := cc.For(object.ID()).Update().Body(object).Send()
+	response, err := cc.For(object.ID()).Update().Body(object).SendContext(ctx)
+	invalidateCluster(object.ID(), object.Name())
 	if err != nil {
 		return cluster, fmt.Errorf("error in update request - %w", err)
 	}
@@ -79,9 +106,10 @@ func (cc *ClusterClient) Update(
 	return response.Body(), nil
 }
 
-func (cc *ClusterClient) Delete(id string) error {
+func (cc *ClusterClient) Delete(ctx context.Context, id string) error {
 	// delete the cluster in ocm
-	response, err := cc.For(id).Delete().Send()
+	response, err := cc.For(id).Delete().SendContext(ctx)
+	invalidateCluster(id, cc.Name)
 	if err != nil {
 		if response.Status() == http.StatusNotFound {
 			return nil
@@ -93,14 +121,72 @@ func (cc *ClusterClient) Delete(id string) error {
 	return nil
 }
 
-func ClusterExists(clusterName string, connection *sdk.Connection) (*clustersmgmtv1.Cluster, bool, error) {
+func ClusterExists(ctx context.Context, clusterName string, connection *sdk.Connection) (*clustersmgmtv1.Cluster, bool, error) {
 	// retrieve the cluster
 	clusterClient := NewClusterClient(connection, clusterName)
 
-	cluster, err := clusterClient.Get()
+	cluster, err := clusterClient.Get(ctx)
 	if err != nil {
 		return nil, false, fmt.Errorf("unable to retrieve cluster [%s] - %w", clusterName, err)
 	}
 
 	return cluster, (cluster != nil), nil
 }
+
+func (cc *ClusterClient) Hibernate(ctx context.Context, id string) error {
+	// hibernate the cluster in ocm
+	_, err := cc.For(id).Hibernate().SendContext(ctx)
+	invalidateCluster(id, cc.Name)
+	if err != nil {
+		return fmt.Errorf("error in hibernate request - %w", err)
+	}
+
+	return nil
+}
+
+func (cc *ClusterClient) Resume(ctx context.Context, id string) error {
+	// resume the cluster in ocm
+	_, err := cc.For(id).Resume().SendContext(ctx)
+	invalidateCluster(id, cc.Name)
+	if err != nil {
+		return fmt.Errorf("error in resume request - %w", err)
+	}
+
+	return nil
+}
+
+func (cc *ClusterClient) GetKubeconfig(ctx context.Context, id string) (string, error) {
+	// retrieve the admin credentials of the cluster from ocm.  these are only available
+	// for clusters which were provisioned with admin credentials and to users who are
+	// permitted to access them.
+	response, err := cc.For(id).Credentials().Get().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound || response.Status() == http.StatusForbidden {
+			return "", nil
+		}
+
+		return "", fmt.Errorf("error in get credentials request - %w", err)
+	}
+
+	return response.Body().Kubeconfig(), nil
+}
+
+// invalidateCluster removes a cluster from the cache once it has been written to, so that
+// the cluster is not retrieved in the state it was in prior to the write.
+func invalidateCluster(id, name string) {
+	clusterCache.invalidate(func(key string, cluster *clustersmgmtv1.Cluster) bool {
+		if key == clusterIDKey(id) || key == clusterNameKey(name) {
+			return true
+		}
+
+		return id != "" && cluster != nil && cluster.ID() == id
+	})
+}
+
+func clusterIDKey(id string) string {
+	return "id/" + id
+}
+
+func clusterNameKey(name string) string {
+	return "name/" + name
+}
@@ -0,0 +1,89 @@
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// ClusterAutoscalerClient represents the client used to interact with the cluster autoscaler API object.
+// The cluster autoscaler is associated with clusters that are not using hosted control plane and only a
+// single cluster autoscaler may exist for a cluster.
+type ClusterAutoscalerClient struct {
+	connection *clustersmgmtv1.AutoscalerClient
+}
+
+func NewClusterAutoscalerClient(connection *sdk.Connection, clusterID string) *ClusterAutoscalerClient {
+	return &ClusterAutoscalerClient{
+		connection: connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Autoscaler(),
+	}
+}
+
+func (cac *ClusterAutoscalerClient) Get(ctx context.Context) (autoscaler *clustersmgmtv1.ClusterAutoscaler, err error) {
+	// retrieve the cluster autoscaler from ocm
+	response, err := cac.connection.Get().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return autoscaler, nil
+		}
+
+		return autoscaler, fmt.Errorf("error in get request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (cac *ClusterAutoscalerClient) Create(
+	ctx context.Context,
+	builder *clustersmgmtv1.ClusterAutoscalerBuilder,
+) (autoscaler *clustersmgmtv1.ClusterAutoscaler, err error) {
+	// build the object to create
+	object, err := builder.Build()
+	if err != nil {
+		return autoscaler, fmt.Errorf("unable to build object for cluster autoscaler creation - %w", err)
+	}
+
+	// create the cluster autoscaler in ocm
+	response, err := cac.connection.Post().Request(object).SendContext(ctx)
+	if err != nil {
+		return autoscaler, fmt.Errorf("error in create request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (cac *ClusterAutoscalerClient) Update(
+	ctx context.Context,
+	builder *clustersmgmtv1.ClusterAutoscalerBuilder,
+) (autoscaler *clustersmgmtv1.ClusterAutoscaler, err error) {
+	// build the object to update
+	object, err := builder.Build()
+	if err != nil {
+		return autoscaler, fmt.Errorf("unable to build object for cluster autoscaler update - %w", err)
+	}
+
+	// update the cluster autoscaler in ocm
+	response, err := cac.connection.Update().Body(object).SendContext(ctx)
+	if err != nil {
+		return autoscaler, fmt.Errorf("error in update request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (cac *ClusterAutoscalerClient) Delete(ctx context.Context) error {
+	// delete the cluster autoscaler in ocm
+	response, err := cac.connection.Delete().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error in delete request - %w", err)
+	}
+
+	return nil
+}
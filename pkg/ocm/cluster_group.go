@@ -0,0 +1,84 @@
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const (
+	ClusterGroupDedicatedAdmins = "dedicated-admins"
+	ClusterGroupClusterAdmins   = "cluster-admins"
+
+	clusterGroupUsersPageSize = 100
+)
+
+// ClusterGroupUsersClient represents the client used to interact with the users of a
+// Cluster Group API object.
+type ClusterGroupUsersClient struct {
+	connection *clustersmgmtv1.UsersClient
+}
+
+func NewClusterGroupUsersClient(connection *sdk.Connection, clusterID, group string) *ClusterGroupUsersClient {
+	return &ClusterGroupUsersClient{
+		connection: connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).
+			Groups().Group(group).Users(),
+	}
+}
+
+func (groupClient *ClusterGroupUsersClient) For(id string) *clustersmgmtv1.UserClient {
+	return groupClient.connection.User(id)
+}
+
+func (groupClient *ClusterGroupUsersClient) List(ctx context.Context) (users []*clustersmgmtv1.User, err error) {
+	// retrieve the users from ocm, one page at a time
+	page := 1
+
+	for {
+		response, err := groupClient.connection.List().Page(page).Size(clusterGroupUsersPageSize).SendContext(ctx)
+		if err != nil {
+			return users, fmt.Errorf("error in list request - %w", err)
+		}
+
+		users = append(users, response.Items().Slice()...)
+
+		if response.Size() < clusterGroupUsersPageSize {
+			return users, nil
+		}
+
+		page++
+	}
+}
+
+func (groupClient *ClusterGroupUsersClient) Create(ctx context.Context, username string) (user *clustersmgmtv1.User, err error) {
+	// build the object to create.  the id of a cluster group user is the username.
+	object, err := clustersmgmtv1.NewUser().ID(username).Build()
+	if err != nil {
+		return user, fmt.Errorf("unable to build object for cluster group user creation - %w", err)
+	}
+
+	// add the user to the group in ocm
+	response, err := groupClient.connection.Add().Body(object).SendContext(ctx)
+	if err != nil {
+		return user, fmt.Errorf("error in create request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (groupClient *ClusterGroupUsersClient) Delete(ctx context.Context, username string) error {
+	// remove the user from the group in ocm
+	response, err := groupClient.For(username).Delete().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error in delete request - %w", err)
+	}
+
+	return nil
+}
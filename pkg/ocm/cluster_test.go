@@ -0,0 +1,133 @@
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/rh-mobb/ocm-operator/internal/factory"
+	"github.com/rh-mobb/ocm-operator/pkg/ocm/ocmtest"
+	"github.com/rh-mobb/ocm-operator/pkg/tracing"
+)
+
+func TestClusterClient_GetByID(t *testing.T) {
+	t.Parallel()
+
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	tests := []struct {
+		name          string
+		clusterID     string
+		reconcile     bool
+		wantParent    string
+		wantNamespace string
+	}{
+		{
+			name:          "ensure request sent during a phase is traced as a child of the phase",
+			clusterID:     "traced-phase",
+			reconcile:     true,
+			wantParent:    "GetCurrentState",
+			wantNamespace: "test",
+		},
+		{
+			name:      "ensure request sent outside of a reconcile starts a new trace",
+			clusterID: "traced-none",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			server := ocmtest.NewServer(t, map[string]ocmtest.Response{
+				http.MethodGet + " /api/clusters_mgmt/v1/clusters/" + tt.clusterID: {
+					Body: fmt.Sprintf(`{"kind":"Cluster","id":"%s","name":"test"}`, tt.clusterID),
+				},
+			})
+			connection := server.Connection(t, func(next http.RoundTripper) http.RoundTripper {
+				return tracing.NewTransport(next)
+			})
+
+			ctx := context.Background()
+
+			var phase trace.Span
+
+			if tt.reconcile {
+				var root trace.Span
+
+				ctx, root = tracing.StartReconcile(ctx, types.NamespacedName{Namespace: "test", Name: "test"})
+				defer root.End()
+
+				tracing.SetObject(ctx, "test", factory.NewTestWorkload(tt.clusterID))
+				phase = tracing.StartPhase(ctx, tt.wantParent)
+			}
+
+			if _, err := NewClusterClient(connection, "test").GetByID(ctx, tt.clusterID); err != nil {
+				t.Fatalf("ClusterClient.GetByID() error = %v", err)
+			}
+
+			if phase != nil {
+				tracing.EndPhase(ctx, phase, nil)
+			}
+
+			var request sdktrace.ReadOnlySpan
+			for _, span := range recorder.Ended() {
+				for _, attribute := range span.Attributes() {
+					if attribute.Key == tracing.ClusterIDKey && attribute.Value.AsString() == tt.clusterID &&
+						span.SpanKind() == trace.SpanKindClient {
+						request = span
+					}
+				}
+			}
+
+			if request == nil {
+				t.Fatalf("ClusterClient.GetByID() did not record a span for the request")
+			}
+
+			// the request is sent from the span of the cache, which is started by each caller
+			var cached sdktrace.ReadOnlySpan
+			for _, span := range recorder.Ended() {
+				if span.SpanContext().SpanID() == request.Parent().SpanID() {
+					cached = span
+				}
+			}
+
+			if cached == nil || cached.Name() != "OCM cache cluster" {
+				t.Fatalf("ClusterClient.GetByID() span parent = %v, want cache span", request.Parent().SpanID())
+			}
+
+			if phase == nil {
+				if cached.Parent().IsValid() {
+					t.Errorf("ClusterClient.GetByID() cache span parent = %v, want none", cached.Parent().SpanID())
+				}
+
+				return
+			}
+
+			if cached.Parent().SpanID() != phase.SpanContext().SpanID() {
+				t.Errorf("ClusterClient.GetByID() cache span parent = %v, want %s span %v",
+					cached.Parent().SpanID(), tt.wantParent, phase.SpanContext().SpanID())
+			}
+
+			var namespace string
+			for _, attribute := range request.Attributes() {
+				if attribute.Key == tracing.ObjectNamespaceKey {
+					namespace = attribute.Value.AsString()
+				}
+			}
+
+			if namespace != tt.wantNamespace {
+				t.Errorf("ClusterClient.GetByID() span namespace = %v, want %v", namespace, tt.wantNamespace)
+			}
+		})
+	}
+}
@@ -0,0 +1,124 @@
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const (
+	// clusterUpgradeDelay is the delay from now that a cluster upgrade is scheduled
+	// for.  OCM requires that manual upgrades are scheduled in the future.
+	clusterUpgradeDelay = 10 * time.Minute
+)
+
+// ClusterUpgrade represents an upgrade of a cluster which is scheduled or in progress.
+type ClusterUpgrade struct {
+	Version     string
+	State       string
+	Description string
+}
+
+// GetUpgrade returns the upgrade of a cluster which is scheduled or in progress.  Clusters which
+// are using a hosted control plane are upgraded via control plane upgrade policies, while all other
+// clusters are upgraded via cluster upgrade policies.  It returns nil if there is no upgrade.
+func (cc *ClusterClient) GetUpgrade(ctx context.Context, id string, hosted bool) (*ClusterUpgrade, error) {
+	if hosted {
+		response, err := cc.For(id).ControlPlane().UpgradePolicies().List().SendContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error in list control plane upgrade policies request - %w", err)
+		}
+
+		policies := response.Items().Slice()
+		if len(policies) == 0 {
+			return nil, nil
+		}
+
+		return &ClusterUpgrade{
+			Version:     policies[0].Version(),
+			State:       string(policies[0].State().Value()),
+			Description: policies[0].State().Description(),
+		}, nil
+	}
+
+	response, err := cc.For(id).UpgradePolicies().List().SendContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error in list upgrade policies request - %w", err)
+	}
+
+	for _, policy := range response.Items().Slice() {
+		if policy.UpgradeType() != clustersmgmtv1.UpgradeTypeOSD {
+			continue
+		}
+
+		// the state of a cluster upgrade policy is a separate api object
+		state, err := cc.For(id).UpgradePolicies().UpgradePolicy(policy.ID()).State().Get().SendContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error in get upgrade policy state request - %w", err)
+		}
+
+		return &ClusterUpgrade{
+			Version:     policy.Version(),
+			State:       string(state.Body().Value()),
+			Description: state.Body().Description(),
+		}, nil
+	}
+
+	return nil, nil
+}
+
+// Upgrade schedules an upgrade of a cluster to a particular version.
+func (cc *ClusterClient) Upgrade(ctx context.Context, id, version string, hosted bool) (*ClusterUpgrade, error) {
+	nextRun := time.Now().UTC().Add(clusterUpgradeDelay)
+
+	if hosted {
+		// build the object to create
+		object, err := clustersmgmtv1.NewControlPlaneUpgradePolicy().
+			ClusterID(id).
+			UpgradeType(clustersmgmtv1.UpgradeTypeControlPlane).
+			ScheduleType(clustersmgmtv1.ScheduleTypeManual).
+			Version(version).
+			NextRun(nextRun).
+			Build()
+		if err != nil {
+			return nil, fmt.Errorf("unable to build object for control plane upgrade policy creation - %w", err)
+		}
+
+		// create the upgrade policy in ocm
+		response, err := cc.For(id).ControlPlane().UpgradePolicies().Add().Body(object).SendContext(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error in create control plane upgrade policy request - %w", err)
+		}
+
+		return &ClusterUpgrade{
+			Version:     response.Body().Version(),
+			State:       string(response.Body().State().Value()),
+			Description: response.Body().State().Description(),
+		}, nil
+	}
+
+	// build the object to create
+	object, err := clustersmgmtv1.NewUpgradePolicy().
+		ClusterID(id).
+		UpgradeType(clustersmgmtv1.UpgradeTypeOSD).
+		ScheduleType(clustersmgmtv1.ScheduleTypeManual).
+		Version(version).
+		NextRun(nextRun).
+		Build()
+	if err != nil {
+		return nil, fmt.Errorf("unable to build object for upgrade policy creation - %w", err)
+	}
+
+	// create the upgrade policy in ocm
+	response, err := cc.For(id).UpgradePolicies().Add().Body(object).SendContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error in create upgrade policy request - %w", err)
+	}
+
+	return &ClusterUpgrade{
+		Version: response.Body().Version(),
+		State:   string(clustersmgmtv1.UpgradePolicyStateValueScheduled),
+	}, nil
+}
@@ -1,6 +1,7 @@
 package ocm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -31,9 +32,9 @@ func (glc *GitLabIdentityProviderClient) For(gitLabName string) *clustersmgmtv1.
 	return glc.connection.IdentityProvider(gitLabName)
 }
 
-func (glc *GitLabIdentityProviderClient) Get() (gitLab *clustersmgmtv1.GitlabIdentityProvider, err error) {
+func (glc *GitLabIdentityProviderClient) Get(ctx context.Context) (gitLab *clustersmgmtv1.GitlabIdentityProvider, err error) {
 	// retrieve the gitlab identity provider from ocm
-	response, err := glc.For(glc.name).Get().Send()
+	response, err := glc.For(glc.name).Get().SendContext(ctx)
 	if err != nil {
 		if response.Status() == http.StatusNotFound {
 			return gitLab, nil
@@ -46,6 +47,7 @@ func (glc *GitLabIdentityProviderClient) Get() (gitLab *clustersmgmtv1.GitlabIde
 }
 
 func (glc *GitLabIdentityProviderClient) Create(
+	ctx context.Context,
 	builder *clustersmgmtv1.GitlabIdentityProviderBuilder,
 ) (gitLab *clustersmgmtv1.GitlabIdentityProvider, err error) {
 	body := clustersmgmtv1.NewIdentityProvider().Gitlab(builder)
@@ -57,7 +59,7 @@ func (glc *GitLabIdentityProviderClient) Create(
 	}
 
 	// create the gitlab identity provider in ocm
-	response, err := glc.connection.Add().Body(object).Send()
+	response, err := glc.connection.Add().Body(object).SendContext(ctx)
 	if err != nil {
 		return gitLab, fmt.Errorf("error in create request - %w", err)
 	}
@@ -66,6 +68,7 @@ func (glc *GitLabIdentityProviderClient) Create(
 }
 
 func (glc *GitLabIdentityProviderClient) Update(
+	ctx context.Context,
 	builder *clustersmgmtv1.GitlabIdentityProviderBuilder,
 ) (gitLab *clustersmgmtv1.GitlabIdentityProvider, err error) {
 	body := clustersmgmtv1.NewIdentityProvider().Gitlab(builder)
@@ -77,7 +80,7 @@ func (glc *GitLabIdentityProviderClient) Update(
 	}
 
 	// update the gitlab identity provider in ocm
-	response, err := glc.For(object.ID()).Update().Body(object).Send()
+	response, err := glc.For(object.ID()).Update().Body(object).SendContext(ctx)
 	if err != nil {
 		return gitLab, fmt.Errorf("error in update request - %w", err)
 	}
@@ -85,9 +88,9 @@ func (glc *GitLabIdentityProviderClient) Update(
 	return response.Body().Gitlab(), nil
 }
 
-func (glc *GitLabIdentityProviderClient) Delete(id string) error {
+func (glc *GitLabIdentityProviderClient) Delete(ctx context.Context, id string) error {
 	// delete the gitlab identity provider in ocm
-	response, err := glc.For(id).Delete().Send()
+	response, err := glc.For(id).Delete().SendContext(ctx)
 	if err != nil {
 		if response.Status() == http.StatusNotFound {
 			return nil
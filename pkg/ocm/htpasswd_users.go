@@ -0,0 +1,139 @@
+package ocm
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"regexp"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const (
+	htpasswdUsersPageSize = 100
+)
+
+// bcryptHashRegex matches a password value that has already been hashed with bcrypt.
+var bcryptHashRegex = regexp.MustCompile(`^\$2[abxy]?\$[0-9]{2}\$[./A-Za-z0-9]{53}$`)
+
+// HTPasswdUsersClient represents the client used to interact with the users of an HTPasswd
+// Identity Provider API object.
+type HTPasswdUsersClient struct {
+	connection *clustersmgmtv1.HTPasswdUsersClient
+}
+
+func NewHTPasswdUsersClient(connection *sdk.Connection, clusterID, providerID string) *HTPasswdUsersClient {
+	return &HTPasswdUsersClient{
+		connection: connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).
+			IdentityProviders().IdentityProvider(providerID).HtpasswdUsers(),
+	}
+}
+
+func (htpasswdClient *HTPasswdUsersClient) For(id string) *clustersmgmtv1.HTPasswdUserClient {
+	return htpasswdClient.connection.HtpasswdUser(id)
+}
+
+func (htpasswdClient *HTPasswdUsersClient) List(ctx context.Context) (users []*clustersmgmtv1.HTPasswdUser, err error) {
+	// retrieve the users from ocm, one page at a time
+	page := 1
+
+	for {
+		response, err := htpasswdClient.connection.List().Page(page).Size(htpasswdUsersPageSize).SendContext(ctx)
+		if err != nil {
+			return users, fmt.Errorf("error in list request - %w", err)
+		}
+
+		users = append(users, response.Items().Slice()...)
+
+		if response.Size() < htpasswdUsersPageSize {
+			return users, nil
+		}
+
+		page++
+	}
+}
+
+func (htpasswdClient *HTPasswdUsersClient) Create(
+	ctx context.Context,
+	builder *clustersmgmtv1.HTPasswdUserBuilder,
+) (user *clustersmgmtv1.HTPasswdUser, err error) {
+	// build the object to create
+	object, err := builder.Build()
+	if err != nil {
+		return user, fmt.Errorf("unable to build object for htpasswd user creation - %w", err)
+	}
+
+	// create the user in ocm
+	response, err := htpasswdClient.connection.Add().Body(object).SendContext(ctx)
+	if err != nil {
+		return user, fmt.Errorf("error in create request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (htpasswdClient *HTPasswdUsersClient) Update(
+	ctx context.Context,
+	id string,
+	builder *clustersmgmtv1.HTPasswdUserBuilder,
+) (user *clustersmgmtv1.HTPasswdUser, err error) {
+	// build the object to update
+	object, err := builder.Build()
+	if err != nil {
+		return user, fmt.Errorf("unable to build object for htpasswd user update - %w", err)
+	}
+
+	// update the user in ocm
+	response, err := htpasswdClient.For(id).Update().Body(object).SendContext(ctx)
+	if err != nil {
+		return user, fmt.Errorf("error in update request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (htpasswdClient *HTPasswdUsersClient) Delete(ctx context.Context, id string) error {
+	// delete the user in ocm
+	response, err := htpasswdClient.For(id).Delete().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error in delete request - %w", err)
+	}
+
+	return nil
+}
+
+// NewHTPasswdUserBuilder returns a builder for an htpasswd user.  If the password is already
+// a bcrypt hash, it is passed to OCM as a hashed password, otherwise it is passed as plain text.
+func NewHTPasswdUserBuilder(username, password string) *clustersmgmtv1.HTPasswdUserBuilder {
+	builder := clustersmgmtv1.NewHTPasswdUser().Username(username)
+
+	if IsHashedPassword(password) {
+		return builder.HashedPassword(password)
+	}
+
+	return builder.Password(password)
+}
+
+// IsHashedPassword determines if a password has already been hashed with bcrypt.
+func IsHashedPassword(password string) bool {
+	return bcryptHashRegex.MatchString(password)
+}
+
+// HTPasswdUserChecksum returns a checksum of a user's credentials.  The checksum is an HMAC keyed
+// by a secret which is only known to the caller, so that passwords may not be guessed offline from
+// the checksum.  The provider ID is included so that checksums are not comparable across identity
+// providers.
+func HTPasswdUserChecksum(key []byte, providerID, username, password string) string {
+	mac := hmac.New(sha256.New, key)
+	fmt.Fprintf(mac, "%s/%s/%s", providerID, username, password)
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,61 @@
+package ocm
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"testing"
+)
+
+func TestHTPasswdUserChecksum(t *testing.T) {
+	t.Parallel()
+
+	const (
+		providerID = "provider"
+		username   = "admin"
+		password   = "password"
+	)
+
+	checksum := HTPasswdUserChecksum([]byte("key"), providerID, username, password)
+
+	// the unkeyed checksum is able to be computed by anyone who knows the provider id
+	unkeyed := sha256.Sum256([]byte(providerID + "/" + username + "/" + password))
+
+	tests := []struct {
+		name  string
+		other string
+		equal bool
+	}{
+		{
+			name:  "ensure checksum is stable",
+			other: HTPasswdUserChecksum([]byte("key"), providerID, username, password),
+			equal: true,
+		},
+		{
+			name:  "ensure checksum is not comparable without the key",
+			other: HTPasswdUserChecksum([]byte("other"), providerID, username, password),
+		},
+		{
+			name:  "ensure checksum is not comparable across identity providers",
+			other: HTPasswdUserChecksum([]byte("key"), "other", username, password),
+		},
+		{
+			name:  "ensure checksum changes with the password",
+			other: HTPasswdUserChecksum([]byte("key"), providerID, username, "other"),
+		},
+		{
+			name:  "ensure checksum is not an unkeyed hash of the credentials",
+			other: hex.EncodeToString(unkeyed[:]),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got := checksum == tt.other; got != tt.equal {
+				t.Errorf("HTPasswdUserChecksum() = %v, other = %v, want equal %v", checksum, tt.other, tt.equal)
+			}
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package ocm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -35,9 +36,9 @@ func (idpClient *IdentityProviderClient) For(id string) *clustersmgmtv1.Identity
 	return idpClient.connection.IdentityProvider(id)
 }
 
-func (idpClient *IdentityProviderClient) Get() (idp *clustersmgmtv1.IdentityProvider, err error) {
+func (idpClient *IdentityProviderClient) Get(ctx context.Context) (idp *clustersmgmtv1.IdentityProvider, err error) {
 	// retrieve the identity provider from ocm
-	response, err := idpClient.connection.List().Send()
+	response, err := idpClient.connection.List().SendContext(ctx)
 	if err != nil {
 		return idp, fmt.Errorf("error in get request - %w", err)
 	}
@@ -54,6 +55,7 @@ func (idpClient *IdentityProviderClient) Get() (idp *clustersmgmtv1.IdentityProv
 }
 
 func (idpClient *IdentityProviderClient) Create(
+	ctx context.Context,
 	builder *clustersmgmtv1.IdentityProviderBuilder,
 ) (gitLab *clustersmgmtv1.IdentityProvider, err error) {
 	// build the object to create
@@ -63,7 +65,7 @@ func (idpClient *IdentityProviderClient) Create(
 	}
 
 	// create the identity provider in ocm
-	response, err := idpClient.connection.Add().Body(object).Send()
+	response, err := idpClient.connection.Add().Body(object).SendContext(ctx)
 	if err != nil {
 		return gitLab, fmt.Errorf("error in create request - %w", err)
 	}
@@ -72,6 +74,7 @@ func (idpClient *IdentityProviderClient) Create(
 }
 
 func (idpClient *IdentityProviderClient) Update(
+	ctx context.Context,
 	builder *clustersmgmtv1.IdentityProviderBuilder,
 ) (gitLab *clustersmgmtv1.IdentityProvider, err error) {
 	// build the object to update
@@ -81,7 +84,7 @@ func (idpClient *IdentityProviderClient) Update(
 	}
 
 	// update the identity provider in ocm
-	response, err := idpClient.For(object.ID()).Update().Body(object).Send()
+	response, err := idpClient.For(object.ID()).Update().Body(object).SendContext(ctx)
 	if err != nil {
 		return gitLab, fmt.Errorf("error in update request - %w", err)
 	}
@@ -89,9 +92,9 @@ func (idpClient *IdentityProviderClient) Update(
 	return response.Body(), nil
 }
 
-func (idpClient *IdentityProviderClient) Delete(id string) error {
+func (idpClient *IdentityProviderClient) Delete(ctx context.Context, id string) error {
 	// delete the identity provider in ocm
-	response, err := idpClient.For(id).Delete().Send()
+	response, err := idpClient.For(id).Delete().SendContext(ctx)
 	if err != nil {
 		if response.Status() == http.StatusNotFound {
 			return nil
@@ -0,0 +1,147 @@
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// IngressClient represents the client used to interact with an Ingress API object.  Every cluster has
+// a single default ingress, which is created along with the cluster, and may have additional ingresses
+// which are identified by their ID.
+type IngressClient struct {
+	id         string
+	isDefault  bool
+	connection *clustersmgmtv1.IngressesClient
+}
+
+func NewIngressClient(connection *sdk.Connection, id, clusterID string, isDefault bool) *IngressClient {
+	return &IngressClient{
+		id:         id,
+		isDefault:  isDefault,
+		connection: connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).Ingresses(),
+	}
+}
+
+func (ic *IngressClient) For(id string) *clustersmgmtv1.IngressClient {
+	return ic.connection.Ingress(id)
+}
+
+func (ic *IngressClient) Get(ctx context.Context) (ingress *clustersmgmtv1.Ingress, err error) {
+	// retrieve the default ingress from ocm.  the id of the default ingress is not known
+	// ahead of time, so we must search for it.
+	if ic.isDefault {
+		response, err := ic.connection.List().SendContext(ctx)
+		if err != nil {
+			return ingress, fmt.Errorf("error in get request - %w", err)
+		}
+
+		for _, ingress := range response.Items().Slice() {
+			if ingress.Default() {
+				return ingress, nil
+			}
+		}
+
+		return ingress, nil
+	}
+
+	// return a nil ingress if it has not yet been created
+	if ic.id == "" {
+		return ingress, nil
+	}
+
+	// retrieve the ingress from ocm
+	response, err := ic.For(ic.id).Get().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return ingress, nil
+		}
+
+		return ingress, fmt.Errorf("error in get request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+// FindByRouteSelectors finds an additional ingress which selects the routes with the given labels.  Additional
+// ingresses have no name in OCM, so this is used to find an ingress which was created but whose ID could not
+// be stored, rather than creating a duplicate ingress.
+func (ic *IngressClient) FindByRouteSelectors(
+	ctx context.Context,
+	selectors map[string]string,
+) (ingress *clustersmgmtv1.Ingress, err error) {
+	response, err := ic.connection.List().SendContext(ctx)
+	if err != nil {
+		return ingress, fmt.Errorf("error in list request - %w", err)
+	}
+
+	for _, ingress := range response.Items().Slice() {
+		if ingress.Default() || len(ingress.RouteSelectors()) != len(selectors) {
+			continue
+		}
+
+		matches := true
+		for key, value := range selectors {
+			if ingress.RouteSelectors()[key] != value {
+				matches = false
+
+				break
+			}
+		}
+
+		if matches {
+			return ingress, nil
+		}
+	}
+
+	return ingress, nil
+}
+
+func (ic *IngressClient) Create(ctx context.Context, builder *clustersmgmtv1.IngressBuilder) (ingress *clustersmgmtv1.Ingress, err error) {
+	// build the object to create
+	object, err := builder.Build()
+	if err != nil {
+		return ingress, fmt.Errorf("unable to build object for ingress creation - %w", err)
+	}
+
+	// create the ingress in ocm
+	response, err := ic.connection.Add().Body(object).SendContext(ctx)
+	if err != nil {
+		return ingress, fmt.Errorf("error in create request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (ic *IngressClient) Update(ctx context.Context, builder *clustersmgmtv1.IngressBuilder) (ingress *clustersmgmtv1.Ingress, err error) {
+	// build the object to update
+	object, err := builder.Build()
+	if err != nil {
+		return ingress, fmt.Errorf("unable to build object for ingress update - %w", err)
+	}
+
+	// update the ingress in ocm
+	response, err := ic.For(object.ID()).Update().Body(object).SendContext(ctx)
+	if err != nil {
+		return ingress, fmt.Errorf("error in update request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (ic *IngressClient) Delete(ctx context.Context, id string) error {
+	// delete the ingress in ocm
+	response, err := ic.For(id).Delete().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error in delete request - %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,178 @@
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// ClusterKubeletConfigClient represents the client used to interact with the cluster-wide Kubelet Config
+// API object.  The cluster-wide kubelet config is associated with clusters that are not using hosted
+// control plane and only a single kubelet config may exist for a cluster.
+type ClusterKubeletConfigClient struct {
+	connection *clustersmgmtv1.KubeletConfigClient
+}
+
+func NewClusterKubeletConfigClient(connection *sdk.Connection, clusterID string) *ClusterKubeletConfigClient {
+	return &ClusterKubeletConfigClient{
+		connection: connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).KubeletConfig(),
+	}
+}
+
+func (kcc *ClusterKubeletConfigClient) Get(ctx context.Context) (kubeletConfig *clustersmgmtv1.KubeletConfig, err error) {
+	// retrieve the kubelet config from ocm
+	response, err := kcc.connection.Get().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return kubeletConfig, nil
+		}
+
+		return kubeletConfig, fmt.Errorf("error in get request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (kcc *ClusterKubeletConfigClient) Create(
+	ctx context.Context,
+	builder *clustersmgmtv1.KubeletConfigBuilder,
+) (kubeletConfig *clustersmgmtv1.KubeletConfig, err error) {
+	// build the object to create
+	object, err := builder.Build()
+	if err != nil {
+		return kubeletConfig, fmt.Errorf("unable to build object for kubelet config creation - %w", err)
+	}
+
+	// create the kubelet config in ocm
+	response, err := kcc.connection.Post().Body(object).SendContext(ctx)
+	if err != nil {
+		return kubeletConfig, fmt.Errorf("error in create request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (kcc *ClusterKubeletConfigClient) Update(
+	ctx context.Context,
+	builder *clustersmgmtv1.KubeletConfigBuilder,
+) (kubeletConfig *clustersmgmtv1.KubeletConfig, err error) {
+	// build the object to update
+	object, err := builder.Build()
+	if err != nil {
+		return kubeletConfig, fmt.Errorf("unable to build object for kubelet config update - %w", err)
+	}
+
+	// update the kubelet config in ocm
+	response, err := kcc.connection.Update().Body(object).SendContext(ctx)
+	if err != nil {
+		return kubeletConfig, fmt.Errorf("error in update request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (kcc *ClusterKubeletConfigClient) Delete(ctx context.Context) error {
+	// delete the kubelet config in ocm
+	response, err := kcc.connection.Delete().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error in delete request - %w", err)
+	}
+
+	return nil
+}
+
+// KubeletConfigClient represents the client used to interact with a Kubelet Config API object.  These
+// kubelet configs are associated with clusters that are using hosted control plane and are
+// referenced by name from node pools.
+type KubeletConfigClient struct {
+	name       string
+	connection *clustersmgmtv1.KubeletConfigsClient
+}
+
+func NewKubeletConfigClient(connection *sdk.Connection, name, clusterID string) *KubeletConfigClient {
+	return &KubeletConfigClient{
+		name:       name,
+		connection: connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).KubeletConfigs(),
+	}
+}
+
+func (kcc *KubeletConfigClient) For(id string) *clustersmgmtv1.HcpKubeletConfigClient {
+	return kcc.connection.KubeletConfig(id)
+}
+
+func (kcc *KubeletConfigClient) Get(ctx context.Context) (kubeletConfig *clustersmgmtv1.KubeletConfig, err error) {
+	// retrieve the kubelet config from ocm
+	response, err := kcc.connection.List().SendContext(ctx)
+	if err != nil {
+		return kubeletConfig, fmt.Errorf("error in get request - %w", err)
+	}
+
+	for _, kubeletConfig := range response.Items().Slice() {
+		if kubeletConfig.Name() == kcc.name {
+			return kubeletConfig, nil
+		}
+	}
+
+	// return a nil kubelet config and nil error here and let the caller determine how to handle
+	// a missing kubelet config
+	return kubeletConfig, nil
+}
+
+func (kcc *KubeletConfigClient) Create(
+	ctx context.Context,
+	builder *clustersmgmtv1.KubeletConfigBuilder,
+) (kubeletConfig *clustersmgmtv1.KubeletConfig, err error) {
+	// build the object to create
+	object, err := builder.Build()
+	if err != nil {
+		return kubeletConfig, fmt.Errorf("unable to build object for kubelet config creation - %w", err)
+	}
+
+	// create the kubelet config in ocm
+	response, err := kcc.connection.Add().Body(object).SendContext(ctx)
+	if err != nil {
+		return kubeletConfig, fmt.Errorf("error in create request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (kcc *KubeletConfigClient) Update(
+	ctx context.Context,
+	builder *clustersmgmtv1.KubeletConfigBuilder,
+) (kubeletConfig *clustersmgmtv1.KubeletConfig, err error) {
+	// build the object to update
+	object, err := builder.Build()
+	if err != nil {
+		return kubeletConfig, fmt.Errorf("unable to build object for kubelet config update - %w", err)
+	}
+
+	// update the kubelet config in ocm
+	response, err := kcc.For(object.ID()).Update().Body(object).SendContext(ctx)
+	if err != nil {
+		return kubeletConfig, fmt.Errorf("error in update request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (kcc *KubeletConfigClient) Delete(ctx context.Context, id string) error {
+	// delete the kubelet config in ocm
+	response, err := kcc.For(id).Delete().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error in delete request - %w", err)
+	}
+
+	return nil
+}
@@ -1,6 +1,7 @@
 package ocm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -31,9 +32,9 @@ func (mpc *MachinePoolClient) For(machinePoolName string) *clustersmgmtv1.Machin
 	return mpc.connection.MachinePool(machinePoolName)
 }
 
-func (mpc *MachinePoolClient) Get() (machinePool *clustersmgmtv1.MachinePool, err error) {
+func (mpc *MachinePoolClient) Get(ctx context.Context) (machinePool *clustersmgmtv1.MachinePool, err error) {
 	// retrieve the machine pool from ocm
-	response, err := mpc.For(mpc.name).Get().Send()
+	response, err := mpc.For(mpc.name).Get().SendContext(ctx)
 	if err != nil {
 		if response.Status() == http.StatusNotFound {
 			return machinePool, nil
@@ -45,7 +46,7 @@ func (mpc *MachinePoolClient) Get() (machinePool *clustersmgmtv1.MachinePool, er
 	return response.Body(), nil
 }
 
-func (mpc *MachinePoolClient) Create(builder *clustersmgmtv1.MachinePoolBuilder) (machinePool *clustersmgmtv1.MachinePool, err error) {
+func (mpc *MachinePoolClient) Create(ctx context.Context, builder *clustersmgmtv1.MachinePoolBuilder) (machinePool *clustersmgmtv1.MachinePool, err error) {
 	// build the object to create
 	object, err := builder.Build()
 	if err != nil {
@@ -53,7 +54,7 @@ func (mpc *MachinePoolClient) Create(builder *clustersmgmtv1.MachinePoolBuilder)
 	}
 
 	// create the machine pool in ocm
-	response, err := mpc.connection.Add().Body(object).Send()
+	response, err := mpc.connection.Add().Body(object).SendContext(ctx)
 	if err != nil {
 		return machinePool, fmt.Errorf("error in create request - %w", err)
 	}
@@ -61,7 +62,7 @@ func (mpc *MachinePoolClient) Create(builder *clustersmgmtv1.MachinePoolBuilder)
 	return response.Body(), nil
 }
 
-func (mpc *MachinePoolClient) Update(builder *clustersmgmtv1.MachinePoolBuilder) (machinePool *clustersmgmtv1.MachinePool, err error) {
+func (mpc *MachinePoolClient) Update(ctx context.Context, builder *clustersmgmtv1.MachinePoolBuilder) (machinePool *clustersmgmtv1.MachinePool, err error) {
 	// build the object to update
 	object, err := builder.Build()
 	if err != nil {
@@ -69,7 +70,7 @@ func (mpc *MachinePoolClient) Update(builder *clustersmgmtv1.MachinePoolBuilder)
 	}
 
 	// update the machine pool in ocm
-	response, err := mpc.For(object.ID()).Update().Body(object).Send()
+	response, err := mpc.For(object.ID()).Update().Body(object).SendContext(ctx)
 	if err != nil {
 		return machinePool, fmt.Errorf("error in update request - %w", err)
 	}
@@ -77,9 +78,9 @@ func (mpc *MachinePoolClient) Update(builder *clustersmgmtv1.MachinePoolBuilder)
 	return response.Body(), nil
 }
 
-func (mpc *MachinePoolClient) Delete(id string) error {
+func (mpc *MachinePoolClient) Delete(ctx context.Context, id string) error {
 	// delete the machine pool in ocm
-	response, err := mpc.For(id).Delete().Send()
+	response, err := mpc.For(id).Delete().SendContext(ctx)
 	if err != nil {
 		if response.Status() == http.StatusNotFound {
 			return nil
@@ -1,14 +1,22 @@
 package ocm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
+	"time"
 
 	sdk "github.com/openshift-online/ocm-sdk-go"
 	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 )
 
+const (
+	// nodePoolUpgradeDelay is the delay from now that a node pool upgrade is scheduled
+	// for.  OCM requires that manual upgrades are scheduled in the future.
+	nodePoolUpgradeDelay = 10 * time.Minute
+)
+
 var (
 	ErrConvertNodePool = errors.New("error converting to node pool object")
 )
@@ -31,9 +39,9 @@ func (npc *NodePoolClient) For(nodePoolName string) *clustersmgmtv1.NodePoolClie
 	return npc.connection.NodePool(nodePoolName)
 }
 
-func (npc *NodePoolClient) Get() (nodePool *clustersmgmtv1.NodePool, err error) {
+func (npc *NodePoolClient) Get(ctx context.Context) (nodePool *clustersmgmtv1.NodePool, err error) {
 	// retrieve the node pool from ocm
-	response, err := npc.For(npc.name).Get().Send()
+	response, err := npc.For(npc.name).Get().SendContext(ctx)
 	if err != nil {
 		if response.Status() == http.StatusNotFound {
 			return nodePool, nil
@@ -45,7 +53,7 @@ func (npc *NodePoolClient) Get() (nodePool *clustersmgmtv1.NodePool, err error)
 	return response.Body(), nil
 }
 
-func (npc *NodePoolClient) Create(builder *clustersmgmtv1.NodePoolBuilder) (nodePool *clustersmgmtv1.NodePool, err error) {
+func (npc *NodePoolClient) Create(ctx context.Context, builder *clustersmgmtv1.NodePoolBuilder) (nodePool *clustersmgmtv1.NodePool, err error) {
 	// build the object to create
 	object, err := builder.Build()
 	if err != nil {
@@ -53,7 +61,7 @@ func (npc *NodePoolClient) Create(builder *clustersmgmtv1.NodePoolBuilder) (node
 	}
 
 	// create the node pool in ocm
-	response, err := npc.connection.Add().Body(object).Send()
+	response, err := npc.connection.Add().Body(object).SendContext(ctx)
 	if err != nil {
 		return nodePool, fmt.Errorf("error in create request - %w", err)
 	}
@@ -61,7 +69,7 @@ func (npc *NodePoolClient) Create(builder *clustersmgmtv1.NodePoolBuilder) (node
 	return response.Body(), nil
 }
 
-func (npc *NodePoolClient) Update(builder *clustersmgmtv1.NodePoolBuilder) (nodePool *clustersmgmtv1.NodePool, err error) {
+func (npc *NodePoolClient) Update(ctx context.Context, builder *clustersmgmtv1.NodePoolBuilder) (nodePool *clustersmgmtv1.NodePool, err error) {
 	// build the object to update
 	object, err := builder.Build()
 	if err != nil {
@@ -69,7 +77,7 @@ func (npc *NodePoolClient) Update(builder *clustersmgmtv1.NodePoolBuilder) (node
 	}
 
 	// update the node pool in ocm
-	response, err := npc.For(object.ID()).Update().Body(object).Send()
+	response, err := npc.For(object.ID()).Update().Body(object).SendContext(ctx)
 	if err != nil {
 		return nodePool, fmt.Errorf("error in update request - %w", err)
 	}
@@ -77,9 +85,9 @@ func (npc *NodePoolClient) Update(builder *clustersmgmtv1.NodePoolBuilder) (node
 	return response.Body(), nil
 }
 
-func (npc *NodePoolClient) Delete(id string) error {
+func (npc *NodePoolClient) Delete(ctx context.Context, id string) error {
 	// delete the node pool in ocm
-	response, err := npc.For(id).Delete().Send()
+	response, err := npc.For(id).Delete().SendContext(ctx)
 	if err != nil {
 		if response.Status() == http.StatusNotFound {
 			return nil
@@ -90,3 +98,35 @@ func (npc *NodePoolClient) Delete(id string) error {
 
 	return nil
 }
+
+func (npc *NodePoolClient) ListUpgradePolicies(ctx context.Context, id string) (policies []*clustersmgmtv1.NodePoolUpgradePolicy, err error) {
+	// retrieve the upgrade policies for the node pool from ocm
+	response, err := npc.For(id).UpgradePolicies().List().SendContext(ctx)
+	if err != nil {
+		return policies, fmt.Errorf("error in list upgrade policies request - %w", err)
+	}
+
+	return response.Items().Slice(), nil
+}
+
+func (npc *NodePoolClient) Upgrade(ctx context.Context, id, version string) (policy *clustersmgmtv1.NodePoolUpgradePolicy, err error) {
+	// build the object to create
+	object, err := clustersmgmtv1.NewNodePoolUpgradePolicy().
+		NodePoolID(id).
+		UpgradeType(clustersmgmtv1.UpgradeTypeNodePool).
+		ScheduleType(clustersmgmtv1.ScheduleTypeManual).
+		Version(version).
+		NextRun(time.Now().UTC().Add(nodePoolUpgradeDelay)).
+		Build()
+	if err != nil {
+		return policy, fmt.Errorf("unable to build object for node pool upgrade policy creation - %w", err)
+	}
+
+	// create the upgrade policy in ocm
+	response, err := npc.For(id).UpgradePolicies().Add().Body(object).SendContext(ctx)
+	if err != nil {
+		return policy, fmt.Errorf("error in create upgrade policy request - %w", err)
+	}
+
+	return response.Body(), nil
+}
@@ -0,0 +1,155 @@
+// Package ocmtest provides a fake OpenShift Cluster Manager API, and a connection to it, for
+// testing the phases of the controllers without a real OpenShift Cluster Manager.
+package ocmtest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+)
+
+// Response is a response returned by the fake API for a route.
+type Response struct {
+	Status int
+	Body   string
+}
+
+// Request is a request which was received by the fake API.
+type Request struct {
+	Method string
+	Path   string
+	Body   string
+}
+
+// Server is a fake OpenShift Cluster Manager API which returns a fixed response for each route
+// and records the requests which it received.  Routes are keyed by method and path, for
+// example "GET /api/clusters_mgmt/v1/clusters/test/ingresses".  Requests for any other route
+// return a not found error.
+type Server struct {
+	server   *httptest.Server
+	routes   map[string]Response
+	mutex    sync.Mutex
+	requests []Request
+}
+
+// NewServer starts a fake API which returns the given responses.  The server is closed
+// when the test completes.
+func NewServer(t *testing.T, routes map[string]Response) *Server {
+	t.Helper()
+
+	s := &Server{routes: routes}
+	s.server = httptest.NewServer(http.HandlerFunc(s.serve))
+	t.Cleanup(s.server.Close)
+
+	return s
+}
+
+// Connection returns a connection to the fake API, with the requests passing through the given
+// transport wrappers.  The connection is closed when the test completes.
+func (s *Server) Connection(t *testing.T, wrappers ...sdk.TransportWrapper) *sdk.Connection {
+	t.Helper()
+
+	builder := sdk.NewConnectionBuilder().
+		URL(s.server.URL).
+		Tokens(token()).
+		RetryLimit(0)
+
+	for _, wrapper := range wrappers {
+		builder = builder.TransportWrapper(wrapper)
+	}
+
+	connection, err := builder.Build()
+	if err != nil {
+		t.Fatalf("unable to create ocm connection - %v", err)
+	}
+
+	t.Cleanup(func() { _ = connection.Close() })
+
+	return connection
+}
+
+// Requests returns the requests which have been received, in order, excluding those which
+// only read objects.
+func (s *Server) Requests() []Request {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	requests := []Request{}
+
+	for _, request := range s.requests {
+		if request.Method != http.MethodGet {
+			requests = append(requests, request)
+		}
+	}
+
+	return requests
+}
+
+func (s *Server) serve(w http.ResponseWriter, r *http.Request) {
+	body, _ := io.ReadAll(r.Body)
+
+	s.mutex.Lock()
+	s.requests = append(s.requests, Request{Method: r.Method, Path: r.URL.Path, Body: string(body)})
+	s.mutex.Unlock()
+
+	w.Header().Set("Content-Type", "application/json")
+
+	response, ok := s.routes[r.Method+" "+r.URL.Path]
+	if !ok {
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprintf(w, `{"kind":"Error","id":"404","code":"CLUSTERS-MGMT-404","reason":"%s not found"}`, r.URL.Path)
+
+		return
+	}
+
+	if response.Status == 0 {
+		response.Status = http.StatusOK
+	}
+
+	w.WriteHeader(response.Status)
+	fmt.Fprint(w, response.Body)
+}
+
+// Marshal returns the body of a response for an object, using the marshal function of the
+// sdk for its type (e.g. clustersmgmtv1.MarshalIngress).
+func Marshal[T any](t *testing.T, object T, marshal func(T, io.Writer) error) string {
+	t.Helper()
+
+	var body bytes.Buffer
+	if err := marshal(object, &body); err != nil {
+		t.Fatalf("unable to marshal response body - %v", err)
+	}
+
+	return body.String()
+}
+
+// ClusterList returns the response to a search for a cluster which exists with the given id
+// and name.
+func ClusterList(id, name string) Response {
+	return Response{
+		Body: fmt.Sprintf(
+			`{"kind":"ClusterList","page":1,"size":1,"total":1,"items":[{"kind":"Cluster","id":"%s","name":"%s"}]}`,
+			id,
+			name,
+		),
+	}
+}
+
+// token returns an unsigned access token which does not expire during a test, so that the
+// connection never attempts to refresh it.
+func token() string {
+	encode := base64.RawURLEncoding.EncodeToString
+
+	header := encode([]byte(`{"alg":"none","typ":"JWT"}`))
+	claims := encode([]byte(fmt.Sprintf(`{"typ":"Bearer","exp":%d}`, time.Now().Add(time.Hour).Unix())))
+
+	return header + "." + claims + "."
+}
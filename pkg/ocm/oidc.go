@@ -1,6 +1,7 @@
 package ocm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -27,9 +28,9 @@ func (cfgClient *oidcConfigClient) For(id string) *clustersmgmtv1.OidcConfigClie
 	return cfgClient.connection.OidcConfig(id)
 }
 
-func (cfgClient *oidcConfigClient) Get(id string) (oidcConfig *clustersmgmtv1.OidcConfig, err error) {
+func (cfgClient *oidcConfigClient) Get(ctx context.Context, id string) (oidcConfig *clustersmgmtv1.OidcConfig, err error) {
 	// retrieve the oidc config from openshift cluster manager
-	response, err := cfgClient.For(id).Get().Send()
+	response, err := cfgClient.For(id).Get().SendContext(ctx)
 	if err != nil {
 		return oidcConfig, fmt.Errorf("error in get request - %w", err)
 	}
@@ -37,7 +38,7 @@ func (cfgClient *oidcConfigClient) Get(id string) (oidcConfig *clustersmgmtv1.Oi
 	return response.Body(), nil
 }
 
-func (cfgClient *oidcConfigClient) Create() (oidcConfig *clustersmgmtv1.OidcConfig, err error) {
+func (cfgClient *oidcConfigClient) Create(ctx context.Context) (oidcConfig *clustersmgmtv1.OidcConfig, err error) {
 	// build the object to create
 	object, err := clustersmgmtv1.NewOidcConfig().Managed(true).Build()
 	if err != nil {
@@ -45,7 +46,7 @@ func (cfgClient *oidcConfigClient) Create() (oidcConfig *clustersmgmtv1.OidcConf
 	}
 
 	// create the oidc config provider
-	response, err := cfgClient.connection.Add().Body(object).Send()
+	response, err := cfgClient.connection.Add().Body(object).SendContext(ctx)
 	if err != nil {
 		return oidcConfig, fmt.Errorf("error in create request - %w", err)
 	}
@@ -53,9 +54,9 @@ func (cfgClient *oidcConfigClient) Create() (oidcConfig *clustersmgmtv1.OidcConf
 	return response.Body(), nil
 }
 
-func (cfgClient *oidcConfigClient) Delete(id string) error {
+func (cfgClient *oidcConfigClient) Delete(ctx context.Context, id string) error {
 	// delete the identity provider in ocm
-	response, err := cfgClient.For(id).Delete().Send()
+	response, err := cfgClient.For(id).Delete().SendContext(ctx)
 	if err != nil {
 		if response.Status() == http.StatusNotFound {
 			return nil
@@ -1,6 +1,7 @@
 package ocm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 
@@ -65,8 +66,8 @@ func NewSTSClient(
 	}
 }
 
-func (stsClient *STSClient) GetCredentialRequests() ([]*STSCredentialRequest, error) {
-	stsCredentialResponse, err := stsClient.CredentialRequest.Send()
+func (stsClient *STSClient) GetCredentialRequests(ctx context.Context) ([]*STSCredentialRequest, error) {
+	stsCredentialResponse, err := stsClient.CredentialRequest.SendContext(ctx)
 	if err != nil {
 		return []*STSCredentialRequest{}, fmt.Errorf("error retrieving sts credential requests - %w", err)
 	}
@@ -108,12 +109,13 @@ func (stsClient *STSClient) GetCredentialRequests() ([]*STSCredentialRequest, er
 //
 //nolint:cyclop
 func (stsClient *STSClient) CreateOperatorRoles(
+	ctx context.Context,
 	awsClient *aws.Client,
 	ver *clustersmgmtv1.Version,
 	requests ...*STSCredentialRequest,
 ) error {
 	// get the list of policies
-	policyResponse, err := stsClient.PolicyRequest.Send()
+	policyResponse, err := stsClient.PolicyRequest.SendContext(ctx)
 	if err != nil {
 		return fmt.Errorf("unable to retrieve sts policies - %w", err)
 	}
@@ -166,7 +168,11 @@ func (stsClient *STSClient) CreateOperatorRoles(
 			)
 
 			// ensure the policy exists
-			_, err = awsClient.Connection.EnsurePolicy(policyARN, getPolicyDetails(policyID, policies...), version, tagsList, "")
+			err = awsClient.Call(ctx, "EnsurePolicy", true, func() error {
+				_, err := awsClient.Connection.EnsurePolicy(policyARN, getPolicyDetails(policyID, policies...), version, tagsList, "")
+
+				return err
+			})
 			if err != nil {
 				return fmt.Errorf("unable to create policy [%s] - %w", policyID, err)
 			}
@@ -183,13 +189,19 @@ func (stsClient *STSClient) CreateOperatorRoles(
 			return fmt.Errorf("error retrieving iam role policy details - %w", err)
 		}
 
-		_, err = awsClient.Connection.EnsureRole(roleName, policy, "", "", tagsList, "", stsClient.ManagedPolicies)
+		err = awsClient.Call(ctx, "EnsureRole", true, func() error {
+			_, err := awsClient.Connection.EnsureRole(roleName, policy, "", "", tagsList, "", stsClient.ManagedPolicies)
+
+			return err
+		})
 		if err != nil {
 			return fmt.Errorf("unable to create aws iam role [%s] - %w", roleName, err)
 		}
 
 		// attach the policy to the role
-		if err := awsClient.Connection.AttachRolePolicy(roleName, policyARN); err != nil {
+		if err := awsClient.Call(ctx, "AttachRolePolicy", true, func() error {
+			return awsClient.Connection.AttachRolePolicy(roleName, policyARN)
+		}); err != nil {
 			return fmt.Errorf("unable to attach iam policy [%s] to iam role [%s] - %w", policyARN, roleName, err)
 		}
 	}
@@ -199,7 +211,11 @@ func (stsClient *STSClient) CreateOperatorRoles(
 
 // DeleteOperatorRoles deletes the operator roles given a specific version and a set of
 // credential requests obtained from OCM.
-func (stsClient *STSClient) DeleteOperatorRoles(awsClient *aws.Client, requests ...*STSCredentialRequest) error {
+func (stsClient *STSClient) DeleteOperatorRoles(
+	ctx context.Context,
+	awsClient *aws.Client,
+	requests ...*STSCredentialRequest,
+) error {
 	// turn our requests into a format understood by the underlying library
 	requestsMap := make(map[string]*clustersmgmtv1.STSOperator)
 
@@ -208,7 +224,13 @@ func (stsClient *STSClient) DeleteOperatorRoles(awsClient *aws.Client, requests
 	}
 
 	// get the operator roles
-	operatorRoles, err := awsClient.Connection.GetOperatorRolesFromAccountByPrefix(stsClient.Prefix, requestsMap)
+	var operatorRoles []string
+
+	err := awsClient.Call(ctx, "GetOperatorRolesFromAccountByPrefix", true, func() (err error) {
+		operatorRoles, err = awsClient.Connection.GetOperatorRolesFromAccountByPrefix(stsClient.Prefix, requestsMap)
+
+		return err
+	})
 	if err != nil {
 		return fmt.Errorf("unable to retrieve operator roles - %w", err)
 	}
@@ -220,7 +242,9 @@ func (stsClient *STSClient) DeleteOperatorRoles(awsClient *aws.Client, requests
 
 	// delete the operator roles
 	for _, role := range operatorRoles {
-		if err := awsClient.Connection.DeleteOperatorRole(role, stsClient.ManagedPolicies); err != nil {
+		if err := awsClient.Call(ctx, "DeleteOperatorRole", true, func() error {
+			return awsClient.Connection.DeleteOperatorRole(role, stsClient.ManagedPolicies)
+		}); err != nil {
 			return fmt.Errorf("unable to delete role [%s] - %w", role, err)
 		}
 	}
@@ -0,0 +1,99 @@
+package ocm
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+// TuningConfigClient represents the client used to interact with a Tuning Config API object.  Tuning
+// configs are associated with clusters that are using hosted control plane and are referenced by
+// name from node pools.
+type TuningConfigClient struct {
+	name       string
+	connection *clustersmgmtv1.TuningConfigsClient
+}
+
+func NewTuningConfigClient(connection *sdk.Connection, name, clusterID string) *TuningConfigClient {
+	return &TuningConfigClient{
+		name:       name,
+		connection: connection.ClustersMgmt().V1().Clusters().Cluster(clusterID).TuningConfigs(),
+	}
+}
+
+func (tcc *TuningConfigClient) For(id string) *clustersmgmtv1.TuningConfigClient {
+	return tcc.connection.TuningConfig(id)
+}
+
+func (tcc *TuningConfigClient) Get(ctx context.Context) (tuningConfig *clustersmgmtv1.TuningConfig, err error) {
+	// retrieve the tuning config from ocm
+	response, err := tcc.connection.List().SendContext(ctx)
+	if err != nil {
+		return tuningConfig, fmt.Errorf("error in get request - %w", err)
+	}
+
+	for _, tuningConfig := range response.Items().Slice() {
+		if tuningConfig.Name() == tcc.name {
+			return tuningConfig, nil
+		}
+	}
+
+	// return a nil tuning config and nil error here and let the caller determine how to handle
+	// a missing tuning config
+	return tuningConfig, nil
+}
+
+func (tcc *TuningConfigClient) Create(
+	ctx context.Context,
+	builder *clustersmgmtv1.TuningConfigBuilder,
+) (tuningConfig *clustersmgmtv1.TuningConfig, err error) {
+	// build the object to create
+	object, err := builder.Build()
+	if err != nil {
+		return tuningConfig, fmt.Errorf("unable to build object for tuning config creation - %w", err)
+	}
+
+	// create the tuning config in ocm
+	response, err := tcc.connection.Add().Body(object).SendContext(ctx)
+	if err != nil {
+		return tuningConfig, fmt.Errorf("error in create request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (tcc *TuningConfigClient) Update(
+	ctx context.Context,
+	builder *clustersmgmtv1.TuningConfigBuilder,
+) (tuningConfig *clustersmgmtv1.TuningConfig, err error) {
+	// build the object to update
+	object, err := builder.Build()
+	if err != nil {
+		return tuningConfig, fmt.Errorf("unable to build object for tuning config update - %w", err)
+	}
+
+	// update the tuning config in ocm
+	response, err := tcc.For(object.ID()).Update().Body(object).SendContext(ctx)
+	if err != nil {
+		return tuningConfig, fmt.Errorf("error in update request - %w", err)
+	}
+
+	return response.Body(), nil
+}
+
+func (tcc *TuningConfigClient) Delete(ctx context.Context, id string) error {
+	// delete the tuning config in ocm
+	response, err := tcc.For(id).Delete().SendContext(ctx)
+	if err != nil {
+		if response.Status() == http.StatusNotFound {
+			return nil
+		}
+
+		return fmt.Errorf("error in delete request - %w", err)
+	}
+
+	return nil
+}
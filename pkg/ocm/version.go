@@ -1,6 +1,7 @@
 package ocm
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"sort"
@@ -11,29 +12,38 @@ import (
 	clustersmgmtv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 )
 
+const (
+	ChannelGroupStable = "stable"
+
+	versionPageSize = 100
+)
+
 var (
-	ErrVersionNotFound  = errors.New("unable to find version")
-	ErrVersionsNotFound = errors.New("unable to find available versions")
+	ErrVersionNotFound          = errors.New("unable to find version")
+	ErrVersionsNotFound         = errors.New("unable to find available versions")
+	ErrInvalidVersionConstraint = errors.New("invalid version constraint")
 )
 
-// GetVersionObject returns the version object for a particular raw version.  It assumes stable channel only
-// as well as only versions that are enabled and available in ROSA.
-func GetVersionObject(connection *sdk.Connection, rawVersion string) (version *clustersmgmtv1.Version, err error) {
-	filter := fmt.Sprintf("enabled = 'true' AND rosa_enabled = 'true' AND raw_id = '%s' AND channel_group = 'stable'", rawVersion)
+// GetVersionObject returns the version object for a particular raw version.  It assumes only versions
+// that are enabled and available in ROSA.  If the channel group is empty, the stable channel group
+// is used.
+func GetVersionObject(ctx context.Context, connection *sdk.Connection, rawVersion, channelGroup string) (version *clustersmgmtv1.Version, err error) {
+	filter := fmt.Sprintf(
+		"enabled = 'true' AND rosa_enabled = 'true' AND raw_id = '%s' AND channel_group = '%s'",
+		rawVersion,
+		getChannelGroup(channelGroup),
+	)
 
-	versions, err := connection.ClustersMgmt().V1().Versions().List().Search(filter).Send()
+	versions, err := listVersions(ctx, connection, filter)
 	if err != nil {
 		return version, fmt.Errorf("unable to get versions - %w", err)
 	}
 
-	if len(versions.Items().Slice()) == 0 {
+	if len(versions) == 0 {
 		return version, ErrVersionNotFound
 	}
 
-	// sort list in descending order
-	sortVersions(versions.Items().Slice())
-
-	return versions.Items().Slice()[0], nil
+	return versions[0], nil
 }
 
 // MajorMinorVersion returns the major and minor representation of a version object.
@@ -43,43 +53,32 @@ func MajorMinorVersion(version *clustersmgmtv1.Version) string {
 	return fmt.Sprintf("%s.%s", versionSplit[0], versionSplit[1])
 }
 
-// GetAvailableVersions gets all available versions from OCM.
+// GetAvailableVersions gets all available versions of a channel group from OCM.  If the channel group
+// is empty, the stable channel group is used.
 // Copied from https://github.com/openshift/rosa/blob/master/pkg/ocm/versions.go#L54
-func GetAvailableVersions(connection *sdk.Connection) (versions []*clustersmgmtv1.Version, err error) {
-	collection := connection.ClustersMgmt().V1().Versions()
-	page := 1
-	size := 100
-	filter := "enabled = 'true' AND rosa_enabled = 'true' AND channel_group = 'stable' AND default = 'true'"
-
-	for {
-		var response *clustersmgmtv1.VersionsListResponse
-		response, err = collection.List().
-			Search(filter).
-			Page(page).
-			Size(size).
-			Send()
-		if err != nil {
-			return versions, fmt.Errorf("unable to list versions at page [%d] - %w", page, err)
-		}
-		versions = append(versions, response.Items().Slice()...)
-		if response.Size() < size {
-			break
-		}
-		page++
-	}
-
-	// sort list in descending order
-	sortVersions(versions)
-
-	return versions, nil
+func GetAvailableVersions(ctx context.Context, connection *sdk.Connection, channelGroup string) (versions []*clustersmgmtv1.Version, err error) {
+	return listVersions(ctx, connection, fmt.Sprintf(
+		"enabled = 'true' AND rosa_enabled = 'true' AND channel_group = '%s'",
+		getChannelGroup(channelGroup),
+	))
 }
 
-// GetDefaultVersion gets the default (latest) version.
+// GetDefaultVersion gets the default (latest) version of a channel group.  Channel groups which do not
+// flag any version as a default use the latest available version instead.
 // Copied from https://github.com/openshift/rosa/blob/master/pkg/ocm/versions.go#L219.
-func GetDefaultVersion(connection *sdk.Connection) (version *clustersmgmtv1.Version, err error) {
-	response, err := GetAvailableVersions(connection)
+func GetDefaultVersion(ctx context.Context, connection *sdk.Connection, channelGroup string) (version *clustersmgmtv1.Version, err error) {
+	response, err := listVersions(ctx, connection, fmt.Sprintf(
+		"enabled = 'true' AND rosa_enabled = 'true' AND channel_group = '%s' AND default = 'true'",
+		getChannelGroup(channelGroup),
+	))
 	if err != nil {
-		return version, fmt.Errorf("unable to get available versions - %w", err)
+		return version, fmt.Errorf("unable to get default versions - %w", err)
+	}
+
+	if len(response) == 0 {
+		if response, err = GetAvailableVersions(ctx, connection, channelGroup); err != nil {
+			return version, fmt.Errorf("unable to get available versions - %w", err)
+		}
 	}
 
 	if len(response) > 0 {
@@ -91,6 +90,165 @@ func GetDefaultVersion(connection *sdk.Connection) (version *clustersmgmtv1.Vers
 	return version, ErrVersionsNotFound
 }
 
+// ResolveVersion resolves a version constraint to the latest matching version of a channel group.  The
+// constraint may be empty, in which case the default version is returned, an exact version (e.g. 4.14.5)
+// or a constraint (e.g. ~4.14, 4.15.x or >= 4.14.5, < 4.15).  Constraints never match a pre-release
+// version, so the versions of a channel group other than stable, which are commonly release candidates
+// (e.g. 4.15.0-rc.2) or nightly builds, are also matched by their core version (e.g. 4.15.0).
+func ResolveVersion(ctx context.Context, connection *sdk.Connection, constraint, channelGroup string) (*clustersmgmtv1.Version, error) {
+	if constraint == "" {
+		return GetDefaultVersion(ctx, connection, channelGroup)
+	}
+
+	if isExactVersion(constraint) {
+		return GetVersionObject(ctx, connection, constraint, channelGroup)
+	}
+
+	constraints, err := ParseVersionConstraint(constraint)
+	if err != nil {
+		return nil, err
+	}
+
+	versions, err := GetAvailableVersions(ctx, connection, channelGroup)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get available versions - %w", err)
+	}
+
+	// versions are sorted in descending order, so the first match is the latest
+	for _, version := range versions {
+		parsed, err := ver.NewVersion(version.RawID())
+		if err != nil {
+			continue
+		}
+
+		if constraints.Check(parsed) {
+			return version, nil
+		}
+
+		if getChannelGroup(channelGroup) != ChannelGroupStable && constraints.Check(parsed.Core()) {
+			return version, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no version in channel group [%s] matches [%s] - %w", getChannelGroup(channelGroup), constraint, ErrVersionNotFound)
+}
+
+// ParseVersionConstraint parses a version constraint.  In addition to the syntax supported by
+// hashicorp/go-version, a leading '~' allows patch releases of the given version (e.g. ~4.14 is
+// equivalent to ~> 4.14.0), an 'x' acts as a wildcard (e.g. 4.15.x is equivalent to ~> 4.15.0)
+// and a partial version allows its patch releases (e.g. 4.14 is equivalent to ~> 4.14.0).
+func ParseVersionConstraint(constraint string) (ver.Constraints, error) {
+	constraint = strings.TrimSpace(constraint)
+
+	switch {
+	case isPartialVersion(constraint):
+		constraint = pessimisticConstraint(constraint)
+	case strings.HasPrefix(constraint, "~") && !strings.HasPrefix(constraint, "~>"):
+		constraint = pessimisticConstraint(strings.TrimPrefix(constraint, "~"))
+	case strings.HasSuffix(constraint, ".x"):
+		constraint = pessimisticConstraint(strings.TrimSuffix(constraint, ".x"))
+	}
+
+	constraints, err := ver.NewConstraint(constraint)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse version constraint [%s] - %w", constraint, ErrInvalidVersionConstraint)
+	}
+
+	return constraints, nil
+}
+
+// pessimisticConstraint returns a constraint which allows only the last segment of a version to
+// increase, with a version of x.y allowing only patch releases of x.y.
+func pessimisticConstraint(version string) string {
+	version = strings.TrimSpace(version)
+	if strings.Count(version, ".") < 2 {
+		version += ".0"
+	}
+
+	return "~> " + version
+}
+
+// isExactVersion determines if a version constraint is an exact version in format of x.y.z.
+func isExactVersion(constraint string) bool {
+	if _, err := ver.NewSemver(constraint); err != nil {
+		return false
+	}
+
+	return strings.Count(constraint, ".") == 2
+}
+
+// isPartialVersion determines if a version constraint is a version which omits its patch
+// release (e.g. 4.14).
+func isPartialVersion(constraint string) bool {
+	if _, err := ver.NewSemver(constraint); err != nil {
+		return false
+	}
+
+	return strings.Count(constraint, ".") < 2
+}
+
+// getChannelGroup returns the channel group, defaulting to the stable channel group.
+func getChannelGroup(channelGroup string) string {
+	if channelGroup == "" {
+		return ChannelGroupStable
+	}
+
+	return channelGroup
+}
+
+// listVersions lists all versions from OCM which match a filter, sorted with the newest version first.
+// Responses are cached by their filter, as the same versions are requested by many objects.
+func listVersions(ctx context.Context, connection *sdk.Connection, filter string) ([]*clustersmgmtv1.Version, error) {
+	versions, err := versionCache.get(ctx, filter, func(ctx context.Context) (versions []*clustersmgmtv1.Version, err error) {
+		collection := connection.ClustersMgmt().V1().Versions()
+		page := 1
+
+		for {
+			var response *clustersmgmtv1.VersionsListResponse
+			response, err = collection.List().
+				Search(filter).
+				Page(page).
+				Size(versionPageSize).
+				SendContext(ctx)
+			if err != nil {
+				return versions, fmt.Errorf("unable to list versions at page [%d] - %w", page, err)
+			}
+			versions = append(versions, response.Items().Slice()...)
+			if response.Size() < versionPageSize {
+				break
+			}
+			page++
+		}
+
+		// sort list in descending order
+		sortVersions(versions)
+
+		return versions, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// return a copy so that the cached versions are not modified by the caller
+	return append([]*clustersmgmtv1.Version(nil), versions...), nil
+}
+
+// CompareVersions compares two raw versions (e.g. 4.14.5).  It returns -1, 0 or 1 if the
+// first version is less than, equal to or greater than the second version.
+func CompareVersions(first, second string) (int, error) {
+	a, err := ver.NewVersion(first)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse version [%s] - %w", first, err)
+	}
+
+	b, err := ver.NewVersion(second)
+	if err != nil {
+		return 0, fmt.Errorf("unable to parse version [%s] - %w", second, err)
+	}
+
+	return a.Compare(b), nil
+}
+
 // sortVersions sorts versions with the newest version being the first in the
 // slice.
 func sortVersions(versions []*clustersmgmtv1.Version) {
@@ -0,0 +1,162 @@
+package ocm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	ver "github.com/hashicorp/go-version"
+
+	"github.com/rh-mobb/ocm-operator/pkg/ocm/ocmtest"
+)
+
+func TestParseVersionConstraint(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		constraint string
+		matches    []string
+		misses     []string
+		wantErr    error
+	}{
+		{
+			name:       "ensure tilde constraint matches patch releases",
+			constraint: "~4.14",
+			matches:    []string{"4.14.0", "4.14.12"},
+			misses:     []string{"4.13.30", "4.15.0"},
+		},
+		{
+			name:       "ensure wildcard constraint matches patch releases",
+			constraint: "4.15.x",
+			matches:    []string{"4.15.0", "4.15.3"},
+			misses:     []string{"4.14.12", "4.16.0"},
+		},
+		{
+			name:       "ensure partial version matches patch releases",
+			constraint: "4.14",
+			matches:    []string{"4.14.0", "4.14.12"},
+			misses:     []string{"4.15.0"},
+		},
+		{
+			name:       "ensure native constraint is supported",
+			constraint: ">= 4.14.5, < 4.15",
+			matches:    []string{"4.14.5", "4.14.9"},
+			misses:     []string{"4.14.4", "4.15.0"},
+		},
+		{
+			name:       "ensure exact version matches only itself",
+			constraint: "4.14.5",
+			matches:    []string{"4.14.5"},
+			misses:     []string{"4.14.6"},
+		},
+		{
+			name:       "ensure invalid constraint returns an error",
+			constraint: "latest",
+			wantErr:    ErrInvalidVersionConstraint,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := ParseVersionConstraint(tt.constraint)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ParseVersionConstraint() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			for _, version := range tt.matches {
+				if !got.Check(ver.Must(ver.NewVersion(version))) {
+					t.Errorf("ParseVersionConstraint(%s) does not match %s", tt.constraint, version)
+				}
+			}
+
+			for _, version := range tt.misses {
+				if got.Check(ver.Must(ver.NewVersion(version))) {
+					t.Errorf("ParseVersionConstraint(%s) matches %s", tt.constraint, version)
+				}
+			}
+		})
+	}
+}
+
+func TestResolveVersion(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		constraint   string
+		channelGroup string
+		rawIDs       []string
+		want         string
+		wantErr      error
+	}{
+		{
+			name:         "ensure release candidate is not resolved in the stable channel group",
+			constraint:   ">= 4.14",
+			channelGroup: "stable",
+			rawIDs:       []string{"4.15.0-rc.1", "4.14.12", "4.14.5"},
+			want:         "4.14.12",
+		},
+		{
+			name:         "ensure release candidate is resolved by its core version",
+			constraint:   "~4.15",
+			channelGroup: "candidate",
+			rawIDs:       []string{"4.16.0-ec.1", "4.15.0-rc.2", "4.15.0-rc.1", "4.14.12"},
+			want:         "4.15.0-rc.2",
+		},
+		{
+			name:         "ensure nightly build is resolved by its core version",
+			constraint:   "4.16.x",
+			channelGroup: "nightly",
+			rawIDs:       []string{"4.16.0-0.nightly-2024-01-02-000000", "4.15.0-0.nightly-2024-01-01-000000"},
+			want:         "4.16.0-0.nightly-2024-01-02-000000",
+		},
+		{
+			name:         "ensure release candidate is resolved by a pre-release constraint",
+			constraint:   ">= 4.15.0-rc.1, < 4.15.0-rc.3",
+			channelGroup: "fast",
+			rawIDs:       []string{"4.15.0-rc.3", "4.15.0-rc.2"},
+			want:         "4.15.0-rc.2",
+		},
+		{
+			name:         "ensure no matching version returns an error",
+			constraint:   "4.17",
+			channelGroup: "eus",
+			rawIDs:       []string{"4.16.2"},
+			wantErr:      ErrVersionNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			items := []string{}
+			for _, rawID := range tt.rawIDs {
+				items = append(items, fmt.Sprintf(`{"kind":"Version","id":"openshift-v%s","raw_id":"%s"}`, rawID, rawID))
+			}
+
+			server := ocmtest.NewServer(t, map[string]ocmtest.Response{
+				http.MethodGet + " /api/clusters_mgmt/v1/versions": {Body: fmt.Sprintf(
+					`{"kind":"VersionList","page":1,"size":%d,"total":%d,"items":[%s]}`,
+					len(items), len(items), strings.Join(items, ","),
+				)},
+			})
+
+			got, err := ResolveVersion(context.Background(), server.Connection(t), tt.constraint, tt.channelGroup)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ResolveVersion() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if tt.wantErr == nil && got.RawID() != tt.want {
+				t.Errorf("ResolveVersion() = %v, want %v", got.RawID(), tt.want)
+			}
+		})
+	}
+}
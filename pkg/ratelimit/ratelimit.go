@@ -0,0 +1,113 @@
+package ratelimit
+
+import (
+	"flag"
+	"fmt"
+	"math/rand"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	DefaultMaxRetries = 5
+	DefaultBaseDelay  = 500 * time.Millisecond
+	DefaultMaxDelay   = 30 * time.Second
+)
+
+// Config represents the configuration of the rate limiting and retrying of requests to
+// an API.
+type Config struct {
+	// QPS is the number of requests per second which are allowed on average and Burst is the
+	// number of requests which are allowed at the same time.  A QPS of zero disables rate
+	// limiting.
+	QPS   float64
+	Burst int
+
+	// MaxRetries is the number of times a failed request is retried.  The delay between retries
+	// grows exponentially from BaseDelay and is limited to MaxDelay.
+	MaxRetries int
+	BaseDelay  time.Duration
+	MaxDelay   time.Duration
+}
+
+// BindFlags binds the configuration to command line flags, which are prefixed with the name of the
+// API, using the passed in values as the defaults.
+func (config *Config) BindFlags(flags *flag.FlagSet, api string, qps float64, burst int) {
+	flags.Float64Var(&config.QPS, api+"-qps", qps, fmt.Sprintf(
+		"Maximum number of requests per second to the %s API (0 disables rate limiting).", api))
+	flags.IntVar(&config.Burst, api+"-burst", burst, fmt.Sprintf(
+		"Maximum number of requests to the %s API which are allowed at the same time.", api))
+	flags.IntVar(&config.MaxRetries, api+"-max-retries", DefaultMaxRetries, fmt.Sprintf(
+		"Maximum number of times a throttled or failed request to the %s API is retried.", api))
+	flags.DurationVar(&config.BaseDelay, api+"-retry-base-delay", DefaultBaseDelay, fmt.Sprintf(
+		"Delay before the first retry of a request to the %s API.", api))
+	flags.DurationVar(&config.MaxDelay, api+"-retry-max-delay", DefaultMaxDelay, fmt.Sprintf(
+		"Maximum delay between retries of a request to the %s API.", api))
+}
+
+// NewLimiter returns a new token bucket limiter from the configuration.
+func (config Config) NewLimiter() *rate.Limiter {
+	if config.QPS <= 0 {
+		return rate.NewLimiter(rate.Inf, 0)
+	}
+
+	burst := config.Burst
+	if burst < 1 {
+		burst = 1
+	}
+
+	return rate.NewLimiter(rate.Limit(config.QPS), burst)
+}
+
+// Backoff returns the delay before a retry attempt, starting from 0.  The delay grows exponentially
+// and is randomized between half of the delay and the full delay, to avoid many clients retrying
+// at the same time.
+func (config Config) Backoff(attempt int) time.Duration {
+	delay := config.BaseDelay
+	for i := 0; i < attempt && delay < config.MaxDelay; i++ {
+		delay *= 2
+	}
+
+	if delay > config.MaxDelay {
+		delay = config.MaxDelay
+	}
+
+	if delay <= 0 {
+		return 0
+	}
+
+	//nolint:gosec
+	return delay/2 + time.Duration(rand.Int63n(int64(delay/2)+1))
+}
+
+// Limiters is a set of limiters which are shared by key, such as an account.
+type Limiters struct {
+	Config Config
+
+	mutex    sync.Mutex
+	limiters map[string]*rate.Limiter
+}
+
+// NewLimiters returns a new set of limiters from a configuration.
+func NewLimiters(config Config) *Limiters {
+	return &Limiters{
+		Config:   config,
+		limiters: map[string]*rate.Limiter{},
+	}
+}
+
+// For returns the limiter for a key, creating it if it does not exist.
+func (limiters *Limiters) For(key string) *rate.Limiter {
+	limiters.mutex.Lock()
+	defer limiters.mutex.Unlock()
+
+	limiter, found := limiters.limiters[key]
+	if !found {
+		limiter = limiters.Config.NewLimiter()
+		limiters.limiters[key] = limiter
+	}
+
+	return limiter
+}
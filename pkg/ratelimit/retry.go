@@ -0,0 +1,27 @@
+package ratelimit
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/time/rate"
+)
+
+// Do calls a function once the limiter allows it, retrying the call while it returns an error
+// which is retryable.
+func Do(ctx context.Context, limiter *rate.Limiter, config Config, retryable func(error) bool, call func() error) error {
+	for attempt := 0; ; attempt++ {
+		if err := limiter.Wait(ctx); err != nil {
+			return fmt.Errorf("unable to wait for rate limiter - %w", err)
+		}
+
+		err := call()
+		if err == nil || attempt >= config.MaxRetries || !retryable(err) {
+			return err
+		}
+
+		if err := sleep(ctx, config.Backoff(attempt)); err != nil {
+			return err
+		}
+	}
+}
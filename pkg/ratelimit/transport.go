@@ -0,0 +1,154 @@
+package ratelimit
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Transport is an http.RoundTripper which limits the rate of requests and retries requests which
+// were throttled or failed.  Throttled requests (429, or 503 with a Retry-After header) are retried
+// regardless of the method, as the server did not process them, while other failures, including a
+// 503 which may have come from a request that was partially processed, are only retried for
+// idempotent methods.
+type Transport struct {
+	Next    http.RoundTripper
+	Limiter *rate.Limiter
+	Config  Config
+}
+
+// NewTransport returns a new transport which wraps another transport.
+func NewTransport(next http.RoundTripper, limiter *rate.Limiter, config Config) *Transport {
+	return &Transport{
+		Next:    next,
+		Limiter: limiter,
+		Config:  config,
+	}
+}
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (t *Transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	ctx := request.Context()
+
+	// read the body so that it may be sent again on a retry
+	var body []byte
+	if request.Body != nil {
+		var err error
+		if body, err = io.ReadAll(request.Body); err != nil {
+			return nil, fmt.Errorf("unable to read request body - %w", err)
+		}
+
+		if err := request.Body.Close(); err != nil {
+			return nil, fmt.Errorf("unable to close request body - %w", err)
+		}
+	}
+
+	for attempt := 0; ; attempt++ {
+		if err := t.Limiter.Wait(ctx); err != nil {
+			return nil, fmt.Errorf("unable to wait for rate limiter - %w", err)
+		}
+
+		if body != nil {
+			request.Body = io.NopCloser(bytes.NewReader(body))
+		}
+
+		response, err := t.Next.RoundTrip(request)
+		if attempt >= t.Config.MaxRetries || !retryable(request, response, err) {
+			return response, err
+		}
+
+		// honor the delay requested by the server.  if the server requests a delay which is
+		// longer than we are willing to wait, return the response to the caller instead.
+		delay := t.Config.Backoff(attempt)
+		if retryAfter, found := RetryAfter(response, time.Now()); found {
+			if retryAfter > t.Config.MaxDelay {
+				return response, err
+			}
+
+			delay = retryAfter
+		}
+
+		if response != nil {
+			_, _ = io.Copy(io.Discard, response.Body)
+			_ = response.Body.Close()
+		}
+
+		if err := sleep(ctx, delay); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// RetryAfter returns the delay requested by the Retry-After header of a response, which is either
+// a number of seconds or a date.
+func RetryAfter(response *http.Response, now time.Time) (time.Duration, bool) {
+	if response == nil {
+		return 0, false
+	}
+
+	header := response.Header.Get("Retry-After")
+	if header == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(header); err == nil {
+		if delay := date.Sub(now); delay > 0 {
+			return delay, true
+		}
+
+		return 0, true
+	}
+
+	return 0, false
+}
+
+// retryable determines if a request should be retried.
+func retryable(request *http.Request, response *http.Response, err error) bool {
+	if err != nil {
+		return request.Context().Err() == nil && idempotent(request.Method)
+	}
+
+	switch {
+	case response.StatusCode == http.StatusTooManyRequests:
+		return true
+	case response.StatusCode == http.StatusServiceUnavailable && response.Header.Get("Retry-After") != "":
+		return true
+	case response.StatusCode >= http.StatusInternalServerError:
+		return idempotent(request.Method)
+	default:
+		return false
+	}
+}
+
+// idempotent determines if a request with a method may safely be sent more than once.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+// sleep waits for a delay or until the context is done.
+func sleep(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("context done while waiting to retry - %w", ctx.Err())
+	case <-timer.C:
+		return nil
+	}
+}
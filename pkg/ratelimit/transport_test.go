@@ -0,0 +1,201 @@
+package ratelimit
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+type testRoundTripper struct {
+	codes      []int
+	retryAfter string
+	requests   int
+	bodies     []string
+}
+
+func (t *testRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Body != nil {
+		body, _ := io.ReadAll(request.Body)
+		t.bodies = append(t.bodies, string(body))
+	}
+
+	code := t.codes[len(t.codes)-1]
+	if t.requests < len(t.codes) {
+		code = t.codes[t.requests]
+	}
+	t.requests++
+
+	header := http.Header{}
+	if t.retryAfter != "" {
+		header.Set("Retry-After", t.retryAfter)
+	}
+
+	return &http.Response{StatusCode: code, Header: header, Body: io.NopCloser(&bytes.Buffer{})}, nil
+}
+
+func TestTransport_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name         string
+		method       string
+		codes        []int
+		retryAfter   string
+		wantCode     int
+		wantRequests int
+	}{
+		{
+			name:         "ensure successful request is not retried",
+			method:       http.MethodGet,
+			codes:        []int{http.StatusOK},
+			wantCode:     http.StatusOK,
+			wantRequests: 1,
+		},
+		{
+			name:         "ensure throttled request is retried regardless of method",
+			method:       http.MethodPost,
+			codes:        []int{http.StatusTooManyRequests, http.StatusCreated},
+			wantCode:     http.StatusCreated,
+			wantRequests: 2,
+		},
+		{
+			name:         "ensure server error is retried for idempotent method",
+			method:       http.MethodGet,
+			codes:        []int{http.StatusInternalServerError, http.StatusBadGateway, http.StatusOK},
+			wantCode:     http.StatusOK,
+			wantRequests: 3,
+		},
+		{
+			name:         "ensure server error is not retried for non-idempotent method",
+			method:       http.MethodPost,
+			codes:        []int{http.StatusInternalServerError},
+			wantCode:     http.StatusInternalServerError,
+			wantRequests: 1,
+		},
+		{
+			name:         "ensure unavailable request is not retried for non-idempotent method",
+			method:       http.MethodPost,
+			codes:        []int{http.StatusServiceUnavailable, http.StatusCreated},
+			wantCode:     http.StatusServiceUnavailable,
+			wantRequests: 1,
+		},
+		{
+			name:         "ensure unavailable request with a requested delay is retried regardless of method",
+			method:       http.MethodPost,
+			codes:        []int{http.StatusServiceUnavailable, http.StatusCreated},
+			retryAfter:   "0",
+			wantCode:     http.StatusCreated,
+			wantRequests: 2,
+		},
+		{
+			name:         "ensure request is retried up to the maximum retries",
+			method:       http.MethodGet,
+			codes:        []int{http.StatusServiceUnavailable},
+			wantCode:     http.StatusServiceUnavailable,
+			wantRequests: 3,
+		},
+		{
+			name:         "ensure request is not retried when the server requests too long a delay",
+			method:       http.MethodGet,
+			codes:        []int{http.StatusTooManyRequests, http.StatusOK},
+			retryAfter:   "120",
+			wantCode:     http.StatusTooManyRequests,
+			wantRequests: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			next := &testRoundTripper{codes: tt.codes, retryAfter: tt.retryAfter}
+			transport := NewTransport(next, rate.NewLimiter(rate.Inf, 0), Config{
+				MaxRetries: 2,
+				BaseDelay:  time.Millisecond,
+				MaxDelay:   10 * time.Millisecond,
+			})
+
+			request, err := http.NewRequest(tt.method, "https://api.openshift.com", bytes.NewBufferString("body"))
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			response, err := transport.RoundTrip(request)
+			if err != nil {
+				t.Fatalf("Transport.RoundTrip() error = %v", err)
+			}
+
+			if response.StatusCode != tt.wantCode {
+				t.Errorf("Transport.RoundTrip() code = %v, want %v", response.StatusCode, tt.wantCode)
+			}
+
+			if next.requests != tt.wantRequests {
+				t.Errorf("Transport.RoundTrip() requests = %v, want %v", next.requests, tt.wantRequests)
+			}
+
+			for _, body := range next.bodies {
+				if body != "body" {
+					t.Errorf("Transport.RoundTrip() body = %v, want %v", body, "body")
+				}
+			}
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2023, 7, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		header    string
+		want      time.Duration
+		wantFound bool
+	}{
+		{
+			name:      "ensure seconds are parsed",
+			header:    "5",
+			want:      5 * time.Second,
+			wantFound: true,
+		},
+		{
+			name:      "ensure dates are parsed",
+			header:    now.Add(time.Minute).Format(http.TimeFormat),
+			want:      time.Minute,
+			wantFound: true,
+		},
+		{
+			name:      "ensure missing header is not found",
+			header:    "",
+			wantFound: false,
+		},
+		{
+			name:      "ensure invalid header is not found",
+			header:    "soon",
+			wantFound: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			response := &http.Response{Header: http.Header{}}
+			if tt.header != "" {
+				response.Header.Set("Retry-After", tt.header)
+			}
+
+			got, found := RetryAfter(response, now)
+			if got != tt.want || found != tt.wantFound {
+				t.Errorf("RetryAfter() = %v, %v, want %v, %v", got, found, tt.want, tt.wantFound)
+			}
+		})
+	}
+}
@@ -0,0 +1,121 @@
+package schedule
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+const (
+	defaultTimeZone = "UTC"
+)
+
+var (
+	ErrInvalidWindow = errors.New("invalid schedule window")
+)
+
+// Window represents a recurring window of time.  Each window begins according to a cron
+// expression and lasts for a fixed duration.
+type Window struct {
+	// Name is the name of the window, used for error messages.
+	Name string
+
+	// Schedule is a cron expression, in standard five field format, representing the start of
+	// each window.
+	Schedule string
+
+	// TimeZone is the IANA time zone in which the cron expression is evaluated.  If empty,
+	// UTC is used.
+	TimeZone string
+
+	// Duration is the length of each window.
+	Duration time.Duration
+}
+
+// Active returns the index of the first window which is active at the given time along with the time
+// of the next boundary at which the active window may change.  It returns an index of -1 if no window is
+// active and a zero time if no window has a future boundary.
+func Active(windows []Window, now time.Time) (active int, next time.Time, err error) {
+	active = -1
+
+	for i := range windows {
+		isActive, boundary, err := windows[i].Evaluate(now)
+		if err != nil {
+			return -1, time.Time{}, err
+		}
+
+		if isActive && active < 0 {
+			active = i
+		}
+
+		// a window which never begins again has no boundary
+		if boundary.IsZero() {
+			continue
+		}
+
+		if next.IsZero() || boundary.Before(next) {
+			next = boundary
+		}
+	}
+
+	return active, next, nil
+}
+
+// Evaluate returns whether the window is active at the given time along with the time of the next
+// boundary of the window.  The boundary is the end of the current window if the window is active or
+// the start of the next window if it is not.
+func (window *Window) Evaluate(now time.Time) (bool, time.Time, error) {
+	timeZone := window.TimeZone
+	if timeZone == "" {
+		timeZone = defaultTimeZone
+	}
+
+	location, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf(
+			"invalid time zone [%s] for schedule [%s] - %w",
+			timeZone,
+			window.Name,
+			ErrInvalidWindow,
+		)
+	}
+
+	parsed, err := cron.ParseStandard(window.Schedule)
+	if err != nil {
+		return false, time.Time{}, fmt.Errorf(
+			"invalid cron expression [%s] for schedule [%s] - %w",
+			window.Schedule,
+			window.Name,
+			ErrInvalidWindow,
+		)
+	}
+
+	if window.Duration <= 0 {
+		return false, time.Time{}, fmt.Errorf(
+			"invalid duration [%s] for schedule [%s] - %w",
+			window.Duration.String(),
+			window.Name,
+			ErrInvalidWindow,
+		)
+	}
+
+	// find the most recent start of a window which has not yet ended.  cron only calculates
+	// the next activation time, so we walk forward from the earliest possible start.
+	now = now.In(location)
+
+	var start time.Time
+
+	candidate := parsed.Next(now.Add(-window.Duration))
+	for !candidate.IsZero() && !candidate.After(now) {
+		start = candidate
+		candidate = parsed.Next(candidate)
+	}
+
+	if start.IsZero() {
+		return false, parsed.Next(now), nil
+	}
+
+	return true, start.Add(window.Duration), nil
+}
@@ -0,0 +1,235 @@
+package schedule
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("unable to load location [%s] - %v", name, err)
+	}
+
+	return location
+}
+
+func TestWindow_Evaluate(t *testing.T) {
+	t.Parallel()
+
+	newYork := mustLoadLocation(t, "America/New_York")
+	tokyo := mustLoadLocation(t, "Asia/Tokyo")
+
+	// nightly from 19:00 until 07:00 in new york
+	nightly := Window{
+		Name:     "nightly",
+		Schedule: "0 19 * * *",
+		TimeZone: "America/New_York",
+		Duration: 12 * time.Hour,
+	}
+
+	tests := []struct {
+		name       string
+		window     Window
+		now        time.Time
+		wantActive bool
+		wantNext   time.Time
+		wantErr    error
+	}{
+		{
+			name:     "ensure time before window is inactive until the window starts",
+			window:   nightly,
+			now:      time.Date(2023, time.June, 5, 12, 0, 0, 0, newYork),
+			wantNext: time.Date(2023, time.June, 5, 19, 0, 0, 0, newYork),
+		},
+		{
+			name:       "ensure window start is active until the window ends",
+			window:     nightly,
+			now:        time.Date(2023, time.June, 5, 19, 0, 0, 0, newYork),
+			wantActive: true,
+			wantNext:   time.Date(2023, time.June, 6, 7, 0, 0, 0, newYork),
+		},
+		{
+			name:       "ensure window spanning midnight is active after midnight",
+			window:     nightly,
+			now:        time.Date(2023, time.June, 6, 2, 0, 0, 0, newYork),
+			wantActive: true,
+			wantNext:   time.Date(2023, time.June, 6, 7, 0, 0, 0, newYork),
+		},
+		{
+			name:     "ensure window end is inactive until the next window starts",
+			window:   nightly,
+			now:      time.Date(2023, time.June, 6, 7, 0, 0, 0, newYork),
+			wantNext: time.Date(2023, time.June, 6, 19, 0, 0, 0, newYork),
+		},
+		{
+			name:       "ensure time in another time zone is evaluated in the time zone of the window",
+			window:     nightly,
+			now:        time.Date(2023, time.June, 6, 0, 0, 0, 0, time.UTC),
+			wantActive: true,
+			wantNext:   time.Date(2023, time.June, 6, 7, 0, 0, 0, newYork),
+		},
+		{
+			name: "ensure unset time zone is evaluated in utc",
+			window: Window{
+				Name:     "utc",
+				Schedule: "0 9 * * *",
+				Duration: time.Hour,
+			},
+			now:        time.Date(2023, time.June, 5, 18, 30, 0, 0, tokyo),
+			wantActive: true,
+			wantNext:   time.Date(2023, time.June, 5, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:       "ensure window duration is elapsed time across daylight saving time changes",
+			window:     nightly,
+			now:        time.Date(2023, time.March, 12, 2, 30, 0, 0, time.UTC),
+			wantActive: true,
+			wantNext:   time.Date(2023, time.March, 12, 8, 0, 0, 0, newYork),
+		},
+		{
+			name: "ensure overlapping windows end after the latest start",
+			window: Window{
+				Name:     "overlapping",
+				Schedule: "0 * * * *",
+				Duration: 90 * time.Minute,
+			},
+			now:        time.Date(2023, time.June, 5, 12, 15, 0, 0, time.UTC),
+			wantActive: true,
+			wantNext:   time.Date(2023, time.June, 5, 13, 30, 0, 0, time.UTC),
+		},
+		{
+			name:    "ensure invalid cron expression is invalid",
+			window:  Window{Name: "invalid", Schedule: "invalid", Duration: time.Hour},
+			wantErr: ErrInvalidWindow,
+		},
+		{
+			name:    "ensure invalid time zone is invalid",
+			window:  Window{Name: "invalid", Schedule: "0 19 * * *", TimeZone: "Invalid/Zone", Duration: time.Hour},
+			wantErr: ErrInvalidWindow,
+		},
+		{
+			name:    "ensure missing duration is invalid",
+			window:  Window{Name: "invalid", Schedule: "0 19 * * *"},
+			wantErr: ErrInvalidWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			active, next, err := tt.window.Evaluate(tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Window.Evaluate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if active != tt.wantActive {
+				t.Errorf("Window.Evaluate() active = %v, want %v", active, tt.wantActive)
+			}
+
+			if !next.Equal(tt.wantNext) {
+				t.Errorf("Window.Evaluate() next = %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
+
+func TestActive(t *testing.T) {
+	t.Parallel()
+
+	newYork := mustLoadLocation(t, "America/New_York")
+
+	// weeknights from 19:00 until 07:00 and weekends from friday 19:00 until monday 07:00
+	weeknights := Window{
+		Name:     "weeknights",
+		Schedule: "0 19 * * 1-5",
+		TimeZone: "America/New_York",
+		Duration: 12 * time.Hour,
+	}
+
+	weekends := Window{
+		Name:     "weekends",
+		Schedule: "0 19 * * 5",
+		TimeZone: "America/New_York",
+		Duration: 60 * time.Hour,
+	}
+
+	// 2023-06-05 is a monday
+	at := func(day, hour int) time.Time {
+		return time.Date(2023, time.June, day, hour, 0, 0, 0, newYork)
+	}
+
+	tests := []struct {
+		name     string
+		windows  []Window
+		now      time.Time
+		want     int
+		wantNext time.Time
+		wantErr  error
+	}{
+		{
+			name:    "ensure no windows are inactive without a boundary",
+			windows: []Window{},
+			now:     at(5, 12),
+			want:    -1,
+		},
+		{
+			name:     "ensure inactive windows return the earliest start",
+			windows:  []Window{weekends, weeknights},
+			now:      at(5, 12),
+			want:     -1,
+			wantNext: at(5, 19),
+		},
+		{
+			name:     "ensure first active window is used",
+			windows:  []Window{weekends, weeknights},
+			now:      at(9, 23),
+			want:     0,
+			wantNext: at(10, 7),
+		},
+		{
+			name:     "ensure boundary of a later window is used when it is earlier",
+			windows:  []Window{weekends, weeknights},
+			now:      at(11, 12),
+			want:     0,
+			wantNext: at(12, 7),
+		},
+		{
+			name:     "ensure start of an inactive window before the end of the active window is used",
+			windows:  []Window{weeknights, {Name: "dawn", Schedule: "0 5 * * *", TimeZone: "America/New_York", Duration: time.Hour}},
+			now:      at(6, 2),
+			want:     0,
+			wantNext: at(6, 5),
+		},
+		{
+			name:    "ensure invalid window is invalid",
+			windows: []Window{weeknights, {Name: "invalid", Schedule: "invalid", Duration: time.Hour}},
+			now:     at(5, 12),
+			want:    -1,
+			wantErr: ErrInvalidWindow,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			active, next, err := Active(tt.windows, tt.now)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("Active() error = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if active != tt.want {
+				t.Errorf("Active() active = %v, want %v", active, tt.want)
+			}
+
+			if !next.Equal(tt.wantNext) {
+				t.Errorf("Active() next = %v, want %v", next, tt.wantNext)
+			}
+		})
+	}
+}
@@ -0,0 +1,110 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type reconcileKey struct{}
+
+// Object represents an object which is reconciled, which may belong to a cluster.
+type Object interface {
+	client.Object
+
+	GetClusterID() string
+}
+
+// reconcile stores the spans of a reconcile in its context.  The functions of the phases of a
+// reconcile do not receive a context of their own, so spans which are started from the context
+// of the reconcile are made children of the phase which is currently running instead.
+type reconcile struct {
+	mutex      sync.Mutex
+	root       trace.Span
+	phase      trace.Span
+	attributes []attribute.KeyValue
+}
+
+func fromContext(ctx context.Context) *reconcile {
+	current, _ := ctx.Value(reconcileKey{}).(*reconcile)
+
+	return current
+}
+
+// parent returns the context which a span started from a context should be a child of.
+func (current *reconcile) parent(ctx context.Context) context.Context {
+	current.mutex.Lock()
+	defer current.mutex.Unlock()
+
+	if current.phase != nil && trace.SpanFromContext(ctx) == current.root {
+		return trace.ContextWithSpan(ctx, current.phase)
+	}
+
+	return ctx
+}
+
+// StartReconcile starts the root span of a reconcile of an object.
+func StartReconcile(ctx context.Context, name types.NamespacedName) (context.Context, trace.Span) {
+	attributes := []attribute.KeyValue{
+		ObjectNamespaceKey.String(name.Namespace),
+		ObjectNameKey.String(name.Name),
+	}
+
+	ctx, span := Tracer().Start(ctx, "Reconcile",
+		trace.WithNewRoot(),
+		trace.WithSpanKind(trace.SpanKindInternal),
+		trace.WithAttributes(attributes...),
+	)
+
+	return context.WithValue(ctx, reconcileKey{}, &reconcile{root: span, attributes: attributes}), span
+}
+
+// SetObject adds the attributes of the object which is being reconciled to the root span of the
+// reconcile, and to all spans which are started from the context of the reconcile afterwards.
+func SetObject(ctx context.Context, kind string, object Object) {
+	current := fromContext(ctx)
+	if current == nil {
+		return
+	}
+
+	current.mutex.Lock()
+	defer current.mutex.Unlock()
+
+	current.attributes = append(current.attributes, ObjectKindKey.String(kind))
+	if clusterID := object.GetClusterID(); clusterID != "" {
+		current.attributes = append(current.attributes, ClusterIDKey.String(clusterID))
+	}
+
+	current.root.SetName(fmt.Sprintf("Reconcile %s", kind))
+	current.root.SetAttributes(current.attributes...)
+}
+
+// StartPhase starts the span of a phase of a reconcile.  Spans which are started from the context
+// of the reconcile are children of the phase until it is ended with EndPhase.
+func StartPhase(ctx context.Context, name string) trace.Span {
+	_, span := Start(ctx, name)
+
+	if current := fromContext(ctx); current != nil {
+		current.mutex.Lock()
+		current.phase = span
+		current.mutex.Unlock()
+	}
+
+	return span
+}
+
+// EndPhase ends the span of a phase of a reconcile.
+func EndPhase(ctx context.Context, span trace.Span, err error) {
+	if current := fromContext(ctx); current != nil {
+		current.mutex.Lock()
+		current.phase = nil
+		current.mutex.Unlock()
+	}
+
+	End(span, err)
+}
@@ -0,0 +1,134 @@
+package tracing
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ServiceName = "ocm-operator"
+
+	DefaultSampleRatio = 1.0
+
+	tracerName = "github.com/rh-mobb/ocm-operator"
+
+	// environment variables which configure the endpoint of the exporter.  tracing is enabled
+	// if either is set, even if the endpoint flag is not.
+	envEndpoint       = "OTEL_EXPORTER_OTLP_ENDPOINT"
+	envTracesEndpoint = "OTEL_EXPORTER_OTLP_TRACES_ENDPOINT"
+)
+
+// attributes which are added to spans to identify the objects which they relate to.
+const (
+	ObjectKindKey      = attribute.Key("ocm_operator.object.kind")
+	ObjectNamespaceKey = attribute.Key("ocm_operator.object.namespace")
+	ObjectNameKey      = attribute.Key("ocm_operator.object.name")
+	ClusterIDKey       = attribute.Key("ocm.cluster.id")
+)
+
+// Config represents the configuration of the exporter of traces.
+type Config struct {
+	// Endpoint is the host and port of the OTLP gRPC receiver which traces are exported to.  The
+	// standard OTEL_EXPORTER_OTLP_* environment variables are used when it is unset.
+	Endpoint string
+
+	// Insecure disables TLS when exporting traces.
+	Insecure bool
+
+	// SampleRatio is the ratio of reconciles which are traced, between 0 and 1.
+	SampleRatio float64
+}
+
+// BindFlags binds the configuration to command line flags.
+func (config *Config) BindFlags(flags *flag.FlagSet) {
+	flags.StringVar(&config.Endpoint, "tracing-endpoint", "",
+		"The host:port of the OTLP gRPC receiver to export traces to (tracing is disabled unless this or "+
+			envEndpoint+" is set).")
+	flags.BoolVar(&config.Insecure, "tracing-insecure", false,
+		"Disable TLS when exporting traces.")
+	flags.Float64Var(&config.SampleRatio, "tracing-sample-ratio", DefaultSampleRatio,
+		"The ratio of reconciles which are traced, between 0 and 1.")
+}
+
+// Enabled determines if traces should be exported.
+func (config Config) Enabled() bool {
+	return config.Endpoint != "" || os.Getenv(envEndpoint) != "" || os.Getenv(envTracesEndpoint) != ""
+}
+
+// Setup sets up the global tracer provider to export traces with the configuration.  If tracing
+// is not enabled, the global tracer provider is left as a provider which does not record spans.  It
+// returns a function which flushes and stops the exporter.
+func Setup(ctx context.Context, config Config) (shutdown func(context.Context) error, err error) {
+	if !config.Enabled() {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	options := []otlptracegrpc.Option{}
+	if config.Endpoint != "" {
+		options = append(options, otlptracegrpc.WithEndpoint(config.Endpoint))
+	}
+
+	if config.Insecure {
+		options = append(options, otlptracegrpc.WithInsecure())
+	}
+
+	exporter, err := otlptracegrpc.New(ctx, options...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create trace exporter - %w", err)
+	}
+
+	resources, err := resource.Merge(resource.Default(), resource.NewSchemaless(semconv.ServiceName(ServiceName)))
+	if err != nil {
+		return nil, fmt.Errorf("unable to create trace resource - %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resources),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(config.SampleRatio))),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer of the operator.
+func Tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// Start starts a new span.  The attributes of the object which is being reconciled are added to
+// the span.  Spans which are started from the context of a reconcile, rather than from the context
+// of another span, are children of the phase which is currently running.
+func Start(ctx context.Context, name string, options ...trace.SpanStartOption) (context.Context, trace.Span) {
+	if current := fromContext(ctx); current != nil {
+		ctx = current.parent(ctx)
+		options = append(options, trace.WithAttributes(current.attributes...))
+	}
+
+	return Tracer().Start(ctx, name, options...)
+}
+
+// End ends a span, recording the error which the operation of the span returned, if any.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
@@ -0,0 +1,89 @@
+package tracing
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// apiPrefixSegments is the number of segments of the path of an OCM API request which precede
+	// the collections and identifiers of the objects (e.g. api/clusters_mgmt/v1).
+	apiPrefixSegments = 3
+
+	clustersCollection = "clusters"
+	routeIdentifier    = "-"
+)
+
+// Transport is an http.RoundTripper which records a span for each request to the OpenShift Cluster
+// Manager API.  The span is a child of the span in the context of the request, if any, and includes
+// the cluster which the request relates to.
+type Transport struct {
+	Next http.RoundTripper
+}
+
+// NewTransport returns a new transport which wraps another transport.
+func NewTransport(next http.RoundTripper) *Transport {
+	return &Transport{Next: next}
+}
+
+// RoundTrip is the implementation of the http.RoundTripper interface.
+func (t *Transport) RoundTrip(request *http.Request) (*http.Response, error) {
+	route, clusterID := Route(request.URL.Path)
+
+	attributes := []attribute.KeyValue{
+		semconv.HTTPMethod(request.Method),
+		semconv.HTTPRoute(route),
+	}
+
+	if clusterID != "" {
+		attributes = append(attributes, ClusterIDKey.String(clusterID))
+	}
+
+	ctx, span := Start(request.Context(), fmt.Sprintf("OCM %s %s", request.Method, route),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attributes...),
+	)
+
+	response, err := t.Next.RoundTrip(request.WithContext(ctx))
+	if response != nil {
+		span.SetAttributes(semconv.HTTPStatusCode(response.StatusCode))
+
+		if response.StatusCode >= http.StatusBadRequest {
+			span.SetStatus(codes.Error, response.Status)
+		}
+	}
+
+	End(span, err)
+
+	return response, err
+}
+
+// Route returns the path of a request to the OpenShift Cluster Manager API with the identifiers of
+// the objects replaced, so that requests for different objects of the same type share a name.  It
+// also returns the identifier of the cluster which the request relates to, if any.  For example, the
+// path /api/clusters_mgmt/v1/clusters/123/machine_pools/abc returns a route of
+// /api/clusters_mgmt/v1/clusters/-/machine_pools/- and a cluster id of 123.
+func Route(path string) (route, clusterID string) {
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if len(segments) <= apiPrefixSegments || segments[0] != "api" {
+		return path, ""
+	}
+
+	// the remaining segments alternate between the name of a collection and the identifier of an
+	// object within the collection
+	for i := apiPrefixSegments + 1; i < len(segments); i += 2 {
+		if segments[i-1] == clustersCollection && clusterID == "" {
+			clusterID = segments[i]
+		}
+
+		segments[i] = routeIdentifier
+	}
+
+	return "/" + strings.Join(segments, "/"), clusterID
+}
@@ -0,0 +1,64 @@
+package tracing
+
+import "testing"
+
+func TestRoute(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name          string
+		path          string
+		wantRoute     string
+		wantClusterID string
+	}{
+		{
+			name:      "ensure collection is unchanged",
+			path:      "/api/clusters_mgmt/v1/clusters",
+			wantRoute: "/api/clusters_mgmt/v1/clusters",
+		},
+		{
+			name:          "ensure cluster id is replaced and returned",
+			path:          "/api/clusters_mgmt/v1/clusters/123",
+			wantRoute:     "/api/clusters_mgmt/v1/clusters/-",
+			wantClusterID: "123",
+		},
+		{
+			name:          "ensure nested object ids are replaced",
+			path:          "/api/clusters_mgmt/v1/clusters/123/machine_pools/abc",
+			wantRoute:     "/api/clusters_mgmt/v1/clusters/-/machine_pools/-",
+			wantClusterID: "123",
+		},
+		{
+			name:          "ensure actions on an object are unchanged",
+			path:          "/api/clusters_mgmt/v1/clusters/123/hibernate",
+			wantRoute:     "/api/clusters_mgmt/v1/clusters/-/hibernate",
+			wantClusterID: "123",
+		},
+		{
+			name:      "ensure objects which are not clusters do not return a cluster id",
+			path:      "/api/clusters_mgmt/v1/oidc_configs/abc",
+			wantRoute: "/api/clusters_mgmt/v1/oidc_configs/-",
+		},
+		{
+			name:      "ensure paths outside of the api are unchanged",
+			path:      "/auth/realms/redhat-external/protocol/openid-connect/token",
+			wantRoute: "/auth/realms/redhat-external/protocol/openid-connect/token",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			route, clusterID := Route(tt.path)
+			if route != tt.wantRoute {
+				t.Errorf("Route() route = %v, want %v", route, tt.wantRoute)
+			}
+
+			if clusterID != tt.wantClusterID {
+				t.Errorf("Route() clusterID = %v, want %v", clusterID, tt.wantClusterID)
+			}
+		})
+	}
+}